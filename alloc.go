@@ -0,0 +1,83 @@
+//go:build cgo && !chinwag_purego
+
+package chinwag
+
+import (
+  "runtime"
+  "sync/atomic"
+  "unsafe"
+)
+
+/*
+#include "chinwag.h"
+*/
+import "C"
+
+// dictRef owns a dictionary container allocated in C memory. Keeping the
+// container outside of Go memory lets the cleanup release it without holding
+// a reference back to the dictRef itself.
+type dictRef struct {
+  c *C.struct_dictionary_container_type
+  derived atomic.Pointer[dictDerived]
+  indexed atomic.Bool
+  members atomic.Pointer[memberSet]
+}
+
+// counts of live C allocations owned by the Go side, checked by the tests
+var (
+  liveDicts atomic.Int64
+  liveStrings atomic.Int64
+)
+
+func wrap(container C.struct_dictionary_container_type) CWDict {
+  size := C.size_t(unsafe.Sizeof(container))
+  c := (*C.struct_dictionary_container_type)(C.malloc(size))
+  *c = container
+
+  ref := &dictRef{c: c}
+  liveDicts.Add(1)
+  runtime.AddCleanup(ref, release, c)
+
+  return CWDict{ref: ref}
+}
+
+func release(c *C.struct_dictionary_container_type) {
+  C.cwdict_close(*c)
+  C.free(unsafe.Pointer(c))
+  liveDicts.Add(-1)
+}
+
+// cdict returns the C dictionary by value, for read-only calls
+func (dict CWDict) cdict() C.struct_dictionary_container_type {
+  if dict.ref == nil { return C.cwdict_open() }
+  return *dict.ref.c
+}
+
+// update stores a (possibly reallocated) C dictionary back into the shared
+// container, allocating one if the dictionary is still the zero value
+func (dict *CWDict) update(container C.struct_dictionary_container_type) *CWDict {
+  if dict.ref == nil {
+    *dict = wrap(container)
+  } else {
+    *dict.ref.c = container
+    dict.ref.derived.Store(nil)
+  }
+
+  return dict
+}
+
+// keep holds the dictionary alive until C is done reading from it
+func (dict CWDict) keep() {
+  runtime.KeepAlive(dict.ref)
+}
+
+// temporary C strings; everything from cstring must go back through cfree
+func cstring(s string) *C.char {
+  liveStrings.Add(1)
+  return C.CString(s)
+}
+
+func cfree(s *C.char) {
+  C.free(unsafe.Pointer(s))
+  liveStrings.Add(-1)
+}
@@ -0,0 +1,106 @@
+//go:build cgo && !chinwag_purego
+
+package chinwag
+
+import (
+  "time"
+  "strings"
+  "runtime"
+  "testing"
+)
+
+// checkLeaks runs fn, then collects garbage until every dictionary and
+// temporary C string allocated along the way has been released
+func checkLeaks(t *testing.T, fn func()) {
+  t.Helper()
+
+  dicts, strs := liveDicts.Load(), liveStrings.Load()
+  fn()
+
+  for i := 0; i != 100; i++ {
+    runtime.GC()
+    if liveDicts.Load() <= dicts && liveStrings.Load() == strs { return }
+    time.Sleep(time.Millisecond)
+  }
+
+  t.Errorf("leaked %d dicts and %d strings", liveDicts.Load() - dicts,
+  liveStrings.Load() - strs)
+}
+
+func TestAllocForgottenClose(t *testing.T) {
+  checkLeaks(t, func() {
+    for i := 0; i != 50; i++ {
+      seuss := OpenEmbedded("Seussian")
+      clone := seuss.Clone()
+      named := OpenWithName("forgotten")
+      named.PlaceWords("never", "closed")
+
+      if clone.Length() != seuss.Length() {
+        t.Error("expected \"clone\" to match \"seuss\"")
+      }
+    }
+  })
+}
+
+func TestAllocClosed(t *testing.T) {
+  checkLeaks(t, func() {
+    for i := 0; i != 50; i++ {
+      seuss := OpenEmbedded("Seussian")
+      seuss.Close()
+      seuss.Close()
+
+      if seuss.Length() != 0 {
+        t.Error("expected \"seuss\" to be closed")
+      }
+    }
+  })
+}
+
+func TestAllocStrings(t *testing.T) {
+  checkLeaks(t, func() {
+    seuss := OpenEmbedded("Seussian")
+
+    for i := 0; i != 50; i++ {
+      seuss.SetName("Geisel")
+      seuss.Include("cat")
+      seuss.Exclude("dog")
+      seuss.PlaceWord("hat")
+      seuss.AppendWords("fish", "fish")
+    }
+
+    seuss.Tweak(strings.ToUpper)
+
+    err := DictTooSmall
+    if ErrString(seuss, &err) == "" {
+      t.Error("expected an error message")
+    }
+
+    if seuss.Name() != "Geisel" || !seuss.Include("CAT") {
+      t.Error("expected \"seuss\" to keep its tweaks")
+    }
+  })
+}
+
+func TestAllocSharedCopies(t *testing.T) {
+  original := Open()
+  shared := original
+  shared.PlaceWords("copies", "share", "entries")
+
+  if original.Length() != 3 {
+    t.Errorf("expected \"original\" to see 3 entries, got %d",
+    original.Length())
+  }
+
+  shared.Close()
+
+  if original.Length() != 0 {
+    t.Error("expected \"original\" to be closed alongside \"shared\"")
+  }
+
+  var zero CWDict
+  zero.PlaceWord("zero")
+
+  if zero.Length() != 1 || zero.Name() != "" {
+    t.Error("expected the zero value to act as a blank dictionary")
+  }
+}
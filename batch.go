@@ -0,0 +1,111 @@
+package chinwag
+
+import (
+  "sync"
+  "runtime"
+)
+
+// outputs generated per engine call; each chunk has its own seed, drawn in
+// order from the batch's, so a seeded batch comes out the same however its
+// chunks are spread across goroutines
+const batchChunk = 512
+
+// GenerateBatch creates n independent outputs, crossing into the engine
+// once per few hundred outputs rather than once apiece
+func GenerateBatch(dict CWDict, kind CWType, min, max uint64,
+n int) ([]string, *ErrorType) {
+  return generateBatch(dict, kind, min, max, n, 1, nextSeed(), nil)
+}
+
+// GenerateBatchSeeded is GenerateBatch with a fixed seed
+func GenerateBatchSeeded(dict CWDict, kind CWType, min, max uint64, n int,
+seed uint64) ([]string, *ErrorType) {
+  return generateBatch(dict, kind, min, max, n, 1,
+  uint32(seed ^ (seed >> 32)), nil)
+}
+
+// GenerateBatchParallel is GenerateBatch spread across workers goroutines
+// (all CPUs, when workers is zero or less)
+func GenerateBatchParallel(dict CWDict, kind CWType, min, max uint64, n,
+workers int) ([]string, *ErrorType) {
+  return generateBatch(dict, kind, min, max, n, workers, nextSeed(), nil)
+}
+
+// GenerateBatchParallelSeeded is GenerateBatchParallel with a fixed seed;
+// its output matches GenerateBatchSeeded's for the same seed
+func GenerateBatchParallelSeeded(dict CWDict, kind CWType, min, max uint64,
+n, workers int, seed uint64) ([]string, *ErrorType) {
+  return generateBatch(dict, kind, min, max, n, workers,
+  uint32(seed ^ (seed >> 32)), nil)
+}
+
+// generateBatch stops with Cancelled once done (if any) is closed
+func generateBatch(dict CWDict, kind CWType, min, max uint64, n,
+workers int, seed uint32, done <-chan struct{}) ([]string, *ErrorType) {
+  cwerror := checkGenerate(dict, kind, min, max)
+  if cwerror != nil { return nil, cwerror }
+
+  if n < 0 { n = 0 }
+  if workers <= 0 { workers = runtime.NumCPU() }
+
+  src := newSource(seed)
+  seeds := make([]uint32, (n + batchChunk - 1) / batchChunk)
+  for i := range seeds { seeds[i] = src.mother() }
+
+  result := make([]string, n)
+
+  chunk := func(i int) *ErrorType {
+    start := i * batchChunk
+    end := start + batchChunk
+    if end > n { end = n }
+
+    engineKind := kind
+    if kind == Titles { engineKind = Words }
+
+    outputs, err := dict.generateBatch(engineKind, min, max, seeds[i],
+    end - start, done)
+    if err != nil { return err }
+
+    if kind == Titles {
+      for j := range outputs { outputs[j] = titleCase(outputs[j]) }
+    }
+
+    copy(result[start:end], outputs)
+    return nil
+  }
+
+  if workers == 1 || len(seeds) < 2 {
+    for i := range seeds {
+      if err := chunk(i); err != nil { return nil, err }
+    }
+
+    return result, nil
+  }
+
+  var group sync.WaitGroup
+  var once sync.Once
+  var failure *ErrorType
+
+  chunks := make(chan int)
+
+  if workers > len(seeds) { workers = len(seeds) }
+
+  for w := 0; w != workers; w++ {
+    group.Add(1)
+
+    go func() {
+      defer group.Done()
+
+      for i := range chunks {
+        if err := chunk(i); err != nil { once.Do(func() { failure = err }) }
+      }
+    }()
+  }
+
+  for i := range seeds { chunks <- i }
+  close(chunks)
+  group.Wait()
+
+  if failure != nil { return nil, failure }
+  return result, nil
+}
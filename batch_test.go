@@ -0,0 +1,81 @@
+package chinwag
+
+import (
+  "slices"
+  "strings"
+  "testing"
+)
+
+func TestGenerateBatch(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+
+  result, err := GenerateBatch(seuss, Words, 2, 3, 1500)
+  if err != nil { t.Fatal(ErrString(seuss, err)) }
+
+  if len(result) != 1500 {
+    t.Fatalf("expected 1500 outputs, got %d", len(result))
+  }
+
+  distinct := map[string]bool{}
+  for _, output := range result {
+    if count := len(strings.Fields(output)); count < 2 {
+      t.Errorf("expected two or three words, got %q", output)
+    }
+
+    distinct[output] = true
+  }
+
+  if len(distinct) < 1000 {
+    t.Errorf("expected independent outputs, got %d distinct", len(distinct))
+  }
+
+  if result, _ := GenerateBatch(seuss, Words, 1, 1, 0); len(result) != 0 {
+    t.Errorf("expected an empty batch, got %d", len(result))
+  }
+}
+
+func TestGenerateBatchSeeded(t *testing.T) {
+  first, err := GenerateBatchSeeded(latin, Sentences, 1, 2, 1200, 77)
+  if err != nil { t.Fatal(ErrString(latin, err)) }
+
+  second, _ := GenerateBatchSeeded(latin, Sentences, 1, 2, 1200, 77)
+  parallel, _ := GenerateBatchParallelSeeded(latin, Sentences, 1, 2, 1200, 4,
+  77)
+
+  if !slices.Equal(first, second) || !slices.Equal(first, parallel) {
+    t.Error("expected seeded batches to match, sequential or parallel")
+  }
+
+  other, _ := GenerateBatchSeeded(latin, Sentences, 1, 2, 1200, 78)
+  if slices.Equal(first, other) {
+    t.Error("expected another seed to give another batch")
+  }
+}
+
+func TestGenerateBatchParallel(t *testing.T) {
+  result, err := GenerateBatchParallel(latin, Titles, 2, 4, 3000, 0)
+  if err != nil { t.Fatal(ErrString(latin, err)) }
+
+  for i, output := range result {
+    if output == "" || output != titleCase(output) {
+      t.Fatalf("expected title %d to be filled and title-cased, got %q", i,
+      output)
+    }
+  }
+}
+
+func TestGenerateBatchErrors(t *testing.T) {
+  if _, err := GenerateBatch(latin, Words, 5, 2, 10); err == nil ||
+  *err != MaxLessThanMin {
+    t.Error("expected max less than min to fail the batch")
+  }
+
+  if _, err := GenerateBatchParallel(latin, CWType(9), 1, 2, 2000,
+  3); err == nil || *err != InvalidOutputType {
+    t.Error("expected an invalid type to fail the parallel batch")
+  }
+
+  if _, err := GenerateBatch(Open(), Words, 1, 2, 10); err == nil {
+    t.Error("expected an empty dictionary to fail the batch")
+  }
+}
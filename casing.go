@@ -0,0 +1,101 @@
+package chinwag
+
+import (
+  "strings"
+  "unicode"
+)
+
+type CWCase uint8
+const (
+  AsGenerated CWCase = 0
+  LowerCase CWCase = 1
+  UpperCase CWCase = 2
+  TitleCase CWCase = 3
+  SentenceCase CWCase = 4
+  CamelCase CWCase = 5
+  PascalCase CWCase = 6
+  SnakeCase CWCase = 7
+  KebabCase CWCase = 8
+  ConstantCase CWCase = 9
+)
+
+// casings by name, as used in struct tags and templates
+var namedCases = map[string]CWCase{
+  "lower": LowerCase,
+  "upper": UpperCase,
+  "title": TitleCase,
+  "sentence": SentenceCase,
+  "camel": CamelCase,
+  "pascal": PascalCase,
+  "snake": SnakeCase,
+  "kebab": KebabCase,
+  "constant": ConstantCase,
+}
+
+// GenerateCased is Generate with the output recased; identifier casings
+// (camel, Pascal, snake, kebab and constant) drop punctuation as well
+func GenerateCased(dict CWDict, kind CWType, min, max uint64,
+casing CWCase) (string, *ErrorType) {
+  result, err := Generate(dict, kind, min, max)
+  if err != nil { return "", err }
+
+  return Recase(result, casing), nil
+}
+
+// Recase converts text to the given casing
+func Recase(text string, casing CWCase) string {
+  switch casing {
+  case LowerCase:
+    return strings.ToLower(text)
+  case UpperCase:
+    return strings.ToUpper(text)
+  case TitleCase:
+    return titleCase(strings.ToLower(text))
+  case SentenceCase:
+    return sentenceCase(text)
+  case CamelCase, PascalCase:
+    words := identifierWords(text)
+
+    for i, word := range words {
+      if i != 0 || casing == PascalCase { words[i] = upperFirst(word) }
+    }
+
+    return strings.Join(words, "")
+  case SnakeCase:
+    return strings.Join(identifierWords(text), "_")
+  case KebabCase:
+    return strings.Join(identifierWords(text), "-")
+  case ConstantCase:
+    return strings.ToUpper(strings.Join(identifierWords(text), "_"))
+  }
+
+  return text
+}
+
+// sentenceCase lower-cases text, then capitalises the start of each
+// sentence
+func sentenceCase(text string) string {
+  runes := []rune(strings.ToLower(text))
+  start := true
+
+  for i, r := range runes {
+    if start && unicode.IsLetter(r) {
+      runes[i] = unicode.ToUpper(r)
+      start = false
+    } else if r == '.' || r == '?' || r == '!' {
+      start = true
+    }
+  }
+
+  return string(runes)
+}
+
+// identifierWords splits text into lower-cased runs of letters and digits,
+// keeping contractions whole
+func identifierWords(text string) []string {
+  text = strings.NewReplacer("'", "", "’", "").Replace(text)
+
+  return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+    return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+  })
+}
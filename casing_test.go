@@ -0,0 +1,88 @@
+package chinwag
+
+import (
+  "regexp"
+  "strings"
+  "testing"
+)
+
+func TestRecase(t *testing.T) {
+  text := "Green Eggs And Who-ville Don't"
+
+  cases := map[CWCase]string{
+    AsGenerated: text,
+    LowerCase: "green eggs and who-ville don't",
+    UpperCase: "GREEN EGGS AND WHO-VILLE DON'T",
+    TitleCase: "Green Eggs and Who-ville Don't",
+    SentenceCase: "Green eggs and who-ville don't",
+    CamelCase: "greenEggsAndWhoVilleDont",
+    PascalCase: "GreenEggsAndWhoVilleDont",
+    SnakeCase: "green_eggs_and_who_ville_dont",
+    KebabCase: "green-eggs-and-who-ville-dont",
+    ConstantCase: "GREEN_EGGS_AND_WHO_VILLE_DONT",
+  }
+
+  for casing, expected := range cases {
+    if got := Recase(text, casing); got != expected {
+      t.Errorf("expected casing %d to give %q, got %q", casing, expected, got)
+    }
+  }
+
+  got := Recase("ONE TWO. three? FOUR!", SentenceCase)
+  if got != "One two. Three? Four!" {
+    t.Errorf("expected each sentence to be capitalised, got %q", got)
+  }
+}
+
+func TestGenerateCased(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+  identifiers := map[CWCase]*regexp.Regexp{
+    CamelCase: regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+    SnakeCase: regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`),
+    KebabCase: regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+    ConstantCase: regexp.MustCompile(`^[A-Z0-9]+(_[A-Z0-9]+)*$`),
+  }
+
+  for casing, pattern := range identifiers {
+    for i := 0; i != 20; i++ {
+      result, err := GenerateCased(seuss, Words, 2, 4, casing)
+      if err != nil { t.Fatal(ErrString(seuss, err)) }
+
+      if !pattern.MatchString(result) {
+        t.Errorf("expected casing %d to give an identifier, got %q", casing,
+        result)
+      }
+    }
+  }
+
+  result, _ := GenerateCased(seuss, Sentences, 2, 2, LowerCase)
+  if result != strings.ToLower(result) {
+    t.Errorf("expected lower case sentences, got %q", result)
+  }
+
+  if _, err := GenerateCased(seuss, Words, 3, 1, SnakeCase); err == nil {
+    t.Error("expected generation errors to pass through")
+  }
+}
+
+func TestCasedTemplateAndFill(t *testing.T) {
+  got := render(t, SeededFuncMap(1), `{{ words 3 | cased "constant" }}`)
+  if got != strings.ToUpper(got) || strings.Contains(got, " ") {
+    t.Errorf("expected a constant identifier, got %q", got)
+  }
+
+  var fixture struct {
+    Class string `chinwag:"words,2,2,case=kebab"`
+  }
+
+  if err := Fill(&fixture); err != nil { t.Fatal(err) }
+  if strings.Count(fixture.Class, "-") < 1 ||
+  fixture.Class != strings.ToLower(fixture.Class) {
+    t.Errorf("expected a kebab-case class, got %q", fixture.Class)
+  }
+
+  var unknown struct { Name string `chinwag:"words,case=shouty"` }
+  if Fill(&unknown) == nil {
+    t.Error("expected an unknown casing to fail")
+  }
+}
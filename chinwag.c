@@ -1,5 +1,15 @@
+//go:build cgo && !chinwag_purego
+
 #include "chinwag.h"
 
+// cancellation flag of the generation running on this thread, if any
+static CW_THREAD_LOCAL volatile int* cw_cancel = NULL;
+
+static bool cancelled()
+{
+  return cw_cancel != NULL && *cw_cancel != 0;
+}
+
 cw_t cw_default_type = CW_WORDS;
 unsigned long cw_default_min_output = 1;
 unsigned long cw_default_max_output = 5;
@@ -32,6 +42,60 @@ char* chinwag
   else if(type == CW_SENTENCES) result = cw_snt_rng(min, max, dict, NULL);
   else if(type == CW_PARAGRAPHS) result = cw_pgf_rng(min, max, dict, NULL);
 
+  if(!result && cancelled() && e) *e = CWERROR_CANCELLED;
+
+  return result;
+}
+
+char* chinwag_seeded
+(cw_t type, unsigned long min, unsigned long max, cwdict_t dict, U32 seed,
+cwerror_t* e)
+{
+  mother_t saved = mother_save();
+  char* result = NULL;
+
+  mother_seed(seed);
+  result = chinwag(type, min, max, dict, e);
+  mother_restore(saved);
+
+  return result;
+}
+
+char* chinwag_batch
+(cw_t type, unsigned long min, unsigned long max, cwdict_t dict, U32 seed,
+unsigned long count, volatile int* cancel, size_t* length, cwerror_t* e)
+{
+  mother_t saved = mother_save();
+  char* result = NULL;
+  size_t used = 0, size = 0;
+
+  mother_seed(seed);
+  cw_cancel = cancel;
+
+  for(unsigned long i = 0; i != count; ++i)
+  {
+    char* s = chinwag(type, min, max, dict, e);
+    if(!s) { free(result); result = NULL; used = 0; break; }
+
+    // grow geometrically, so large batches copy little
+    size_t len = strlen(s) + 1;
+    if(used + len > size)
+    {
+      size = (used + len) * 2;
+      result = (char*)realloc(result, size);
+    }
+
+    memcpy(result + used, s, len);
+    used += len;
+
+    free(s); s = NULL;
+  }
+
+  mother_restore(saved);
+  cw_cancel = NULL;
+
+  if(length) *length = used;
+
   return result;
 }
 
@@ -52,30 +116,44 @@ char* cw_ltr_rng
 
 
   cwdict_t temp = cwdict_open();
-  I32 amount = motherr((U32)min, (U32)max), total = 0; U32 len = 0;
+  I32 amount = motherr((U32)min, (U32)max), total = 0; U32 len = 0,
+  letters = 0;
   char* s = (char*)malloc(CW_SMALL_BUFFER); char* sample = NULL;
   char* result = NULL; char* vowels = "aeiou";
 
   while(amount > 0)
   {
+    if(cancelled())
+    {
+      cwdict_close(temp);
+      free(s); s = NULL;
+
+      return NULL;
+    }
+
     if(amount == 2)
     {
       // SSWS : modifies destination, can't modify source, new string
       sample = sample_substring_with_size(vowels, 1);
       s = strcpy(s, sample); free(sample);
-      len = 1; total += len;
+      len = 1; letters = 1; total += len;
       s[len] = '\0';
     }
     else
     {
       s = strcpy(s, cwdict_sample(dict));
       len = (U32)strlen(s); total += len; s[len] = '\0';
-      if(len > amount || include(s, " ") || include(s, "-")) continue;
+
+      // a phrase counts its letters alone, when drawn at all
+      if(!dict.phrases && phrase(s)) continue;
+      letters = letter_count(s);
+      if(letters > amount) continue;
     }
 
-    amount -= len;
+    amount -= letters;
 
-    // postfixed alteration (append vowel chain/remove trailing character)
+    // postfixed alteration (append vowel chain/remove trailing character);
+    // appends stay within "s", as it is reused for every sample
     if(amount + 1 == 0)
     {
       // SWS : modifies destination, can't modify source, new string
@@ -89,7 +167,7 @@ char* cw_ltr_rng
     {
       // SSWS : modifies destination, can't modify source, new string
       sample = sample_substring_with_size(vowels, 1);
-      s = add_suffix(s, sample); free(sample);
+      s = strcat(s, sample); free(sample);
       len += 1; total += 1; s[len] = '\0';
 
       amount -= 1;
@@ -98,7 +176,7 @@ char* cw_ltr_rng
     {
       // SSWS : modifies destination, can't modify source, new string
       sample = sample_substring_with_size(vowels, 2);
-      s = add_suffix(s, sample); free(sample);
+      s = strcat(s, sample); free(sample);
       len += 2; total += 2; s[len] = '\0';
 
       amount -= 2;
@@ -110,7 +188,7 @@ char* cw_ltr_rng
 
   // post-process dict (pass utility::capitalize function as parameter)
   temp = cwdict_prune(temp, false, false);
-  temp = cwdict_map(temp, capitalize);
+  temp = cwdict_map(temp, dict.phrases ? capitalize_words : capitalize);
   result = cwdict_join(temp, " ");
 
   cwdict_close(temp);
@@ -139,30 +217,44 @@ char* cw_wrd_rng
   char* sample = NULL; char* result = NULL;
   bool invalid = true;
 
+  // words used so far, checked in constant time; entries belong to dict
+  cwset_t used = cwset_open(amount);
+
   // add words to dict
   for(U32 i = 0; i != amount; ++i)
   {
     while(invalid)
     {
+      if(cancelled())
+      {
+        cwdict_close(temp);
+        cwset_close(used);
+
+        return NULL;
+      }
+
       sample = cwdict_sample(dict);
 
-      // valid if no space, hyphen, or duplicate (latter depends on size)
-      if(exclude(sample, " ") && exclude(sample, "-"))
+      // valid if no space, hyphen (bar phrases, when drawn), or duplicate
+      // (latter depends on size)
+      if(dict.phrases || !phrase(sample))
       {
         if(amount > total) invalid = false;
-        else if(cwdict_exclude(temp, sample)) invalid = false;
+        else if(!cwset_include(used, sample)) invalid = false;
       }
     }
 
     temp = cwdict_place_word(temp, sample);
+    used = cwset_add(used, sample);
     invalid = true;
   }
 
   // post-process dict (pass utility::capitalize function as parameter)
-  temp = cwdict_map(temp, capitalize);
+  temp = cwdict_map(temp, dict.phrases ? capitalize_words : capitalize);
   result = cwdict_join(temp, " ");
 
   cwdict_close(temp);
+  cwset_close(used);
 
   return result;
 }
@@ -184,16 +276,23 @@ char* cw_snt_rng
 
   cwdict_t master = cwdict_open(), temp; cwdrow_t selected;
   U32 word_amount = 0, last = 0, amount = motherr((U32)min, (U32)max), now = 0,
-  len = 0, t_minus = 0; U8 comma = 0; I32 punct = 0;
+  len = 0, t_minus = 0, span = 0; U8 comma = 0; I32 punct = 0;
   U32* no_dice = (U32*)malloc(sizeof(U32) * CW_SMALL_BUFFER);
   char* sample = NULL; char* result = NULL; char* s = NULL;
+  char* comma_word = NULL; cwset_t used;
   bool invalid = true;
 
   for(U32 i = 0; i != amount; ++i)
   {
+    if(cancelled()) { cwdict_close(master); free(no_dice); return NULL; }
+
     temp = cwdict_open();
     word_amount = motherr(CW_SENTENCE_MIN_WORD, CW_SENTENCE_MAX_WORD);
 
+    // words placed in this sentence, as placed (the comma word lives in
+    // comma_word until the sentence is done)
+    used = cwset_open(word_amount);
+
     if(word_amount >= 2) comma = (U8)motherr(0, 1);
 
     // if comma, determine commma position after first word)
@@ -211,11 +310,29 @@ char* cw_snt_rng
       selected = dict.drows[now];
       sample = cwdrow_sample(selected);
 
-      while(cwdict_include(temp, sample) && strlen(sample) != now)
-      { sample = cwdict_sample(dict); }
+      // a phrase fills a slot per word, so must fit those left; its
+      // rhythm is that of its last word
+      while((cwset_include(used, sample) && last_word_length(sample) != now)
+      || word_count(sample) > word_amount - j)
+      {
+        if(cancelled())
+        {
+          cwdict_close(temp);
+          cwdict_close(master);
+          cwset_close(used);
+          free(comma_word);
+          free(no_dice);
+
+          return NULL;
+        }
+
+        sample = cwdict_sample(dict);
+      }
+
+      span = word_count(sample);
 
       // add comma (if applicable)
-      if(comma && j == comma - 1)
+      if(comma && j + span - 1 == comma - 1)
       {
         // get local copy of sample for modification
         len = (U32)strlen(sample);
@@ -224,15 +341,19 @@ char* cw_snt_rng
         strcpy(s, sample);
         s[len] = '\0';
 
-        s = add_suffix(s, ",");
-        temp = cwdict_place_word(temp, s);
-
-        free(s);
+        comma_word = add_suffix(s, ",");
+        temp = cwdict_place_word(temp, comma_word);
+        used = cwset_add(used, comma_word);
+      }
+      else
+      {
+        temp = cwdict_place_word(temp, sample);
+        used = cwset_add(used, sample);
       }
-      else temp = cwdict_place_word(temp, sample);
 
       invalid = true;
-      last = now;
+      last = span > 1 ? last_word_length(sample) : now;
+      j += span - 1;
     }
 
     // join temporary dict into a sentence; capitalize first word
@@ -251,6 +372,8 @@ char* cw_snt_rng
     master = cwdict_place_word(master, s);
 
     cwdict_close(temp);
+    cwset_close(used);
+    free(comma_word); comma_word = NULL;
     free(s);
   }
 
@@ -286,6 +409,8 @@ char* cw_pgf_rng
     CW_PARAGRAPH_MAX_SENTENCE);
 
     sentences = cw_snt(sentence_amount, dict, NULL);
+    if(!sentences) { cwdict_close(master); return NULL; }
+
     master = cwdict_place_word(master, sentences);
 
     free(sentences);
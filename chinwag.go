@@ -4,28 +4,30 @@ import (
   "os"
   "fmt"
   "log"
-  "bytes"
-  "unsafe"
-  "reflect"
+  "iter"
+  "slices"
+  "strings"
   "io/ioutil"
 )
 
-/*
-#cgo CFLAGS: -std=c99
-#include "chinwag.h"
-*/
-import "C"
-
 const Version = "1.2.3"
 
-type CWDict C.struct_dictionary_container_type
+// CWDict refers to a dictionary held by the generation engine (C memory, or
+// Go memory under the pure-Go engine). Copies of a CWDict share the same
+// dictionary, and the zero value is an empty, unnamed dictionary. Close
+// releases the entries early; anything left is released once the last copy
+// becomes unreachable.
+type CWDict struct {
+  ref *dictRef
+}
 
 type CWType uint8
 const (
-  Letters CWType = CWType(C.CW_LETTERS)
-  Words CWType = CWType(C.CW_WORDS)
-  Sentences CWType = CWType(C.CW_SENTENCES)
-  Paragraphs CWType = CWType(C.CW_PARAGRAPHS)
+  Letters CWType = 0
+  Words CWType = 1
+  Sentences CWType = 2
+  Paragraphs CWType = 3
+  Titles CWType = 4
 )
 
 type ErrorType string
@@ -37,10 +39,34 @@ const (
   DictTooSmall ErrorType = "CWError.DictTooSmall"
   DictUnsortable ErrorType = "CWError.DictUnsortable"
   DictUnknown ErrorType = "CWError.DictUnknown"
+  UniqueExhausted ErrorType = "CWError.UniqueExhausted"
+  EntropyTooLow ErrorType = "CWError.EntropyTooLow"
+  Cancelled ErrorType = "CWError.Cancelled"
+  Unsatisfiable ErrorType = "CWError.Unsatisfiable"
+  DomainUnreserved ErrorType = "CWError.DomainUnreserved"
 )
 
+// messages for errors raised by this package rather than the engine
+var goErrors = map[ErrorType]string{
+  UniqueExhausted: "unable to find another unique value within the limits",
+  EntropyTooLow: "dict has too few eligible entries for the entropy required",
+  Unsatisfiable: "dict cannot satisfy the request (see Preflight)",
+  DomainUnreserved: "email domains must be reserved (example.com, .org or " +
+  ".net, or under .test, .example, .invalid or .localhost)",
+}
+
+// error (lets an ErrorType travel as a plain error, e.g. out of templates)
+func (err ErrorType) Error() string {
+  return string(err)
+}
+
+// CW_DELIMITERS, which the embedded dictionaries are always read with
+const defaultDelimiters = "\r\n,;:\034"
+
 var (
-  Delimiters = C.GoString(C.CW_DELIMITERS)
+  // used by OpenWithTokens and OpenWithNameAndTokens; prefer LoadOptions,
+  // which sets delimiters per call
+  Delimiters = defaultDelimiters
 
   defaultDict = OpenEmbedded("seuss")
   defaultType = Words
@@ -49,407 +75,198 @@ var (
 )
 
 func Generate(dict CWDict, kind CWType, min, max uint64) (string, *ErrorType) {
-  cwerror := dict.Validate()
+  return generateSeeded(dict, kind, min, max, nextSeed())
+}
+
+// GenerateSeeded is Generate with a fixed seed; the same seed, dictionary
+// and arguments always yield the same output, under either engine
+func GenerateSeeded(dict CWDict, kind CWType, min, max,
+seed uint64) (string, *ErrorType) {
+  return generateSeeded(dict, kind, min, max, uint32(seed ^ (seed >> 32)))
+}
+
+func generateSeeded(dict CWDict, kind CWType, min, max uint64,
+seed uint32) (string, *ErrorType) {
+  cwerror := checkGenerate(dict, kind, min, max)
   if cwerror != nil { return "", cwerror }
 
+  // titles are words, recapitalised as a headline
+  if kind == Titles {
+    result, cwerror := dict.generate(Words, min, max, seed)
+    if cwerror != nil { return "", cwerror }
+
+    return titleCase(result), nil
+  }
+
+  return dict.generate(kind, min, max, seed)
+}
+
+// checkGenerate holds the checks made ahead of any generation
+func checkGenerate(dict CWDict, kind CWType, min, max uint64) *ErrorType {
+  cwerror := dict.Validate()
+  if cwerror != nil { return cwerror }
+
   // TODO : not currently a primary feature in core library
   if max > 10000 {
     var go_error ErrorType = MaxTooHigh
-    return "", &go_error
+    return &go_error
   }
 
-  var err C.cwerror_t
-  result := C.chinwag(C.cw_t(kind), C.ulong(min), C.ulong(max),
-  C.struct_dictionary_container_type(dict), &err)
-
-  if result == nil {
-    var go_error ErrorType
-    if err == C.CWERROR_INVALID_OUTPUT_TYPE {
-      go_error = InvalidOutputType
-    } else if err == C.CWERROR_MIN_LESS_THAN_ONE {
-      go_error = MinLessThanOne
-    } else if err == C.CWERROR_MAX_LESS_THAN_MIN {
-      go_error = MaxLessThanMin
-    } else if err == C.CWERROR_MAX_TOO_HIGH {
-      go_error = MaxTooHigh
-    } else {
-      go_error = DictUnknown
-    }
-
-    return "", &go_error
+  // rather than leave the engine sampling forever
+  if Preflight(dict, kind, min, max) != nil {
+    var go_error ErrorType = Unsatisfiable
+    return &go_error
   }
 
-  return C.GoString(result), nil
+  return nil
 }
 
 func Gen() (string, *ErrorType) {
   return Generate(defaultDict, defaultType, defaultMinOutput, defaultMaxOutput)
 }
 
-func Open() CWDict {
-  return CWDict(C.cwdict_open())
-}
-
 func OpenWithName(name string) CWDict {
-  dict := CWDict(C.cwdict_open())
+  dict := Open()
   dict.SetName(name)
 
   return dict
 }
 
 func OpenEmbedded(name string) CWDict {
-  dict := CWDict(C.cwdict_open())
-
-  delimiters := C.CString(Delimiters)
-  defer C.free(unsafe.Pointer(delimiters))
-
   switch name {
   case "Seussian", "seussian", "seuss", "Seuss":
-    cname := C.CString("Seussian")
-    defer C.free(unsafe.Pointer(cname))
-
-    dict = CWDict(C.cwdict_open_with_name_and_tokens(cname,
-    C.dict_seuss, delimiters))
+    return openEmbedded("Seussian")
   case "Latin", "latin":
-    cname := C.CString("Latin")
-    defer C.free(unsafe.Pointer(cname))
-
-    dict = CWDict(C.cwdict_open_with_name_and_tokens(cname,
-    C.dict_latin, delimiters))
+    return openEmbedded("Latin")
+  case "FirstNames", "firstnames", "first":
+    return openEmbedded("FirstNames")
+  case "LastNames", "lastnames", "last":
+    return openEmbedded("LastNames")
   default:
-    cname := C.CString(name)
-    defer C.free(unsafe.Pointer(cname))
-    dict.name = cname
+    return OpenWithName(name)
   }
-
-  return dict
 }
 
 func OpenWithTokens(filename string) CWDict {
-  dict := CWDict(C.cwdict_open())
-
-  delimiters := C.CString(Delimiters)
-  defer C.free(unsafe.Pointer(delimiters))
-
   contents, err := ioutil.ReadFile(filename)
   if err != nil { log.Fatal(err) }
 
-  ccontents := C.CString(string(contents))
-  defer C.free(unsafe.Pointer(ccontents))
-  dict = CWDict(C.cwdict_open_with_tokens(ccontents, delimiters))
-
-  return dict
+  return openTokens("", string(contents), Delimiters)
 }
 
 func OpenWithNameAndTokens(name, filename string) CWDict {
-  dict := CWDict(C.cwdict_open())
-
-  delimiters := C.CString(Delimiters)
-  defer C.free(unsafe.Pointer(delimiters))
-
   contents, err := ioutil.ReadFile(filename)
   if err != nil { log.Fatal(err) }
 
-  ccontents := C.CString(string(contents))
-  defer C.free(unsafe.Pointer(ccontents))
-
-  cname := C.CString(name)
-  defer C.free(unsafe.Pointer(cname))
-
-  dict = CWDict(C.cwdict_open_with_name_and_tokens(cname, ccontents,
-  delimiters))
-
-  return dict
-}
-
-func (dict CWDict) Name() string {
-  return C.GoString(dict.name)
-}
-
-func (dict *CWDict) SetName(name string) *CWDict {
-  dict.name = C.CString(name)
-  return dict
-}
-
-func (dict *CWDict) AppendWord(word string) *CWDict {
-  cword := C.CString(word)
-  defer C.free(unsafe.Pointer(cword))
-  *dict =
-  CWDict(C.cwdict_place_word(C.struct_dictionary_container_type(*dict),
-  cword))
-
-  return dict
+  return openTokens(name, string(contents), Delimiters)
 }
 
 func (dict *CWDict) AppendWords(words ...string) *CWDict {
-  for _, word := range words {
-    cword := C.CString(word)
-    defer C.free(unsafe.Pointer(cword))
-    *dict =
-    CWDict(C.cwdict_place_word(C.struct_dictionary_container_type(*dict),
-    cword))
-  }
-
-  return dict
+  return dict.AppendSlice(words)
 }
 
 func (dict *CWDict) AppendSlice(words []string) *CWDict {
-  for _, word := range words {
-    cword := C.CString(word)
-    defer C.free(unsafe.Pointer(cword))
-    *dict =
-    CWDict(C.cwdict_place_word(C.struct_dictionary_container_type(*dict),
-    cword))
-  }
-
-  return dict
-}
-
-func (dict *CWDict) PlaceWord(word string) *CWDict {
-  cword := C.CString(word)
-  defer C.free(unsafe.Pointer(cword))
-  *dict =
-  CWDict(C.cwdict_place_word_strict(C.struct_dictionary_container_type(*dict),
-  cword))
+  for _, word := range words { dict.AppendWord(word) }
 
   return dict
 }
 
 func (dict *CWDict) PlaceWords(words ...string) *CWDict {
-  for _, word := range words {
-    cword := C.CString(word)
-    defer C.free(unsafe.Pointer(cword))
-    *dict =
-    CWDict(C.cwdict_place_word_strict(C.struct_dictionary_container_type(*dict),
-    cword))
-  }
-
-  return dict
+  return dict.PlaceSlice(words)
 }
 
 func (dict *CWDict) PlaceSlice(words []string) *CWDict {
-  for _, word := range words {
-    cword := C.CString(word)
-    defer C.free(unsafe.Pointer(cword))
-    *dict =
-    CWDict(C.cwdict_place_word_strict(C.struct_dictionary_container_type(*dict),
-    cword))
-  }
+  for _, word := range words { dict.PlaceWord(word) }
 
   return dict
 }
 
-func (dict *CWDict) Sort() {
-  *dict = CWDict(C.cwdict_sort(C.struct_dictionary_container_type(*dict)))
-}
-
-func (dict CWDict) IsSorted() bool {
-  return bool(C.struct_dictionary_container_type(dict).sorted)
-}
-
-func (dict *CWDict) Prune() {
-  *dict = CWDict(C.cwdict_prune(C.struct_dictionary_container_type(*dict),
-  false, false))
-}
-
-func (dict *CWDict) Clean() {
-  // *dict = CWDict(C.cwdict_clean(C.struct_dictionary_container_type(*dict)))
-  *dict = CWDict(C.cwdict_prune(C.struct_dictionary_container_type(*dict),
-  true, false))
-}
-
-// in-place modification
-func (dict *CWDict) Tweak(fn func(string)string) *CWDict {
-  container := C.struct_dictionary_container_type(*dict)
-
-  row_count := int(container.count)
-
-  var rows_raw *C.struct_dictionary_type = container.drows
-  rows_hdr := reflect.SliceHeader {
-    Data: uintptr(unsafe.Pointer(rows_raw)),
-    Len: int(row_count),
-    Cap: int(row_count),
-  }
-  rows := *(*[]C.struct_dictionary_type)(unsafe.Pointer(&rows_hdr))
-
-  for _, r := range rows {
-    word_count := int(r.count)
-
-    var words_raw **C.char = r.words
-    words_hdr := reflect.SliceHeader {
-      Data: uintptr(unsafe.Pointer(words_raw)),
-      Len: int(word_count),
-      Cap: int(word_count),
-    }
-    words := *(*[]*C.char)(unsafe.Pointer(&words_hdr))
-
-    for j, w := range words {
-      mod := C.GoString(w); mod = fn(mod)
-      words[j] = C.CString(mod)
-    }
-  }
-
-  return dict
-}
-
-func (dict CWDict) Clone() CWDict {
-  return CWDict(C.cwdict_clone(C.struct_dictionary_container_type(dict)))
-}
-
 func (dict CWDict) Dup() CWDict {
-  return CWDict(C.cwdict_dup(C.struct_dictionary_container_type(dict)))
-}
-
-// exclude
-func (dict CWDict) Exclude(word string) bool {
-  return bool(C.cwdict_exclude(C.struct_dictionary_container_type(dict),
-  C.CString(word)))
-}
-
-// include
-func (dict CWDict) Include(word string) bool {
-  return bool(C.cwdict_include(C.struct_dictionary_container_type(dict),
-  C.CString(word)))
-}
-
-// validate
-func (dict CWDict) Validate() *ErrorType {
-  var err C.cwerror_t
-  if !C.cwdict_valid(C.struct_dictionary_container_type(dict), &err) {
-    var go_error ErrorType
-    if err == C.CWERROR_DICT_TOO_SMALL {
-      go_error = DictTooSmall
-    } else if err == C.CWERROR_DICT_UNSORTABLE {
-      go_error = DictUnsortable
-    } else {
-      go_error = DictUnknown
-    }
-
-  return &go_error
-  }
-
-  return nil
-}
-
-// equal
-func (dict CWDict) Equal(against CWDict) bool {
-  return bool(C.cwdict_equal(C.struct_dictionary_container_type(dict),
-  C.struct_dictionary_container_type(dict)))
-}
-
-// inequal
-func (dict CWDict) Inequal(against CWDict) bool {
-  return bool(C.cwdict_inequal(C.struct_dictionary_container_type(dict),
-  C.struct_dictionary_container_type(dict)))
-}
-
-func (dict CWDict) Length() uint64 {
-  return uint64(C.cwdict_length(C.struct_dictionary_container_type(dict)))
+  return dict.Clone()
 }
 
 func (dict CWDict) Size() uint64 {
-  return uint64(C.cwdict_size(C.struct_dictionary_container_type(dict)))
+  return dict.Length()
 }
 
 func (dict CWDict) Count() uint64 {
-  return uint64(C.cwdict_size(C.struct_dictionary_container_type(dict)))
-}
-
-// largest
-func (dict CWDict) Largest() uint32 {
-  return uint32(C.cwdict_largest(C.struct_dictionary_container_type(dict)))
-}
-
-// sample
-func (dict CWDict) Sample() string {
-  return C.GoString(C.cwdict_sample(C.struct_dictionary_container_type(dict)))
+  return dict.Length()
 }
 
 // join
 func (dict CWDict) Join(joiner string) string {
-  var result bytes.Buffer
-  container := C.struct_dictionary_container_type(dict)
+  var rows []string
 
-  row_count := int(container.count)
+  dict.eachRow(func(_ int, words []string) bool {
+    rows = append(rows, strings.Join(words, joiner))
+    return true
+  })
 
-  var rows_raw *C.struct_dictionary_type = container.drows
-  rows_hdr := reflect.SliceHeader {
-    Data: uintptr(unsafe.Pointer(rows_raw)),
-    Len: int(row_count),
-    Cap: int(row_count),
-  }
-  rows := *(*[]C.struct_dictionary_type)(unsafe.Pointer(&rows_hdr))
+  return strings.Join(rows, joiner)
+}
 
-  for i, r := range rows {
-    word_count := int(r.count)
+// words
+func (dict CWDict) Words() []string {
+  result := make([]string, 0, dict.Length())
 
-    var words_raw **C.char = r.words
-    words_hdr := reflect.SliceHeader {
-      Data: uintptr(unsafe.Pointer(words_raw)),
-      Len: int(word_count),
-      Cap: int(word_count),
-    }
-    words := *(*[]*C.char)(unsafe.Pointer(&words_hdr))
+  dict.eachRow(func(_ int, words []string) bool {
+    result = append(result, words...)
+    return true
+  })
 
-    for j, w := range words {
-      result.WriteString(C.GoString(w))
+  return result
+}
 
-      if j < word_count - 1 { result.WriteString(joiner) }
-    }
+// row (every entry stored in a row of the given length)
+func (dict CWDict) Row(length int) []string {
+  var result []string
 
-    if i < row_count - 1 { result.WriteString(joiner) }
-  }
+  dict.eachRow(func(largest int, words []string) bool {
+    if largest == length { result = append(result, words...) }
+    return true
+  })
 
-  return result.String()
-}
-
-// close
-func (dict *CWDict) Close() *CWDict {
-  *dict = CWDict(C.cwdict_close(C.struct_dictionary_container_type(*dict)))
-  return dict
+  return result
 }
 
-func (dict CWDict) String() string {
-  var result bytes.Buffer
-  container := C.struct_dictionary_container_type(dict)
-
-  row_count := int(container.count)
+// all (lazily walks entries, in row order)
+func (dict CWDict) All() iter.Seq[string] {
+  return func(yield func(string) bool) {
+    dict.eachRow(func(_ int, words []string) bool {
+      for _, w := range words {
+        if !yield(w) { return false }
+      }
 
-  result.WriteString("[")
-  var rows_raw *C.struct_dictionary_type = container.drows
-  rows_hdr := reflect.SliceHeader {
-    Data: uintptr(unsafe.Pointer(rows_raw)),
-    Len: int(row_count),
-    Cap: int(row_count),
+      return true
+    })
   }
-  rows := *(*[]C.struct_dictionary_type)(unsafe.Pointer(&rows_hdr))
-
-  for i, r := range rows {
-    result.WriteString("[")
+}
 
-    word_count := int(r.count)
+// lengths (populated row sizes, ascending)
+func (dict CWDict) Lengths() []int {
+  var result []int
 
-    var words_raw **C.char = r.words
-    words_hdr := reflect.SliceHeader {
-      Data: uintptr(unsafe.Pointer(words_raw)),
-      Len: int(word_count),
-      Cap: int(word_count),
+  dict.eachRow(func(largest int, words []string) bool {
+    if len(words) > 0 && !slices.Contains(result, largest) {
+      result = append(result, largest)
     }
-    words := *(*[]*C.char)(unsafe.Pointer(&words_hdr))
 
-    for j, w := range words {
-      result.WriteString(C.GoString(w))
+    return true
+  })
 
-      if j < word_count - 1 { result.WriteString(", ") }
-    }
+  slices.Sort(result)
+  return result
+}
 
-    result.WriteString("]")
+func (dict CWDict) String() string {
+  var rows []string
 
-    if i < row_count - 1 { result.WriteString(", ") }
-  }
+  dict.eachRow(func(_ int, words []string) bool {
+    rows = append(rows, "[" + strings.Join(words, ", ") + "]")
+    return true
+  })
 
-  result.WriteString("]")
-  return result.String()
+  return "[" + strings.Join(rows, ", ") + "]"
 }
 
 func (dict CWDict) Print() {
@@ -457,40 +274,11 @@ func (dict CWDict) Print() {
 }
 
 func ErrString(dict CWDict, err *ErrorType) string {
-  var result string
-
-  switch *err {
-  case InvalidOutputType:
-    msg := C.GoString(C.cwerror_string(C.struct_dictionary_container_type(dict),
-    C.CWERROR_INVALID_OUTPUT_TYPE))
-    result = fmt.Sprintf("%s : %s", *err, msg)
-  case MinLessThanOne:
-    msg := C.GoString(C.cwerror_string(C.struct_dictionary_container_type(dict),
-    C.CWERROR_MIN_LESS_THAN_ONE))
-    result = fmt.Sprintf("%s : %s", *err, msg)
-  case MaxLessThanMin:
-    msg := C.GoString(C.cwerror_string(C.struct_dictionary_container_type(dict),
-    C.CWERROR_MAX_LESS_THAN_MIN))
-    result = fmt.Sprintf("%s : %s", *err, msg)
-  case MaxTooHigh:
-    msg := C.GoString(C.cwerror_string(C.struct_dictionary_container_type(dict),
-    C.CWERROR_MAX_TOO_HIGH))
-    result = fmt.Sprintf("%s : %s", *err, msg)
-  case DictTooSmall:
-    msg := C.GoString(C.cwerror_string(C.struct_dictionary_container_type(dict),
-    C.CWERROR_DICT_TOO_SMALL))
-    result = fmt.Sprintf("%s : %s", *err, msg)
-  case DictUnsortable:
-    msg := C.GoString(C.cwerror_string(C.struct_dictionary_container_type(dict),
-    C.CWERROR_DICT_UNSORTABLE))
-    result = fmt.Sprintf("%s : %s", *err, msg)
-  default:
-    msg := C.GoString(C.cwerror_string(C.struct_dictionary_container_type(dict),
-    C.CWERROR_DICT_UNKNOWN))
-    result = fmt.Sprintf("%s : %s", *err, msg)
+  if message, ok := goErrors[*err]; ok {
+    return fmt.Sprintf("%s : %s", *err, message)
   }
 
-  return result
+  return fmt.Sprintf("%s : %s", *err, dict.errMessage(*err))
 }
 
 func Warn(dict CWDict, err *ErrorType) {
@@ -38,6 +38,7 @@ enum {
   CWERROR_DICT_TOO_SMALL        =   4,
   CWERROR_DICT_UNSORTABLE       =   5,
   CWERROR_DICT_UNKNOWN          =   6,
+  CWERROR_CANCELLED             =   7,
 };
 typedef unsigned long cwerror_t;
 
@@ -54,6 +55,7 @@ typedef struct dictionary_type {
 // dictionary (row container)
 typedef struct dictionary_container_type {
   bool sorted;
+  bool phrases; // words and letters draw phrases too
   unsigned long count;
   cwdrow_t* drows;
   char* name;
@@ -61,6 +63,8 @@ typedef struct dictionary_container_type {
 
 #include "seuss.h"
 #include "latin.h"
+#include "firstnames.h"
+#include "lastnames.h"
 
 #include "ingredient.h"
 #include "generator.h"
@@ -69,6 +73,7 @@ typedef struct dictionary_container_type {
 #include "config.h"
 #include "error.h"
 #include "dict.h"
+#include "set.h"
 #include "args.h"
 
 // external statics
@@ -79,6 +84,18 @@ extern unsigned long cw_default_max_output;
 char* chinwag
 (cw_t type, unsigned long min, unsigned long max, cwdict_t dict, cwerror_t* e);
 
+char* chinwag_seeded
+(cw_t type, unsigned long min, unsigned long max, cwdict_t dict, U32 seed,
+cwerror_t* e);
+
+// count outputs from one seed, packed into a single buffer of consecutive
+// NUL-terminated strings; length receives the buffer's size. generation
+// stops (with CWERROR_CANCELLED) once *cancel becomes non-zero, as checked
+// between samples, words and sentences; cancel may be NULL
+char* chinwag_batch
+(cw_t type, unsigned long min, unsigned long max, cwdict_t dict, U32 seed,
+unsigned long count, volatile int* cancel, size_t* length, cwerror_t* e);
+
 char* cw_ltr_rng
 (unsigned long min, unsigned long max, cwdict_t dict, cwerror_t* e);
 
@@ -66,6 +66,31 @@ func TestChinwagGenerateParagraphs(t *testing.T) {
   }
 }
 
+func TestChinwagGenerateSeeded(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+
+  for kind := Letters; kind <= Paragraphs; kind++ {
+    first, err := GenerateSeeded(seuss, kind, 2, 4, 42)
+    if err != nil { t.Fatal(ErrString(seuss, err)) }
+
+    second, _ := GenerateSeeded(seuss, kind, 2, 4, 42)
+    if first != second {
+      t.Errorf("expected seeded output to repeat, got %q and %q", first, second)
+    }
+
+    other, _ := GenerateSeeded(seuss, kind, 2, 4, 43)
+    if first == other {
+      t.Errorf("expected another seed to differ, got %q twice", first)
+    }
+  }
+
+  // both engines share one generator, so this holds with or without cgo
+  got, _ := GenerateSeeded(seuss, Words, 3, 3, 1)
+  if got != "In Can Frequently" {
+    t.Errorf("expected seeded words to match across engines, got %q", got)
+  }
+}
+
 func TestChinwagGen(t *testing.T) {
   var amount uint64 = 30
   defaultType = Letters
@@ -216,6 +241,75 @@ func TestChinwagJoin(t *testing.T) {
   }
 }
 
+func TestChinwagWords(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+  words := seuss.Words()
+
+  if uint64(len(words)) != seuss.Length() {
+    t.Errorf("expected %d words, got %d", seuss.Length(), len(words))
+  }
+
+  if strings.Join(words, " ") != seuss.Join(" ") {
+    t.Error("expected \"seuss\" words to follow row order")
+  }
+
+  if len(Open().Words()) != 0 {
+    t.Error("expected blank dict to have no words")
+  }
+}
+
+func TestChinwagRow(t *testing.T) {
+  small_mess := Open()
+  small_mess.PlaceWords("this", "is", "a", "quick", "test", "of", "rows")
+
+  if strings.Join(small_mess.Row(4), " ") != "this test rows" {
+    t.Errorf("expected \"this test rows\", got %v", small_mess.Row(4))
+  }
+
+  if len(small_mess.Row(3)) != 0 {
+    t.Errorf("expected no words of length 3, got %v", small_mess.Row(3))
+  }
+}
+
+func TestChinwagAll(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+  var collected []string
+
+  for word := range seuss.All() {
+    collected = append(collected, word)
+  }
+
+  if strings.Join(collected, ",") != seuss.Join(",") {
+    t.Error("expected \"seuss\" iteration to match its words")
+  }
+
+  for word := range seuss.All() {
+    if word != collected[0] {
+      t.Errorf("expected first word %s, got %s", collected[0], word)
+    }
+
+    break
+  }
+}
+
+func TestChinwagLengths(t *testing.T) {
+  small_mess := Open()
+  small_mess.AppendWords("quick", "a", "test", "this", "is")
+
+  got := small_mess.Lengths()
+  expected := []int{1, 2, 4, 5}
+
+  if len(got) != len(expected) {
+    t.Fatalf("expected lengths %v, got %v", expected, got)
+  }
+
+  for i := range expected {
+    if got[i] != expected[i] {
+      t.Errorf("expected lengths %v, got %v", expected, got)
+    }
+  }
+}
+
 func TestChinwagSort(t *testing.T) {
   small_mess := Open()
   small_mess.AppendWords("this", "is", "a", "quick", "test", "of", "sorting")
@@ -341,7 +435,7 @@ func TestChinwagSample(t *testing.T) {
   var sample string = seuss.Sample()
 
   if seuss.Exclude(sample) {
-    t.Error("expected \"seuss\" to include sample (%s)", sample)
+    t.Errorf("expected \"seuss\" to include sample (%s)", sample)
   }
 }
 
@@ -367,7 +461,7 @@ func TestChinwagValidate(t *testing.T) {
   }
 
   if small_mess.Length() < 300 {
-    t.Error("expected >300, got %d", small_mess.Length())
+    t.Errorf("expected >300, got %d", small_mess.Length())
   }
 
   e_3 := small_mess.Validate()
@@ -0,0 +1,78 @@
+// Command chinwag inspects dictionaries from the command-line.
+//
+//   chinwag stats [-name NAME] SOURCE...
+//
+// Each SOURCE is either the path to a token file, or the name of an
+// embedded dictionary ("Seussian", "Latin", "FirstNames" or "LastNames").
+// A SOURCE that is neither is reported, and chinwag exits with status 1.
+package main
+
+import (
+  "os"
+  "fmt"
+  "flag"
+
+  "github.com/vulcancreative/chinwag-go"
+)
+
+func usage() {
+  fmt.Fprintf(os.Stderr, "usage: chinwag stats [-name NAME] SOURCE...\n")
+  os.Exit(2)
+}
+
+func open(source, name string) (chinwag.CWDict, error) {
+  if _, err := os.Stat(source); err != nil {
+    // unknown names open empty, rather than as an embedded dictionary
+    dict := chinwag.OpenEmbedded(source)
+    if dict.Length() != 0 { return dict, nil }
+
+    dict.Close()
+    return dict, err
+  }
+
+  if name == "" { name = source }
+  return chinwag.OpenWithNameAndTokens(name, source), nil
+}
+
+func stats(args []string) {
+  flags := flag.NewFlagSet("stats", flag.ExitOnError)
+  name := flags.String("name", "", "name given to file-based dictionaries")
+  flags.Parse(args)
+
+  if flags.NArg() == 0 { usage() }
+  failed := false
+
+  for i, source := range flags.Args() {
+    dict, err := open(source, *name)
+    if err != nil {
+      fmt.Fprintf(os.Stderr, "chinwag: %s\n", err)
+      failed = true
+      continue
+    }
+
+    if i > 0 { fmt.Println() }
+    fmt.Printf("dict        : %s\n", dict.Name())
+    fmt.Print(dict.Stats())
+
+    if err := dict.Validate(); err != nil {
+      fmt.Printf("valid       : no (%s)\n", chinwag.ErrString(dict, err))
+    } else {
+      fmt.Printf("valid       : yes\n")
+    }
+
+    dict.Close()
+  }
+
+  if failed { os.Exit(1) }
+}
+
+func main() {
+  if len(os.Args) < 2 { usage() }
+
+  switch os.Args[1] {
+  case "stats":
+    stats(os.Args[2:])
+  default:
+    usage()
+  }
+}
@@ -0,0 +1,190 @@
+package chinwag
+
+import (
+  "fmt"
+  "sync"
+  "strings"
+)
+
+// Address is a fake postal address
+type Address struct {
+  Number string
+  Street string
+  City string
+  PostalCode string
+}
+
+// CompositeOptions configures a Composer. Dict defaults to the Seussian
+// dictionary, and a non-zero Seed makes the sequence reproducible.
+type CompositeOptions struct {
+  Dict CWDict
+  Seed uint64
+}
+
+// Composer assembles structurally realistic values (addresses, cities,
+// companies and products) out of dictionary words; it is safe for
+// concurrent use
+type Composer struct {
+  lock sync.Mutex
+  nouns, adjectives []string
+  src *source
+}
+
+var (
+  streetSuffixes = []string{"Street", "Avenue", "Lane", "Road", "Way",
+  "Court", "Drive", "Place", "Boulevard", "Terrace", "Row", "Crescent",
+  "Close", "Square", "Hill"}
+  cityPrefixes = []string{"North", "South", "East", "West", "New", "Old",
+  "Port", "Mount", "Lake", "Fort"}
+  citySuffixes = []string{"ville", "ton", "burg", "ford", "field", "port",
+  "wood", "dale", "bury", "mouth"}
+  companyNouns = []string{"Widgets", "Works", "Holdings", "Industries",
+  "Labs", "Systems", "Supply", "Trading", "Partners", "Foundry", "Goods",
+  "Outfitters", "Logistics", "Media"}
+  companySuffixes = []string{"LLC", "Inc.", "Ltd.", "Co.", "Group",
+  "& Sons", "Corp."}
+  productModels = []string{"Pro", "Max", "Mini", "Plus", "Lite", "XL",
+  "2000", "3000", "One", "Air"}
+
+  // fallbacks for dictionaries with too few adjective-like words
+  productAdjectives = []string{"Deluxe", "Classic", "Cozy", "Jumbo",
+  "Sparkly", "Rugged", "Nimble", "Quiet", "Mighty", "Fuzzy", "Bouncy",
+  "Tidy", "Zesty", "Handy", "Sturdy", "Silky"}
+
+  // endings marking adjectives in English and Latin
+  adjectiveEndings = []string{"y", "ful", "ous", "ish", "ic", "al", "less",
+  "ive", "able", "ible", "osus", "alis", "ilis", "ivus", "inus"}
+)
+
+// adjective-like words a dictionary needs before they replace the fallbacks
+const minAdjectives = 16
+
+func NewComposer(opts CompositeOptions) *Composer {
+  dict := opts.Dict
+  if dict.Length() == 0 { dict = defaultDict }
+
+  composer := &Composer{}
+  seen := map[string]bool{}
+
+  for word := range dict.All() {
+    word = slugify(word)
+    if len(word) < 3 || seen[word] { continue }
+
+    seen[word] = true
+    word = upperFirst(word)
+
+    if isAdjective(word) {
+      composer.adjectives = append(composer.adjectives, word)
+    } else {
+      composer.nouns = append(composer.nouns, word)
+    }
+  }
+
+  if len(composer.adjectives) < minAdjectives {
+    composer.nouns = append(composer.nouns, composer.adjectives...)
+    composer.adjectives = productAdjectives
+  }
+
+  if len(composer.nouns) == 0 { composer.nouns = productAdjectives }
+
+  seed := nextSeed()
+  if opts.Seed != 0 { seed = uint32(opts.Seed ^ (opts.Seed >> 32)) }
+  composer.src = newSource(seed)
+
+  return composer
+}
+
+func isAdjective(word string) bool {
+  for _, ending := range adjectiveEndings {
+    if len(word) > len(ending) + 2 && strings.HasSuffix(word, ending) {
+      return true
+    }
+  }
+
+  return false
+}
+
+// GenerateAddress creates a single address from the dictionary
+func GenerateAddress(dict CWDict) Address {
+  return NewComposer(CompositeOptions{Dict: dict}).Address()
+}
+
+// GenerateCity creates a single city name from the dictionary
+func GenerateCity(dict CWDict) string {
+  return NewComposer(CompositeOptions{Dict: dict}).City()
+}
+
+// GenerateCompany creates a single company name from the dictionary
+func GenerateCompany(dict CWDict) string {
+  return NewComposer(CompositeOptions{Dict: dict}).Company()
+}
+
+// GenerateProduct creates a single product name from the dictionary
+func GenerateProduct(dict CWDict) string {
+  return NewComposer(CompositeOptions{Dict: dict}).Product()
+}
+
+// address (a number, a street name and suffix, a city and a postal code)
+func (c *Composer) Address() Address {
+  c.lock.Lock()
+  defer c.lock.Unlock()
+
+  return Address{
+    Number: fmt.Sprint(c.src.motherr(1, 9999)),
+    Street: c.pick(c.nouns) + " " + c.pick(streetSuffixes),
+    City: c.city(),
+    PostalCode: fmt.Sprintf("%05d", c.src.motherr(501, 99950)),
+  }
+}
+
+// city (e.g. Grinchville or Port Sneetch)
+func (c *Composer) City() string {
+  c.lock.Lock()
+  defer c.lock.Unlock()
+
+  return c.city()
+}
+
+// company (e.g. Whoville Widgets LLC)
+func (c *Composer) Company() string {
+  c.lock.Lock()
+  defer c.lock.Unlock()
+
+  name := c.pick(c.nouns)
+  switch c.src.motherr(0, 2) {
+  case 1:
+    name = c.city()
+  case 2:
+    name += " & " + c.pick(c.nouns)
+  }
+
+  return name + " " + c.pick(companyNouns) + " " + c.pick(companySuffixes)
+}
+
+// product (an adjective and a noun, sometimes with a model)
+func (c *Composer) Product() string {
+  c.lock.Lock()
+  defer c.lock.Unlock()
+
+  name := c.pick(c.adjectives) + " " + c.pick(c.nouns)
+  if c.src.motherr(0, 2) == 0 { name += " " + c.pick(productModels) }
+
+  return name
+}
+
+func (c *Composer) city() string {
+  if c.src.motherr(0, 3) == 0 {
+    return c.pick(cityPrefixes) + " " + c.pick(c.nouns)
+  }
+
+  return c.pick(c.nouns) + c.pick(citySuffixes)
+}
+
+func (c *Composer) pick(from []string) string {
+  return from[c.src.motherr(0, uint32(len(from) - 1))]
+}
+
+func (address Address) String() string {
+  return fmt.Sprintf("%s %s, %s %s", address.Number, address.Street,
+  address.City, address.PostalCode)
+}
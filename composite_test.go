@@ -0,0 +1,75 @@
+package chinwag
+
+import (
+  "regexp"
+  "strings"
+  "testing"
+)
+
+func TestComposerAddress(t *testing.T) {
+  composer := NewComposer(CompositeOptions{})
+  pattern := regexp.MustCompile(`^[0-9]{1,4} [A-Z][a-z0-9]+ [A-Z][a-z]+, ` +
+  `[A-Z][A-Za-z0-9 ]+ [0-9]{5}$`)
+
+  for i := 0; i != 50; i++ {
+    address := composer.Address()
+
+    if !pattern.MatchString(address.String()) {
+      t.Errorf("expected a street address, got %q", address.String())
+    }
+  }
+}
+
+func TestComposerCompanyAndProduct(t *testing.T) {
+  composer := NewComposer(CompositeOptions{Dict: latin})
+
+  for i := 0; i != 50; i++ {
+    company := composer.Company()
+    suffixed := false
+
+    for _, suffix := range companySuffixes {
+      if strings.HasSuffix(company, " " + suffix) { suffixed = true }
+    }
+
+    if !suffixed {
+      t.Errorf("expected a company with a legal suffix, got %q", company)
+    }
+
+    product := strings.Fields(composer.Product())
+    if len(product) < 2 || !isAdjective(strings.ToLower(product[0])) {
+      t.Errorf("expected an adjective and a noun, got %q", product)
+    }
+  }
+}
+
+func TestComposerFallbacks(t *testing.T) {
+  small := Open()
+  small.PlaceWords("widget", "gadget", "sprocket")
+
+  product := GenerateProduct(small)
+  words := strings.Fields(product)
+
+  found := false
+  for _, adjective := range productAdjectives {
+    if words[0] == adjective { found = true }
+  }
+
+  if !found || !small.Include(strings.ToLower(words[1])) {
+    t.Errorf("expected a fallback adjective and a dictionary noun, got %q",
+    product)
+  }
+
+  if city := GenerateCity(Open()); city == "" {
+    t.Error("expected an empty dictionary to fall back to the default")
+  }
+}
+
+func TestComposerSeeded(t *testing.T) {
+  first := NewComposer(CompositeOptions{Seed: 21})
+  second := NewComposer(CompositeOptions{Seed: 21})
+
+  if first.Address() != second.Address() ||
+  first.Company() != second.Company() || first.City() != second.City() {
+    t.Error("expected the same seed to repeat")
+  }
+}
@@ -1,3 +1,5 @@
+//go:build cgo && !chinwag_purego
+
 #include "config.h"
 
 const char* const CW_VERSION = "1.2.3";
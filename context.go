@@ -0,0 +1,34 @@
+package chinwag
+
+import (
+  "context"
+)
+
+// GenerateContext is Generate, abandoned with ctx.Err() once ctx is done;
+// the engine checks between samples, words and sentences, so even a
+// dictionary it can never satisfy gives way. Other failures are returned
+// as an ErrorType.
+func GenerateContext(ctx context.Context, dict CWDict, kind CWType, min,
+max uint64) (string, error) {
+  result, err := GenerateBatchContext(ctx, dict, kind, min, max, 1)
+  if err != nil { return "", err }
+
+  return result[0], nil
+}
+
+// GenerateBatchContext is GenerateBatch, abandoned with ctx.Err() once ctx
+// is done
+func GenerateBatchContext(ctx context.Context, dict CWDict, kind CWType, min,
+max uint64, n int) ([]string, error) {
+  if err := ctx.Err(); err != nil { return nil, err }
+
+  result, cwerror := generateBatch(dict, kind, min, max, n, 1, nextSeed(),
+  ctx.Done())
+
+  if cwerror != nil {
+    if *cwerror == Cancelled && ctx.Err() != nil { return nil, ctx.Err() }
+    return nil, *cwerror
+  }
+
+  return result, nil
+}
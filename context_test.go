@@ -0,0 +1,56 @@
+package chinwag
+
+import (
+  "time"
+  "errors"
+  "context"
+  "testing"
+)
+
+func TestGenerateContext(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+
+  result, err := GenerateContext(context.Background(), seuss, Words, 2, 2)
+  if err != nil || result == "" {
+    t.Fatalf("expected output, got %q (%v)", result, err)
+  }
+
+  ctx, cancel := context.WithCancel(context.Background())
+  cancel()
+
+  if _, err := GenerateContext(ctx, seuss, Words, 2, 2); err != context.Canceled {
+    t.Errorf("expected a cancelled context to refuse, got %v", err)
+  }
+
+  _, err = GenerateContext(context.Background(), seuss, Words, 3, 1)
+  if !errors.Is(err, MaxLessThanMin) {
+    t.Errorf("expected generation errors to pass through, got %v", err)
+  }
+}
+
+func TestGenerateContextDeadline(t *testing.T) {
+  ctx, cancel := context.WithTimeout(context.Background(),
+  50 * time.Millisecond)
+  defer cancel()
+
+  start := time.Now()
+  _, err := GenerateContext(ctx, latin, Paragraphs, 10000, 10000)
+
+  if err != context.DeadlineExceeded {
+    t.Errorf("expected the deadline to stop generation, got %v", err)
+  }
+
+  if elapsed := time.Since(start); elapsed > 5 * time.Second {
+    t.Errorf("expected generation to stop promptly, took %s", elapsed)
+  }
+}
+
+func TestGenerateBatchContextCancel(t *testing.T) {
+  ctx, cancel := context.WithCancel(context.Background())
+  time.AfterFunc(20 * time.Millisecond, cancel)
+
+  _, err := GenerateBatchContext(ctx, latin, Paragraphs, 50, 100, 100000)
+  if err != context.Canceled {
+    t.Errorf("expected cancellation to stop the batch, got %v", err)
+  }
+}
@@ -0,0 +1,182 @@
+package chinwag
+
+import (
+  "io"
+  "os"
+  "sort"
+  "strings"
+  "unicode"
+  "unicode/utf8"
+)
+
+// CorpusOptions configures a Corpus. Lowercase folds every word to lower
+// case, save (with KeepProperNouns) those only ever seen capitalised away
+// from the start of a sentence. Dict keeps words seen at least MinCount
+// times, of MinLength to MaxLength letters (either bound ignored at zero).
+type CorpusOptions struct {
+  Name string
+  Lowercase bool
+  KeepProperNouns bool
+  MinCount int
+  MinLength, MaxLength int
+}
+
+// Corpus tokenises natural text (books, articles, marketing copy) into
+// words, counting each, for a dictionary built from their vocabulary. Words
+// keep inner apostrophes and hyphens (don't, well-known); other
+// punctuation, quotes and numbers are dropped.
+type Corpus struct {
+  opts CorpusOptions
+  words map[string]*corpusWord
+
+  // whether the next word starts a sentence
+  starting bool
+}
+
+type corpusWord struct {
+  count int
+  form string // first capitalised form seen
+  lower bool // seen in lower case
+  proper bool // seen capitalised mid-sentence
+}
+
+func NewCorpus(opts CorpusOptions) *Corpus {
+  return &Corpus{opts: opts, words: map[string]*corpusWord{}, starting: true}
+}
+
+// OpenCorpus builds a dictionary from the text held in filename
+func OpenCorpus(filename string, opts CorpusOptions) (CWDict, error) {
+  file, err := os.Open(filename)
+  if err != nil { return CWDict{}, err }
+  defer file.Close()
+
+  corpus := NewCorpus(opts)
+  if err := corpus.Read(file); err != nil { return CWDict{}, err }
+
+  return corpus.Dict(), nil
+}
+
+// read (tokenises everything r holds)
+func (c *Corpus) Read(r io.Reader) error {
+  contents, err := io.ReadAll(r)
+  if err != nil { return err }
+
+  c.Add(string(contents))
+  return nil
+}
+
+// add (tokenises text; sentences may carry on into the next call)
+func (c *Corpus) Add(text string) *Corpus {
+  var word strings.Builder
+
+  flush := func() {
+    if word.Len() != 0 { c.count(word.String()) }
+    word.Reset()
+  }
+
+  for i, r := range text {
+    switch {
+    case unicode.IsLetter(r) || unicode.IsDigit(r):
+      word.WriteRune(r)
+    case (r == '\'' || r == '’' || r == '-') && word.Len() != 0 &&
+    nextIsLetter(text[i + utf8.RuneLen(r):]):
+      if r == '’' { r = '\'' }
+      word.WriteRune(r)
+    default:
+      flush()
+      if r == '.' || r == '!' || r == '?' { c.starting = true }
+    }
+  }
+
+  flush()
+  return c
+}
+
+func nextIsLetter(rest string) bool {
+  r, _ := utf8.DecodeRuneInString(rest)
+  return unicode.IsLetter(r)
+}
+
+func (c *Corpus) count(token string) {
+  starting := c.starting
+  c.starting = false
+
+  // numbers aren't words
+  if strings.IndexFunc(token, unicode.IsLetter) < 0 { return }
+
+  key := token
+  if c.opts.Lowercase { key = strings.ToLower(token) }
+
+  word := c.words[key]
+  if word == nil {
+    word = &corpusWord{}
+    c.words[key] = word
+  }
+
+  word.count++
+
+  first, _ := utf8.DecodeRuneInString(token)
+  if !unicode.IsUpper(first) {
+    word.lower = true
+  } else {
+    if word.form == "" { word.form = token }
+    if !starting { word.proper = true }
+  }
+}
+
+// counts (occurrences of every word, before filtering)
+func (c *Corpus) Counts() map[string]int {
+  result := make(map[string]int, len(c.words))
+  for key, word := range c.words { result[c.form(key, word)] += word.count }
+
+  return result
+}
+
+// count (occurrences of word, as Counts would give them)
+func (c *Corpus) Count(word string) int {
+  key := word
+  if c.opts.Lowercase { key = strings.ToLower(word) }
+
+  found := c.words[key]
+  if found == nil || c.form(key, found) != word { return 0 }
+
+  return found.count
+}
+
+// dict (sorted, with each word kept once)
+func (c *Corpus) Dict() CWDict {
+  var kept []string
+
+  for word, count := range c.Counts() {
+    if !c.keep(word, count) { continue }
+    kept = append(kept, word)
+  }
+
+  sort.Strings(kept)
+
+  dict := OpenWithName(c.opts.Name)
+  dict.PlaceSlice(kept)
+  dict.Sort()
+
+  return dict
+}
+
+// form returns the word as it belongs in the dictionary
+func (c *Corpus) form(key string, word *corpusWord) string {
+  if c.opts.Lowercase && c.opts.KeepProperNouns && word.proper &&
+  !word.lower {
+    return word.form
+  }
+
+  return key
+}
+
+func (c *Corpus) keep(word string, count int) bool {
+  if count < c.opts.MinCount { return false }
+
+  length := utf8.RuneCountInString(word)
+  if length < c.opts.MinLength { return false }
+  if c.opts.MaxLength > 0 && length > c.opts.MaxLength { return false }
+
+  return true
+}
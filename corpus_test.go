@@ -0,0 +1,126 @@
+package chinwag
+
+import (
+  "os"
+  "slices"
+  "strings"
+  "testing"
+  "path/filepath"
+)
+
+const marketing = `"Meet Sprocket," said Alice. The widget everyone's talking
+about is here! Sprocket fits the well-known gadgets you already own; it's
+light, it's quick and it's 100% recyclable. Why wait? Order a Sprocket
+today -- the widget Alice trusts.`
+
+func TestCorpusTokens(t *testing.T) {
+  corpus := NewCorpus(CorpusOptions{}).Add(marketing)
+  counts := corpus.Counts()
+
+  for word, count := range map[string]int{"Sprocket": 3, "widget": 2,
+  "it's": 3, "well-known": 1, "everyone's": 1, "The": 1, "the": 2} {
+    if counts[word] != count {
+      t.Errorf("expected %q %d times, got %d", word, count, counts[word])
+    }
+  }
+
+  for word := range counts {
+    if strings.ContainsAny(word, "\"%;,.!?") || word == "100" || word == "" {
+      t.Errorf("expected punctuation and numbers to be dropped, got %q", word)
+    }
+  }
+}
+
+func TestCorpusCase(t *testing.T) {
+  opts := CorpusOptions{Lowercase: true}
+  counts := NewCorpus(opts).Add(marketing).Counts()
+
+  if counts["the"] != 3 || counts["sprocket"] != 3 || counts["alice"] != 2 {
+    t.Errorf("expected case to be folded, got %v", counts)
+  }
+
+  opts.KeepProperNouns = true
+  counts = NewCorpus(opts).Add(marketing).Counts()
+
+  if counts["Sprocket"] != 3 || counts["Alice"] != 2 || counts["the"] != 3 {
+    t.Errorf("expected proper nouns to be kept, got %v", counts)
+  }
+
+  // "Meet" and "Why" only ever start sentences
+  if counts["meet"] != 1 || counts["why"] != 1 {
+    t.Errorf("expected sentence starts to be folded, got %v", counts)
+  }
+}
+
+func TestCorpusCount(t *testing.T) {
+  for _, opts := range []CorpusOptions{{}, {Lowercase: true},
+  {Lowercase: true, KeepProperNouns: true}} {
+    corpus := NewCorpus(opts).Add(marketing)
+    counts := corpus.Counts()
+
+    for word, count := range counts {
+      if corpus.Count(word) != count {
+        t.Errorf("expected %q to count %d under %+v, got %d", word, count,
+        opts, corpus.Count(word))
+      }
+    }
+
+    for _, word := range []string{"SPROCKET", "gizmo", "100"} {
+      if corpus.Count(word) != counts[word] {
+        t.Errorf("expected %q to count %d under %+v, got %d", word,
+        counts[word], opts, corpus.Count(word))
+      }
+    }
+  }
+
+  // kept proper nouns only count under their capitalised form
+  corpus := NewCorpus(CorpusOptions{Lowercase: true, KeepProperNouns: true})
+  corpus.Add(marketing)
+
+  if corpus.Count("Sprocket") != 3 || corpus.Count("sprocket") != 0 {
+    t.Errorf("expected only \"Sprocket\" to count, got %d and %d",
+    corpus.Count("Sprocket"), corpus.Count("sprocket"))
+  }
+}
+
+func TestCorpusDict(t *testing.T) {
+  opts := CorpusOptions{Name: "marketing", Lowercase: true, MinCount: 2,
+  MinLength: 4}
+
+  dict := NewCorpus(opts).Add(marketing).Dict()
+
+  if dict.Name() != "marketing" || !dict.IsSorted() {
+    t.Errorf("expected a sorted dictionary named marketing")
+  }
+
+  words := dict.Words()
+  slices.Sort(words)
+
+  expected := []string{"alice", "it's", "sprocket", "widget"}
+  if !slices.Equal(words, expected) {
+    t.Errorf("expected %v, got %v", expected, words)
+  }
+
+  opts.MinCount, opts.MaxLength = 0, 3
+  for word := range NewCorpus(opts).Add(marketing).Dict().All() {
+    t.Errorf("expected no words between four and three letters, got %q", word)
+  }
+}
+
+func TestOpenCorpus(t *testing.T) {
+  filename := filepath.Join(t.TempDir(), "marketing.txt")
+  if err := os.WriteFile(filename, []byte(marketing), 0644); err != nil {
+    t.Fatal(err)
+  }
+
+  dict, err := OpenCorpus(filename, CorpusOptions{Lowercase: true})
+  if err != nil { t.Fatal(err) }
+
+  if !dict.Include("recyclable") || dict.Include("Recyclable") {
+    t.Error("expected the corpus's words, in lower case")
+  }
+
+  if _, err := OpenCorpus(filename + ".missing", CorpusOptions{}); err == nil {
+    t.Error("expected a missing file to fail")
+  }
+}
@@ -0,0 +1,172 @@
+package chinwag
+
+import (
+  "sort"
+  "strings"
+  "unicode"
+)
+
+// DedupeKeep chooses which of a set of duplicates survives
+type DedupeKeep uint8
+const (
+  KeepFirst DedupeKeep = iota // the earliest, in row order
+  KeepLast // the latest, in row order
+  KeepLowest // the lowest in byte order (e.g. "Apple" over "apple")
+  KeepHighest // the highest in byte order
+)
+
+// DedupeOptions configures Dedupe. Entries are duplicates when equal, or,
+// with FoldCase, equal but for case, and, with Normalize, equal once
+// canonically decomposed (so "café" typed either way matches). Normalize
+// isn't full Unicode normalisation: it decomposes only the Latin, Greek
+// (U+0370-03FF) and Cyrillic (U+0400-04FF) blocks, leaving other characters,
+// like Greek Extended's "ἀ" or Hangul, as they are. Survivors
+// keep their places, unless SortRows orders each row's entries by their
+// comparison key, then bytes.
+type DedupeOptions struct {
+  FoldCase bool
+  Normalize bool
+  Keep DedupeKeep
+  SortRows bool
+}
+
+// Removal records an entry Dedupe removed, and the one kept in its stead
+type Removal struct {
+  Word string
+  Kept string
+}
+
+// DedupeReport lists Dedupe's removals, in row order
+type DedupeReport struct {
+  Removed []Removal
+}
+
+// entry's place within a dictionary
+type dedupeEntry struct {
+  row, index int
+  word, key string
+}
+
+// dedupe (removes duplicates across every row, unlike Prune, which only
+// compares entries sharing a row); the same entries and options always
+// produce the same dictionary, whichever engine is in use
+func (dict *CWDict) Dedupe(opts DedupeOptions) DedupeReport {
+  var report DedupeReport
+  var entries []dedupeEntry
+  var rows [][]string
+
+  dict.eachRow(func(_ int, words []string) bool {
+    for i, word := range words {
+      if word == "" { continue }
+
+      entries = append(entries, dedupeEntry{row: len(rows), index: i,
+      word: word, key: opts.key(word)})
+    }
+
+    rows = append(rows, nil)
+    return true
+  })
+
+  kept := map[string]int{}
+  for i, entry := range entries {
+    survivor, found := kept[entry.key]
+    if !found || opts.prefer(entry, entries[survivor]) { kept[entry.key] = i }
+  }
+
+  for i, entry := range entries {
+    survivor := entries[kept[entry.key]]
+
+    if kept[entry.key] != i {
+      report.Removed = append(report.Removed, Removal{Word: entry.word,
+      Kept: survivor.word})
+      continue
+    }
+
+    rows[entry.row] = append(rows[entry.row], entry.word)
+  }
+
+  if opts.SortRows {
+    for _, row := range rows {
+      sort.SliceStable(row, func(i, j int) bool {
+        a, b := opts.key(row[i]), opts.key(row[j])
+        if a != b { return a < b }
+
+        return row[i] < row[j]
+      })
+    }
+  }
+
+  if len(report.Removed) != 0 || opts.SortRows { dict.setRows(rows) }
+
+  return report
+}
+
+// prefer reports whether candidate should replace survivor
+func (opts DedupeOptions) prefer(candidate, survivor dedupeEntry) bool {
+  switch opts.Keep {
+  case KeepLast:
+    return true
+  case KeepLowest:
+    return candidate.word < survivor.word
+  case KeepHighest:
+    return candidate.word > survivor.word
+  }
+
+  return false
+}
+
+// key returns what word is compared by
+func (opts DedupeOptions) key(word string) string {
+  if opts.Normalize { word = decompose(word) }
+  if opts.FoldCase { word = strings.Map(foldRune, word) }
+
+  return word
+}
+
+// foldRune maps r to the least rune it matches under simple case folding
+func foldRune(r rune) rune {
+  least := r
+  for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+    if f < least { least = f }
+  }
+
+  return least
+}
+
+// decompose canonically decomposes word (NFD), for the scripts covered by
+// decompositions, putting runs of combining marks into canonical order
+func decompose(word string) string {
+  var runes []rune
+
+  for _, r := range word {
+    if parts, ok := decompositions[r]; ok {
+      runes = append(runes, []rune(parts)...)
+    } else {
+      runes = append(runes, r)
+    }
+  }
+
+  // stable, so marks of the same class keep their order
+  for start := 0; start < len(runes); start++ {
+    if combiningClass(runes[start]) == 0 { continue }
+
+    end := start
+    for end < len(runes) && combiningClass(runes[end]) != 0 { end++ }
+
+    marks := runes[start:end]
+    sort.SliceStable(marks, func(i, j int) bool {
+      return combiningClass(marks[i]) < combiningClass(marks[j])
+    })
+
+    start = end
+  }
+
+  return string(runes)
+}
+
+func combiningClass(r rune) int {
+  if class, ok := combiningClasses[r]; ok { return class }
+  if unicode.Is(unicode.Mn, r) { return 230 }
+
+  return 0
+}
@@ -0,0 +1,109 @@
+package chinwag
+
+import (
+  "slices"
+  "testing"
+)
+
+// two teams' lists, the second appended after the first was placed
+func merged() CWDict {
+  dict := OpenWithName("merged")
+  dict.PlaceWords("apple", "Pear", "caf\u00e9", "plum", "apple")
+  dict.AppendWords("Apple", "pear", "cafe\u0301", "PLUM", "fig")
+
+  return dict
+}
+
+func TestDedupeExact(t *testing.T) {
+  dict := merged()
+  report := dict.Dedupe(DedupeOptions{})
+
+  expected := []Removal{{"apple", "apple"}}
+  if !slices.Equal(report.Removed, expected) {
+    t.Errorf("expected %v removed, got %v", expected, report.Removed)
+  }
+
+  if dict.Length() != 9 || dict.Name() != "merged" {
+    t.Errorf("expected nine entries in merged, got %v", dict.Words())
+  }
+}
+
+func TestDedupeFolded(t *testing.T) {
+  dict := merged()
+  report := dict.Dedupe(DedupeOptions{FoldCase: true, Normalize: true})
+
+  expected := []string{"apple", "caf\u00e9", "Pear", "plum", "fig"}
+  if words := dict.Words(); !slices.Equal(words, expected) {
+    t.Errorf("expected %v, got %v", expected, words)
+  }
+
+  if len(report.Removed) != 5 || report.Removed[1] != (Removal{"Apple",
+  "apple"}) || report.Removed[3] != (Removal{"cafe\u0301", "caf\u00e9"}) {
+    t.Errorf("unexpected report %v", report.Removed)
+  }
+
+  // folding case alone leaves the two spellings of café apart
+  dict = merged()
+  dict.Dedupe(DedupeOptions{FoldCase: true})
+
+  if dict.Length() != 6 {
+    t.Errorf("expected six entries, got %v", dict.Words())
+  }
+}
+
+func TestDedupeKeep(t *testing.T) {
+  for keep, expected := range map[DedupeKeep][]string{
+    KeepFirst: {"apple", "Pear", "caf\u00e9", "plum", "fig"},
+    KeepLast: {"Apple", "pear", "cafe\u0301", "PLUM", "fig"},
+    KeepLowest: {"Apple", "Pear", "cafe\u0301", "PLUM", "fig"},
+    KeepHighest: {"apple", "pear", "caf\u00e9", "plum", "fig"},
+  } {
+    dict := merged()
+    dict.Dedupe(DedupeOptions{FoldCase: true, Normalize: true, Keep: keep})
+
+    words := dict.Words()
+    slices.Sort(words); slices.Sort(expected)
+
+    if !slices.Equal(words, expected) {
+      t.Errorf("keep %d: expected %v, got %v", keep, expected, words)
+    }
+  }
+}
+
+func TestDedupeSortRows(t *testing.T) {
+  dict := Open()
+  dict.PlaceWords("plum", "Kiwi", "lime", "KIWI", "date")
+  dict.Sort()
+
+  dict.Dedupe(DedupeOptions{FoldCase: true, Keep: KeepLast, SortRows: true})
+
+  expected := []string{"date", "KIWI", "lime", "plum"}
+  if words := dict.Words(); !slices.Equal(words, expected) {
+    t.Errorf("expected %v, got %v", expected, words)
+  }
+
+  if !dict.IsSorted() { t.Error("expected a sorted dictionary to stay so") }
+
+  if report := dict.Dedupe(DedupeOptions{}); len(report.Removed) != 0 {
+    t.Errorf("expected nothing left to remove, got %v", report.Removed)
+  }
+}
+
+func TestDecompose(t *testing.T) {
+  // the dot below (class 220) sorts ahead of the circumflex (230)
+  for _, spelling := range []string{"\u1ec7", "\u1eb9\u0302",
+  "e\u0323\u0302", "e\u0302\u0323", "\u00ea\u0323"} {
+    if decompose(spelling) != "e\u0323\u0302" {
+      t.Errorf("expected %q to decompose canonically, got %q", spelling,
+      decompose(spelling))
+    }
+  }
+
+  // outside the covered blocks, characters are left alone
+  for _, spelling := range []string{"\u1f00", "\uac00", "\u1f00\u0301"} {
+    if decompose(spelling) != spelling {
+      t.Errorf("expected %q to be left alone, got %q", spelling,
+      decompose(spelling))
+    }
+  }
+}
@@ -1,11 +1,8 @@
+//go:build cgo && !chinwag_purego
+
 #include "dict.h"
 
 // dictionary row utilities
-cwdrow_t cwdrow_open();
-
-cwdrow_t cwdrow_add_word
-(cwdrow_t drow, const char* word);
-
 cwdrow_t cwdrow_add_word_strict
 (cwdrow_t drow, const char* word, U32 size);
 
@@ -91,6 +88,9 @@ cwdrow_t cwdrow_add_word_strict
 cwdrow_t cwdrow_sort
 (cwdrow_t drow)
 {
+  // nothing to bubble (also avoids underflowing "count - 1")
+  if(drow.count < 2) { drow.sorted = true; return drow; }
+
   for(U32 i = 0; i != drow.count - 1; ++i)
   {
     if(cwdrow_word_blank(drow, i) && cwdrow_word_present(drow, i + 1))
@@ -209,6 +209,7 @@ cwdict_t cwdict_open()
 
   // set default values
   d.sorted = false;
+  d.phrases = false;
   d.count = 0;
   d.drows = NULL;
   d.name = NULL;
@@ -223,6 +224,7 @@ cwdict_t cwdict_open_with_name
 
   // set default values
   d.sorted = false;
+  d.phrases = false;
   d.count = 0;
   d.drows = NULL;
   d.name = NULL;
@@ -337,6 +339,9 @@ cwdict_t cwdict_sort
     dict.drows[i] = cwdrow_sort(dict.drows[i]);
   }
 
+  // nothing to order (also avoids underflowing "count - 1")
+  if(dict.count < 2) { dict.sorted = true; return dict; }
+
   // sort individual drows within dict
   for(U32 i = 0; i != dict.count - 1; ++i)
   {
@@ -515,7 +520,8 @@ cwdict_t cwdict_map
       // only resize if necessary
       if(len != strlen(dict.drows[i].words[j]))
       {
-        dict.drows[i].words[j]=(char*)realloc(dict.drows[i].words[j], len);
+        dict.drows[i].words[j] =
+        (char*)realloc(dict.drows[i].words[j], len + 1);
       }
 
       strcpy(dict.drows[i].words[j], temp);
@@ -543,14 +549,8 @@ cwdict_t cwdict_clone
     }
   }
 
-  if(dict.name)
-  {
-    new.name = (char*)malloc(strlen(dict.name) + 1);
-    strcpy(new.name, dict.name);
-    new.name[strlen(dict.name)] = '\0';
-  }
-
   if(dict.sorted) new = cwdict_sort(new);
+  new.phrases = dict.phrases;
 
   return new;
 }
@@ -612,8 +612,8 @@ U32 count = 0;
   {
     for(U32 j = 0; j != dict.drows[i].count; ++j)
     {
-      // valid if word excludes a space
-      if(exclude(dict.drows[i].words[j], " ")) ++count;
+      // phrases count as entries, as any word does
+      if(dict.drows[i].words[j][0] != '\0') ++count;
     }
   }
 
@@ -844,7 +844,7 @@ void validate_cwdict
 cwdict_t split_into_cwdict
 (const char* buffer, const char* delimiters)
 {
-  char* tok;
+  char* tok; char* cursor;
   char* mutable_buffer = (char*)malloc(strlen(buffer) + 1 * sizeof(char));
   cwdict_t dict = cwdict_open();
 
@@ -853,14 +853,15 @@ cwdict_t split_into_cwdict
   mutable_buffer[strlen(buffer)] = '\0';
 
   // natively tokenize input string
-  tok = strtok(mutable_buffer, delimiters);
+  cursor = mutable_buffer;
+  tok = next_token(&cursor, delimiters);
   while(tok != NULL)
   {
     // add word to dict
     dict = cwdict_place_word(dict, tok);
 
     // get new tok (if any)
-    tok = strtok(NULL, delimiters);
+    tok = next_token(&cursor, delimiters);
   }
 
   // close mutable buffer
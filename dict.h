@@ -4,6 +4,11 @@
 #include "chinwag.h"
 
 // exposed dictionary row routines
+cwdrow_t cwdrow_open();
+
+cwdrow_t cwdrow_add_word
+(cwdrow_t drow, const char* word);
+
 char* cwdrow_sample
 (cwdrow_t drow);
 
@@ -0,0 +1,254 @@
+package chinwag
+
+import (
+  "fmt"
+  "html"
+  "strings"
+)
+
+type DocFormat uint8
+const (
+  Markdown DocFormat = 0
+  HTML DocFormat = 1
+)
+
+type DocBlock uint8
+const (
+  Heading DocBlock = 0
+  Paragraph DocBlock = 1
+  BulletList DocBlock = 2
+  NumberedList DocBlock = 3
+  Blockquote DocBlock = 4
+)
+
+// DocPart is a single entry in a document outline. Min and Max bound the
+// words in a heading, the sentences in a paragraph or blockquote, or the
+// items in a list; either left at zero takes the block's default (kept
+// within the other bound).
+type DocPart struct {
+  Block DocBlock
+  Level int
+  Min, Max uint64
+}
+
+// DocOptions configures GenerateDocument. InlineRate is the percentage
+// (0-100) of sentences given an inline element: emphasis, strong emphasis,
+// a link or a code span.
+type DocOptions struct {
+  Format DocFormat
+  Outline []DocPart
+  InlineRate uint32
+}
+
+// DefaultOutline resembles a short article, and is used when no outline is
+// given
+var DefaultOutline = []DocPart{
+  {Block: Heading, Level: 1},
+  {Block: Paragraph},
+  {Block: Heading, Level: 2},
+  {Block: Paragraph},
+  {Block: BulletList},
+  {Block: Paragraph},
+  {Block: Heading, Level: 2},
+  {Block: Blockquote},
+  {Block: Paragraph},
+  {Block: Heading, Level: 3},
+  {Block: NumberedList},
+  {Block: Paragraph},
+}
+
+// per-block defaults for DocPart.Min and DocPart.Max
+var docDefaults = map[DocBlock][2]uint64{
+  Heading: {2, 6},
+  Paragraph: {3, 6},
+  BulletList: {3, 5},
+  NumberedList: {3, 5},
+  Blockquote: {1, 3},
+}
+
+// opening and closing markup for emphasis, strong emphasis and code spans
+var docInline = map[DocFormat][3][2]string{
+  Markdown: {{"*", "*"}, {"**", "**"}, {"`", "`"}},
+  HTML: {{"<em>", "</em>"}, {"<strong>", "</strong>"}, {"<code>", "</code>"}},
+}
+
+type document struct {
+  dict CWDict
+  src *source
+  opts DocOptions
+}
+
+// GenerateDocument builds a structured article from the dictionary, as
+// either Markdown or HTML, following the outline in opts
+func GenerateDocument(dict CWDict, opts DocOptions) (string, *ErrorType) {
+  doc := document{dict: dict, src: newSource(nextSeed()), opts: opts}
+  return doc.render()
+}
+
+func (doc document) render() (string, *ErrorType) {
+  var blocks []string
+
+  outline := doc.opts.Outline
+  if outline == nil { outline = DefaultOutline }
+
+  for _, part := range outline {
+    block, err := doc.block(part)
+    if err != nil { return "", err }
+
+    blocks = append(blocks, block)
+  }
+
+  if doc.opts.Format == HTML { return strings.Join(blocks, "\n"), nil }
+  return strings.Join(blocks, "\n\n"), nil
+}
+
+func (doc document) block(part DocPart) (string, *ErrorType) {
+  defaults := docDefaults[part.Block]
+  min, max := part.Min, part.Max
+
+  if min == 0 {
+    min = defaults[0]
+    if max != 0 && max < min { min = max }
+  }
+
+  if max == 0 { max = max64(defaults[1], min) }
+
+  switch part.Block {
+  case Heading:
+    return doc.heading(part.Level, min, max)
+  case Paragraph:
+    return doc.paragraph(min, max)
+  case BulletList, NumberedList:
+    return doc.list(part.Block == NumberedList, min, max)
+  case Blockquote:
+    return doc.blockquote(min, max)
+  }
+
+  var go_error ErrorType = InvalidOutputType
+  return "", &go_error
+}
+
+func (doc document) heading(level int, min, max uint64) (string, *ErrorType) {
+  if level < 1 { level = 1 }
+  if level > 6 { level = 6 }
+
+  text, err := Generate(doc.dict, Titles, min, max)
+  if err != nil { return "", err }
+
+  if doc.opts.Format == HTML {
+    return fmt.Sprintf("<h%d>%s</h%d>", level, html.EscapeString(text),
+    level), nil
+  }
+
+  return strings.Repeat("#", level) + " " + text, nil
+}
+
+func (doc document) paragraph(min, max uint64) (string, *ErrorType) {
+  text, err := doc.sentences(min, max)
+  if err != nil { return "", err }
+
+  if doc.opts.Format == HTML { return "<p>" + text + "</p>", nil }
+  return text, nil
+}
+
+func (doc document) list(numbered bool, min, max uint64) (string, *ErrorType) {
+  var items []string
+  amount := doc.src.motherr(uint32(min), uint32(max))
+
+  for i := uint32(0); i != amount; i++ {
+    item, err := doc.sentences(1, 1)
+    if err != nil { return "", err }
+
+    if doc.opts.Format == HTML {
+      items = append(items, "  <li>" + item + "</li>")
+    } else if numbered {
+      items = append(items, fmt.Sprintf("%d. %s", i + 1, item))
+    } else {
+      items = append(items, "- " + item)
+    }
+  }
+
+  if doc.opts.Format == HTML {
+    tag := "ul"
+    if numbered { tag = "ol" }
+
+    return "<" + tag + ">\n" + strings.Join(items, "\n") + "\n</" + tag + ">",
+    nil
+  }
+
+  return strings.Join(items, "\n"), nil
+}
+
+func (doc document) blockquote(min, max uint64) (string, *ErrorType) {
+  text, err := doc.sentences(min, max)
+  if err != nil { return "", err }
+
+  if doc.opts.Format == HTML {
+    return "<blockquote>\n  <p>" + text + "</p>\n</blockquote>", nil
+  }
+
+  return "> " + text, nil
+}
+
+// sentences generates text with inline elements mixed in, already escaped
+// for the document's format
+func (doc document) sentences(min, max uint64) (string, *ErrorType) {
+  var result []string
+  amount := doc.src.motherr(uint32(min), uint32(max))
+
+  for i := uint32(0); i != amount; i++ {
+    sentence, err := Generate(doc.dict, Sentences, 1, 1)
+    if err != nil { return "", err }
+
+    if doc.opts.Format == HTML { sentence = html.EscapeString(sentence) }
+
+    if doc.src.motherr(1, 100) <= doc.opts.InlineRate {
+      sentence = doc.inline(sentence)
+    }
+
+    result = append(result, sentence)
+  }
+
+  return strings.Join(result, " "), nil
+}
+
+// inline wraps one word of the sentence, leaving its punctuation outside
+func (doc document) inline(sentence string) string {
+  words := strings.Split(sentence, " ")
+  i := doc.src.motherr(0, uint32(len(words) - 1))
+
+  word := strings.TrimRight(words[i], ".,?!")
+  trailing := words[i][len(word):]
+  if word == "" { return sentence }
+
+  kind := doc.src.motherr(0, 3)
+
+  if kind == 3 {
+    href := "https://example.com/" + slugify(word)
+
+    if doc.opts.Format == HTML {
+      word = "<a href=\"" + href + "\">" + word + "</a>"
+    } else {
+      word = "[" + word + "](" + href + ")"
+    }
+  } else {
+    markup := docInline[doc.opts.Format][kind]
+    word = markup[0] + word + markup[1]
+  }
+
+  words[i] = word + trailing
+  return strings.Join(words, " ")
+}
+
+// slugify keeps only the lower-cased letters and digits of a word
+func slugify(word string) string {
+  var result strings.Builder
+
+  for _, r := range strings.ToLower(html.UnescapeString(word)) {
+    if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+      result.WriteRune(r)
+    }
+  }
+
+  return result.String()
+}
@@ -0,0 +1,123 @@
+package chinwag
+
+import (
+  "regexp"
+  "strings"
+  "testing"
+)
+
+func TestDocumentMarkdown(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+  outline := []DocPart{
+    {Block: Heading, Level: 1},
+    {Block: Paragraph, Min: 2, Max: 2},
+    {Block: Heading, Level: 2, Min: 3, Max: 3},
+    {Block: BulletList, Min: 4, Max: 4},
+    {Block: NumberedList, Min: 3, Max: 3},
+    {Block: Blockquote},
+  }
+
+  result, err := GenerateDocument(seuss, DocOptions{Outline: outline})
+  if err != nil { t.Fatal(ErrString(seuss, err)) }
+
+  blocks := strings.Split(result, "\n\n")
+  if len(blocks) != len(outline) {
+    t.Fatalf("expected %d blocks, got %d:\n%s", len(outline), len(blocks),
+    result)
+  }
+
+  if !strings.HasPrefix(blocks[0], "# ") {
+    t.Errorf("expected a level 1 heading, got %q", blocks[0])
+  }
+
+  if !strings.HasPrefix(blocks[2], "## ") ||
+  len(strings.Fields(blocks[2])) != 4 {
+    t.Errorf("expected a three word level 2 heading, got %q", blocks[2])
+  }
+
+  if strings.Count(blocks[3], "- ") < 4 ||
+  len(strings.Split(blocks[3], "\n")) != 4 {
+    t.Errorf("expected four bullets, got %q", blocks[3])
+  }
+
+  if !strings.HasPrefix(blocks[4], "1. ") ||
+  !strings.Contains(blocks[4], "\n3. ") {
+    t.Errorf("expected three numbered items, got %q", blocks[4])
+  }
+
+  if !strings.HasPrefix(blocks[5], "> ") {
+    t.Errorf("expected a blockquote, got %q", blocks[5])
+  }
+}
+
+func TestDocumentBounds(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+  outline := []DocPart{
+    {Block: Paragraph, Min: 3},
+    {Block: BulletList, Max: 2},
+    {Block: Heading, Level: 1, Min: 7},
+    {Block: NumberedList, Min: 4},
+  }
+
+  result, err := GenerateDocument(seuss, DocOptions{Outline: outline})
+  if err != nil { t.Fatal(ErrString(seuss, err)) }
+
+  blocks := strings.Split(result, "\n\n")
+  if len(blocks) != len(outline) {
+    t.Fatalf("expected %d blocks, got %d:\n%s", len(outline), len(blocks),
+    result)
+  }
+
+  ends := regexp.MustCompile(`[.?!]( |$)`)
+  if count := len(ends.FindAllString(blocks[0], -1)); count < 3 || count > 6 {
+    t.Errorf("expected 3-6 sentences, got %d in %q", count, blocks[0])
+  }
+
+  if len(strings.Split(blocks[1], "\n")) != 2 {
+    t.Errorf("expected a lone max to cap the default min, got %q", blocks[1])
+  }
+
+  if len(strings.Fields(blocks[2])) != 8 {
+    t.Errorf("expected a lone min to raise the default max, got %q",
+    blocks[2])
+  }
+
+  if count := len(strings.Split(blocks[3], "\n")); count < 4 || count > 5 {
+    t.Errorf("expected 4-5 numbered items, got %q", blocks[3])
+  }
+}
+
+func TestDocumentHTML(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+
+  result, err := GenerateDocument(seuss, DocOptions{Format: HTML,
+  InlineRate: 100})
+  if err != nil { t.Fatal(ErrString(seuss, err)) }
+
+  counts := map[string]int{"<h1>": 1, "<h2>": 2, "<h3>": 1, "<ul>": 1,
+  "<ol>": 1, "<blockquote>": 1, "</p>": 6}
+
+  for tag, expected := range counts {
+    if actual := strings.Count(result, tag); actual != expected {
+      t.Errorf("expected %d %s, got %d", expected, tag, actual)
+    }
+  }
+
+  inline := regexp.MustCompile("<(em|strong|code|a href=\"[^\"]*\")>")
+  if !inline.MatchString(result) {
+    t.Error("expected inline elements at an inline rate of 100")
+  }
+
+  if strings.Contains(result, "'") {
+    t.Error("expected apostrophes to be escaped")
+  }
+}
+
+func TestDocumentInvalidDict(t *testing.T) {
+  small_mess := Open()
+  small_mess.PlaceWords("too", "few", "words")
+
+  if _, err := GenerateDocument(small_mess, DocOptions{}); err == nil {
+    t.Error("expected an invalid dict to fail")
+  }
+}
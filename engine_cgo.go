@@ -0,0 +1,406 @@
+//go:build cgo && !chinwag_purego
+
+package chinwag
+
+import (
+  "unsafe"
+  "strings"
+  "sync/atomic"
+)
+
+/*
+#cgo CFLAGS: -std=c99
+#include "chinwag.h"
+*/
+import "C"
+
+func Open() CWDict {
+  return wrap(C.cwdict_open())
+}
+
+func openEmbedded(name string) CWDict {
+  delimiters := cstring(defaultDelimiters)
+  defer cfree(delimiters)
+
+  cname := cstring(name)
+  defer cfree(cname)
+
+  tokens := C.dict_seuss
+  switch name {
+  case "Latin":
+    tokens = C.dict_latin
+  case "FirstNames":
+    tokens = C.dict_first_names
+  case "LastNames":
+    tokens = C.dict_last_names
+  }
+
+  return wrap(C.cwdict_open_with_name_and_tokens(cname, tokens, delimiters))
+}
+
+func openTokens(name, tokens, delimiters string) CWDict {
+  cdelimiters := cstring(delimiters)
+  defer cfree(cdelimiters)
+
+  ctokens := cstring(tokens)
+  defer cfree(ctokens)
+
+  if name == "" {
+    return wrap(C.cwdict_open_with_tokens(ctokens, cdelimiters))
+  }
+
+  cname := cstring(name)
+  defer cfree(cname)
+
+  return wrap(C.cwdict_open_with_name_and_tokens(cname, ctokens,
+  cdelimiters))
+}
+
+func (dict CWDict) generate(kind CWType, min, max uint64,
+seed uint32) (string, *ErrorType) {
+  defer dict.keep()
+
+  var err C.cwerror_t
+  result := C.chinwag_seeded(C.cw_t(kind), C.ulong(min), C.ulong(max),
+  dict.cdict(), C.U32(seed), &err)
+
+  if result == nil { return "", generateError(err) }
+
+  defer C.free(unsafe.Pointer(result))
+  return C.GoString(result), nil
+}
+
+func (dict CWDict) generateBatch(kind CWType, min, max uint64, seed uint32,
+count int, done <-chan struct{}) ([]string, *ErrorType) {
+  defer dict.keep()
+
+  var err C.cwerror_t
+  var length C.size_t
+  var cancel *C.int
+
+  // the engine polls a flag in C memory, raised once done is closed
+  if done != nil {
+    cancel = (*C.int)(C.calloc(1, C.size_t(unsafe.Sizeof(C.int(0)))))
+    finished, exited := make(chan struct{}), make(chan struct{})
+
+    go func() {
+      defer close(exited)
+
+      select {
+      case <-done:
+        atomic.StoreInt32((*int32)(unsafe.Pointer(cancel)), 1)
+      case <-finished:
+      }
+    }()
+
+    // the watcher must be gone before the flag is freed
+    defer func() {
+      close(finished)
+      <-exited
+      C.free(unsafe.Pointer(cancel))
+    }()
+  }
+
+  result := C.chinwag_batch(C.cw_t(kind), C.ulong(min), C.ulong(max),
+  dict.cdict(), C.U32(seed), C.ulong(count), cancel, &length,
+  &err)
+
+  if result == nil { return nil, generateError(err) }
+
+  defer C.free(unsafe.Pointer(result))
+
+  // one copy for the whole batch; the outputs share it
+  packed := string(unsafe.Slice((*byte)(unsafe.Pointer(result)), length))
+  return strings.Split(packed[:len(packed) - 1], "\x00"), nil
+}
+
+// generateError converts a generation failure's code
+func generateError(err C.cwerror_t) *ErrorType {
+  var go_error ErrorType
+  if err == C.CWERROR_INVALID_OUTPUT_TYPE {
+    go_error = InvalidOutputType
+  } else if err == C.CWERROR_MIN_LESS_THAN_ONE {
+    go_error = MinLessThanOne
+  } else if err == C.CWERROR_MAX_LESS_THAN_MIN {
+    go_error = MaxLessThanMin
+  } else if err == C.CWERROR_MAX_TOO_HIGH {
+    go_error = MaxTooHigh
+  } else if err == C.CWERROR_CANCELLED {
+    go_error = Cancelled
+  } else {
+    go_error = DictUnknown
+  }
+
+  return &go_error
+}
+
+func (dict CWDict) Name() string {
+  defer dict.keep()
+  return C.GoString(dict.cdict().name)
+}
+
+func (dict *CWDict) SetName(name string) *CWDict {
+  container := dict.cdict()
+
+  // the dictionary owns its name, so the old one is released here
+  if container.name != nil { C.free(unsafe.Pointer(container.name)) }
+  container.name = C.CString(name)
+
+  return dict.update(container)
+}
+
+func (dict *CWDict) AppendWord(word string) *CWDict {
+  cword := cstring(word)
+  defer cfree(cword)
+
+  dict.update(C.cwdict_place_word(dict.cdict(), cword))
+  dict.indexWords(word)
+
+  return dict
+}
+
+func (dict *CWDict) PlaceWord(word string) *CWDict {
+  cword := cstring(word)
+  defer cfree(cword)
+
+  dict.update(C.cwdict_place_word_strict(dict.cdict(), cword))
+  dict.indexWords(word)
+
+  return dict
+}
+
+func (dict *CWDict) Sort() {
+  dict.update(C.cwdict_sort(dict.cdict()))
+}
+
+func (dict CWDict) IsSorted() bool {
+  defer dict.keep()
+  return bool(dict.cdict().sorted)
+}
+
+// SetPhrasesAsWords lets words (and titles) and letters draw phrases, each
+// as a single entry; sentences always do
+func (dict *CWDict) SetPhrasesAsWords(on bool) *CWDict {
+  container := dict.cdict()
+  container.phrases = C.bool(on)
+
+  return dict.update(container)
+}
+
+func (dict CWDict) PhrasesAsWords() bool {
+  defer dict.keep()
+  return bool(dict.cdict().phrases)
+}
+
+func (dict *CWDict) Prune() {
+  dict.update(C.cwdict_prune(dict.cdict(), false, false))
+  dict.reindex()
+}
+
+func (dict *CWDict) Clean() {
+  // dict.update(C.cwdict_clean(dict.cdict()))
+  dict.update(C.cwdict_prune(dict.cdict(), true, false))
+  dict.reindex()
+}
+
+// setRows replaces the dictionary's rows, keeping its name, whether it
+// counts as sorted and whether it draws phrases; empty rows are dropped
+func (dict *CWDict) setRows(rows [][]string) {
+  defer dict.keep()
+  if dict.ref == nil { return }
+
+  old := dict.cdict()
+  container := C.cwdict_open()
+
+  for _, words := range rows {
+    if len(words) == 0 { continue }
+
+    row := C.cwdrow_open()
+    row.sorted = C.bool(old.sorted)
+
+    for _, word := range words {
+      cword := cstring(word)
+      row = C.cwdrow_add_word(row, cword)
+      cfree(cword)
+    }
+
+    container = C.cwdict_add_row(container, row)
+  }
+
+  // the name moves across, rather than being copied
+  container.sorted, container.name, old.name = old.sorted, old.name, nil
+  container.phrases = old.phrases
+  C.cwdict_close(old)
+
+  dict.update(container)
+  dict.indexRows(rows)
+}
+
+// in-place modification
+func (dict *CWDict) Tweak(fn func(string)string) *CWDict {
+  defer dict.keep()
+  if dict.ref != nil { dict.ref.derived.Store(nil) }
+  dict.reindex()
+
+  for _, r := range dict.rows() {
+    words := rowWords(r)
+
+    for j, w := range words {
+      mod := C.GoString(w); mod = fn(mod)
+
+      // each entry is owned by its row, so the original is released
+      C.free(unsafe.Pointer(w))
+      words[j] = C.CString(mod)
+    }
+  }
+
+  return dict
+}
+
+func (dict CWDict) Clone() CWDict {
+  defer dict.keep()
+
+  clone := wrap(C.cwdict_clone(dict.cdict()))
+  clone.ref.indexed.Store(dict.Indexed())
+
+  return clone
+}
+
+// exclude
+func (dict CWDict) Exclude(word string) bool {
+  if members := dict.members(); members != nil { return !members[word] }
+
+  defer dict.keep()
+
+  cword := cstring(word)
+  defer cfree(cword)
+
+  return bool(C.cwdict_exclude(dict.cdict(), cword))
+}
+
+// include
+func (dict CWDict) Include(word string) bool {
+  if members := dict.members(); members != nil { return members[word] }
+
+  defer dict.keep()
+
+  cword := cstring(word)
+  defer cfree(cword)
+
+  return bool(C.cwdict_include(dict.cdict(), cword))
+}
+
+// validate
+func (dict CWDict) Validate() *ErrorType {
+  defer dict.keep()
+
+  var err C.cwerror_t
+  if !C.cwdict_valid(dict.cdict(), &err) {
+    var go_error ErrorType
+    if err == C.CWERROR_DICT_TOO_SMALL {
+      go_error = DictTooSmall
+    } else if err == C.CWERROR_DICT_UNSORTABLE {
+      go_error = DictUnsortable
+    } else {
+      go_error = DictUnknown
+    }
+
+  return &go_error
+  }
+
+  return nil
+}
+
+// equal
+func (dict CWDict) Equal(against CWDict) bool {
+  defer dict.keep(); defer against.keep()
+  return bool(C.cwdict_equal(dict.cdict(), against.cdict()))
+}
+
+// inequal
+func (dict CWDict) Inequal(against CWDict) bool {
+  defer dict.keep(); defer against.keep()
+  return bool(C.cwdict_inequal(dict.cdict(), against.cdict()))
+}
+
+func (dict CWDict) Length() uint64 {
+  defer dict.keep()
+  return uint64(C.cwdict_length(dict.cdict()))
+}
+
+// largest
+func (dict CWDict) Largest() uint32 {
+  defer dict.keep()
+  return uint32(C.cwdict_largest(dict.cdict()))
+}
+
+// sample
+func (dict CWDict) Sample() string {
+  defer dict.keep()
+  return C.GoString(C.cwdict_sample(dict.cdict()))
+}
+
+// close
+func (dict *CWDict) Close() *CWDict {
+  dict.update(C.cwdict_close(dict.cdict()))
+  dict.reindex()
+
+  return dict
+}
+
+// eachRow hands fn a Go copy of every row, in order, until fn returns false
+func (dict CWDict) eachRow(fn func(largest int, words []string) bool) {
+  defer dict.keep()
+
+  for _, r := range dict.rows() {
+    cwords := rowWords(r)
+    words := make([]string, len(cwords))
+
+    for j, w := range cwords { words[j] = C.GoString(w) }
+
+    if !fn(int(r.largest), words) { return }
+  }
+}
+
+func (dict CWDict) errMessage(err ErrorType) string {
+  var code C.cwerror_t
+
+  switch err {
+  case InvalidOutputType:
+    code = C.CWERROR_INVALID_OUTPUT_TYPE
+  case MinLessThanOne:
+    code = C.CWERROR_MIN_LESS_THAN_ONE
+  case MaxLessThanMin:
+    code = C.CWERROR_MAX_LESS_THAN_MIN
+  case MaxTooHigh:
+    code = C.CWERROR_MAX_TOO_HIGH
+  case DictTooSmall:
+    code = C.CWERROR_DICT_TOO_SMALL
+  case DictUnsortable:
+    code = C.CWERROR_DICT_UNSORTABLE
+  case Cancelled:
+    code = C.CWERROR_CANCELLED
+  default:
+    code = C.CWERROR_DICT_UNKNOWN
+  }
+
+  defer dict.keep()
+
+  cmsg := C.cwerror_string(dict.cdict(), code)
+  defer C.free(unsafe.Pointer(cmsg))
+
+  return C.GoString(cmsg)
+}
+
+// rows are viewed in place; nothing is copied out of C memory
+func (dict CWDict) rows() []C.struct_dictionary_type {
+  container := dict.cdict()
+
+  if container.drows == nil || container.count == 0 { return nil }
+  return unsafe.Slice(container.drows, int(container.count))
+}
+
+func rowWords(row C.struct_dictionary_type) []*C.char {
+  if row.words == nil || row.count == 0 { return nil }
+  return unsafe.Slice(row.words, int(row.count))
+}
@@ -0,0 +1,708 @@
+//go:build !cgo || chinwag_purego
+
+package chinwag
+
+import (
+  _ "embed"
+  "fmt"
+  "slices"
+  "strings"
+  "sync/atomic"
+)
+
+// The pure-Go engine ports dict.c and chinwag.c, entry for entry, so that
+// dictionaries are laid out (and generated from) exactly as under cgo.
+
+// mirrors of config.c
+const (
+  minDictSize = 300
+  sentenceMinWord = 2
+  sentenceMaxWord = 25
+  paragraphMinSentence = 4
+  paragraphMaxSentence = 6
+)
+
+// the embedded dictionaries are read from the same sources the C engine
+// compiles, each of which holds a single string literal
+var (
+  //go:embed seuss.c
+  seussSource string
+  //go:embed latin.c
+  latinSource string
+  //go:embed firstnames.c
+  firstNamesSource string
+  //go:embed lastnames.c
+  lastNamesSource string
+)
+
+// internal dictionary row
+type drow struct {
+  sorted bool
+  largest int
+  largestPos int
+  words []string // "" marks an entry removed by pruning
+}
+
+// dictionary (row container)
+type dictRef struct {
+  sorted bool
+  phrases bool // words and letters draw phrases too
+  rows []drow
+  name string
+  derived atomic.Pointer[dictDerived]
+  indexed atomic.Bool
+  members atomic.Pointer[memberSet]
+}
+
+func Open() CWDict {
+  return CWDict{ref: &dictRef{}}
+}
+
+func openEmbedded(name string) CWDict {
+  source := seussSource
+  switch name {
+  case "Latin":
+    source = latinSource
+  case "FirstNames":
+    source = firstNamesSource
+  case "LastNames":
+    source = lastNamesSource
+  }
+
+  start := strings.Index(source, "= \"") + 3
+  end := start + strings.IndexByte(source[start:], '"')
+
+  return openTokens(name, source[start:end], defaultDelimiters)
+}
+
+func openTokens(name, tokens, delimiters string) CWDict {
+  ref := &dictRef{name: name}
+
+  // tokenize as strtok does; runs of delimiters produce no entries
+  split := func(r rune) bool { return strings.ContainsRune(delimiters, r) }
+  for _, token := range strings.FieldsFunc(tokens, split) {
+    ref.placeWordStrict(token)
+  }
+
+  ref.prune(true)
+
+  return CWDict{ref: ref}
+}
+
+func (dict CWDict) generate(kind CWType, min, max uint64,
+seed uint32) (string, *ErrorType) {
+  result, err := dict.generateBatch(kind, min, max, seed, 1, nil)
+  if err != nil { return "", err }
+
+  return result[0], nil
+}
+
+// generateBatch draws count outputs from a single source, as chinwag_batch
+// does from a single seeding, until done is closed
+func (dict CWDict) generateBatch(kind CWType, min, max uint64, seed uint32,
+count int, done <-chan struct{}) ([]string, *ErrorType) {
+  var go_error ErrorType
+
+  if min == 0 || max == 0 {
+    go_error = MinLessThanOne
+    return nil, &go_error
+  }
+
+  if max < min {
+    go_error = MaxLessThanMin
+    return nil, &go_error
+  }
+
+  var fn func(*source, uint32, uint32) string
+  ref, src := dict.container(), newSource(seed)
+  src.done = done
+
+  switch kind {
+  case Letters:
+    fn = ref.letters
+  case Words:
+    fn = ref.words
+  case Sentences:
+    fn = ref.sentences
+  case Paragraphs:
+    fn = ref.paragraphs
+  default:
+    go_error = InvalidOutputType
+    return nil, &go_error
+  }
+
+  result := make([]string, count)
+  for i := range result {
+    result[i] = fn(src, uint32(min), uint32(max))
+
+    if src.cancelled() {
+      go_error = Cancelled
+      return nil, &go_error
+    }
+  }
+
+  return result, nil
+}
+
+func (dict CWDict) Name() string {
+  return dict.container().name
+}
+
+func (dict *CWDict) SetName(name string) *CWDict {
+  dict.mutable().name = name
+  return dict
+}
+
+func (dict *CWDict) AppendWord(word string) *CWDict {
+  dict.mutable().placeWord(word)
+  dict.indexWords(word)
+
+  return dict
+}
+
+func (dict *CWDict) PlaceWord(word string) *CWDict {
+  dict.mutable().placeWordStrict(word)
+  dict.indexWords(word)
+
+  return dict
+}
+
+func (dict *CWDict) Sort() {
+  dict.mutable().sort()
+}
+
+func (dict CWDict) IsSorted() bool {
+  return dict.container().sorted
+}
+
+// SetPhrasesAsWords lets words (and titles) and letters draw phrases, each
+// as a single entry; sentences always do
+func (dict *CWDict) SetPhrasesAsWords(on bool) *CWDict {
+  dict.mutable().phrases = on
+  return dict
+}
+
+func (dict CWDict) PhrasesAsWords() bool {
+  return dict.container().phrases
+}
+
+func (dict *CWDict) Prune() {
+  dict.mutable().prune(false)
+  dict.reindex()
+}
+
+func (dict *CWDict) Clean() {
+  dict.mutable().prune(true)
+  dict.reindex()
+}
+
+// setRows replaces the dictionary's rows, keeping its name, whether it
+// counts as sorted and whether it draws phrases; empty rows are dropped
+func (dict *CWDict) setRows(rows [][]string) {
+  if dict.ref == nil { return }
+
+  ref := dict.mutable()
+  replaced := make([]drow, 0, len(rows))
+
+  for _, words := range rows {
+    if len(words) == 0 { continue }
+
+    row := drow{sorted: ref.sorted}
+    for _, word := range words { row.addWord(word) }
+
+    replaced = append(replaced, row)
+  }
+
+  ref.rows = replaced
+  dict.indexRows(rows)
+}
+
+// in-place modification
+func (dict *CWDict) Tweak(fn func(string)string) *CWDict {
+  ref := dict.mutable()
+  dict.reindex()
+
+  for i := range ref.rows {
+    for j, w := range ref.rows[i].words { ref.rows[i].words[j] = fn(w) }
+  }
+
+  return dict
+}
+
+func (dict CWDict) Clone() CWDict {
+  ref := dict.container()
+  clone := &dictRef{name: ref.name}
+
+  for _, r := range ref.rows {
+    for _, w := range r.words {
+      if w != "" { clone.placeWordStrict(w) }
+    }
+  }
+
+  if ref.sorted { clone.sort() }
+  clone.phrases = ref.phrases
+  clone.indexed.Store(ref.indexed.Load())
+
+  return CWDict{ref: clone}
+}
+
+// exclude
+func (dict CWDict) Exclude(word string) bool {
+  return !dict.Include(word)
+}
+
+// include
+func (dict CWDict) Include(word string) bool {
+  if members := dict.members(); members != nil { return members[word] }
+  return dict.container().include(word)
+}
+
+// validate
+func (dict CWDict) Validate() *ErrorType {
+  var go_error ErrorType
+  ref := dict.container()
+  count := 0
+
+  for _, r := range ref.rows {
+    for _, w := range r.words {
+      // phrases count as entries, as any word does
+      if w != "" { count++ }
+    }
+  }
+
+  if count < minDictSize {
+    go_error = DictTooSmall
+    return &go_error
+  } else if !ref.sorted {
+    go_error = DictUnsortable
+    return &go_error
+  }
+
+  return nil
+}
+
+// equal
+func (dict CWDict) Equal(against CWDict) bool {
+  ref, other := dict.container(), against.container()
+
+  if len(ref.rows) != len(other.rows) { return false }
+
+  for i := range ref.rows {
+    if !slices.Equal(ref.rows[i].words, other.rows[i].words) { return false }
+  }
+
+  return true
+}
+
+// inequal
+func (dict CWDict) Inequal(against CWDict) bool {
+  return !dict.Equal(against)
+}
+
+func (dict CWDict) Length() uint64 {
+  return uint64(dict.container().length())
+}
+
+// largest
+func (dict CWDict) Largest() uint32 {
+  largest := 0
+
+  for _, r := range dict.container().rows {
+    if r.largest > largest { largest = r.largest }
+  }
+
+  return uint32(largest)
+}
+
+// sample
+func (dict CWDict) Sample() string {
+  seedLock.Lock()
+  defer seedLock.Unlock()
+
+  return dict.container().sample(seeds)
+}
+
+// close
+func (dict *CWDict) Close() *CWDict {
+  *dict.mutable() = dictRef{}
+  return dict
+}
+
+// eachRow hands fn every row, in order, until fn returns false; the words
+// belong to the dictionary, and must not be modified
+func (dict CWDict) eachRow(fn func(largest int, words []string) bool) {
+  for _, r := range dict.container().rows {
+    if !fn(r.largest, r.words) { return }
+  }
+}
+
+// messages mirror cwerror_string in error.c
+func (dict CWDict) errMessage(err ErrorType) string {
+  name := dict.Name()
+
+  switch err {
+  case InvalidOutputType:
+    return "requested generation type invalid"
+  case MinLessThanOne:
+    return "min threshold must be at least one"
+  case MaxLessThanMin:
+    return "max threshold must be greater than min"
+  case MaxTooHigh:
+    return "max cannot be in excess of 10000"
+  case DictTooSmall:
+    if name != "" {
+      return fmt.Sprintf("dict \"%s\" has too few acceptable entries " +
+      "(%d of %d)", name, dict.Length(), minDictSize)
+    }
+
+    return fmt.Sprintf("dict has too few acceptable entries (%d of %d)",
+    dict.Length(), minDictSize)
+  case DictUnsortable:
+    if name != "" { return fmt.Sprintf("unable to sort dict \"%s\"", name) }
+    return "unable to sort dict"
+  case Cancelled:
+    return "generation was cancelled"
+  }
+
+  if name != "" {
+    return fmt.Sprintf("something weird happened while validating dict " +
+    "\"%s\"", name)
+  }
+
+  return "something weird happened while validating dict"
+}
+
+// container returns the dictionary for reading; the zero value reads as empty
+func (dict CWDict) container() *dictRef {
+  if dict.ref == nil { return &dictRef{} }
+  return dict.ref
+}
+
+// mutable returns the dictionary for writing, allocating it if necessary
+func (dict *CWDict) mutable() *dictRef {
+  if dict.ref == nil { dict.ref = &dictRef{} }
+
+  dict.ref.derived.Store(nil)
+  return dict.ref
+}
+
+func (row *drow) addWord(word string) {
+  if len(word) > 0 {
+    row.words = append(row.words, word)
+
+    // set new largest (if applicable)
+    if row.largest < len(word) {
+      row.largest = len(word)
+      row.largestPos = len(row.words) - 1
+    }
+  }
+}
+
+func (row *drow) sort() {
+  // bubble each blank one place toward the back, as cwdrow_sort does
+  for i := 0; i < len(row.words) - 1; i++ {
+    if row.words[i] == "" && row.words[i + 1] != "" {
+      row.words[i], row.words[i + 1] = row.words[i + 1], ""
+    }
+  }
+
+  row.sorted = true
+}
+
+func (row drow) sample(src *source) string {
+  // immediately fail if empty
+  if len(row.words) == 0 { return "" }
+
+  return row.words[src.motherr(0, uint32(len(row.words) - 1))]
+}
+
+func (ref *dictRef) placeWord(word string) {
+  var row drow
+
+  row.addWord(word)
+  ref.rows = append(ref.rows, row)
+}
+
+func (ref *dictRef) placeWordStrict(word string) {
+  inserted := false
+
+  for i := range ref.rows {
+    if ref.rows[i].largest == len(word) {
+      ref.rows[i].addWord(word)
+      inserted = true
+    }
+  }
+
+  if !inserted { ref.placeWord(word) }
+}
+
+func (ref *dictRef) sort() {
+  // sort individual drows' contents
+  for i := range ref.rows { ref.rows[i].sort() }
+
+  // sort individual drows within dict (stable, as with the C bubble sort)
+  slices.SortStableFunc(ref.rows, func(a, b drow) int {
+    return a.largest - b.largest
+  })
+
+  ref.sorted = true
+}
+
+func (ref *dictRef) prune(sorted bool) {
+  for i := range ref.rows {
+    words := ref.rows[i].words
+
+    for j := range words {
+      if words[j] == "" { continue }
+
+      for k := range words {
+        if k != j && words[k] == words[j] { words[k] = "" }
+      }
+    }
+  }
+
+  if sorted { ref.sort() }
+
+  // resize individual drows within dict; like the C engine, this trims
+  // from the back, relying on sorting to have moved blanks there
+  blanks := false
+
+  for i := range ref.rows {
+    words := ref.rows[i].words
+    null_count := 0
+
+    for _, w := range words {
+      if w == "" { null_count++ }
+    }
+
+    ref.rows[i].words = words[:len(words) - null_count]
+
+    for _, w := range ref.rows[i].words {
+      if w == "" { blanks = true }
+    }
+  }
+
+  if blanks { ref.prune(sorted) }
+}
+
+func (ref *dictRef) include(word string) bool {
+  for _, r := range ref.rows {
+    if slices.Contains(r.words, word) { return true }
+  }
+
+  return false
+}
+
+func (ref *dictRef) length() int {
+  total := 0
+
+  for _, r := range ref.rows { total += len(r.words) }
+
+  return total
+}
+
+func (ref *dictRef) sample(src *source) string {
+  // immediately fail if empty
+  if len(ref.rows) == 0 { return "" }
+
+  return ref.rows[src.motherr(0, uint32(len(ref.rows) - 1))].sample(src)
+}
+
+func (ref *dictRef) letters(src *source, min, max uint32) string {
+  var temp []string
+  amount := int32(src.motherr(min, max))
+  vowels := "aeiou"
+
+  for amount > 0 {
+    if src.cancelled() { return "" }
+
+    var s string
+    letters := 1
+
+    if amount == 2 {
+      s = sampleSubstring(src, vowels, 1)
+    } else {
+      s = ref.sample(src)
+
+      // a phrase counts its letters alone, when drawn at all
+      if !ref.phrases && isPhrase(s) { continue }
+      letters = letterCount(s)
+      if letters > int(amount) { continue }
+    }
+
+    amount -= int32(letters)
+
+    // postfixed alteration (append vowel chain/remove trailing character)
+    if amount + 1 == 0 {
+      s = s[:len(s) - 1]
+      amount += 1
+    } else if amount - 1 == 0 {
+      s += sampleSubstring(src, vowels, 1)
+      amount -= 1
+    } else if amount - 2 == 0 {
+      s += sampleSubstring(src, vowels, 2)
+      amount -= 2
+    }
+
+    temp = append(temp, s)
+    if amount > 0 && amount != 1 { amount-- }
+  }
+
+  for i := range temp { temp[i] = ref.capitalize(temp[i]) }
+
+  return strings.Join(temp, " ")
+}
+
+func (ref *dictRef) words(src *source, min, max uint32) string {
+  var temp []string
+  amount, total := src.motherr(min, max), uint32(ref.length())
+  used := make(map[string]bool, amount)
+
+  // add words to dict
+  for i := uint32(0); i != amount; i++ {
+    for {
+      if src.cancelled() { return "" }
+
+      sample := ref.sample(src)
+
+      // valid if no space, hyphen (bar phrases, when drawn), or duplicate
+      // (latter depends on size)
+      if ref.phrases || !isPhrase(sample) {
+        if amount > total || !used[sample] {
+          temp = append(temp, sample)
+          used[sample] = true
+          break
+        }
+      }
+    }
+  }
+
+  for i := range temp { temp[i] = ref.capitalize(temp[i]) }
+
+  return strings.Join(temp, " ")
+}
+
+func (ref *dictRef) sentences(src *source, min, max uint32) string {
+  var master []string
+  var last, now, t_minus uint32
+  amount, count := src.motherr(min, max), uint32(len(ref.rows))
+
+  for i := uint32(0); i != amount; i++ {
+    if src.cancelled() { return "" }
+
+    var temp []string
+    var comma uint32
+    word_amount := src.motherr(sentenceMinWord, sentenceMaxWord)
+    used := make(map[string]bool, word_amount)
+
+    if word_amount >= 2 { comma = src.motherr(0, 1) }
+
+    // if comma, determine commma position after first word)
+    if word_amount >= 2 && comma == 1 { comma = src.motherr(1, word_amount - 1) }
+
+    // determine sentence rhythm
+    for j := uint32(0); j != word_amount; j++ {
+      if j == 0 {
+        now = src.motherr(5, 10)
+      } else if j == word_amount - 1 {
+        now = src.motherr(3, 8)
+      } else if t_minus > 0 {
+        now = src.motherr(1, 10); t_minus--
+      } else if last <= 10 {
+        now = src.motherr(1, count - 1)
+      } else if last > 10 || last <= 2 {
+        now = src.motherr(6, 10); t_minus = 3
+      }
+
+      // the C engine reads past the last row here; stay on the last one
+      selected := ref.rows[min32(now, count - 1)]
+      sample := selected.sample(src)
+
+      // a phrase fills a slot per word, so must fit those left; its
+      // rhythm is that of its last word
+      for (used[sample] && uint32(lastWordLength(sample)) != now) ||
+      uint32(wordCount(sample)) > word_amount - j {
+        if src.cancelled() { return "" }
+        sample = ref.sample(src)
+      }
+
+      span := uint32(wordCount(sample))
+
+      // add comma (if applicable)
+      if comma != 0 && j + span - 1 == comma - 1 { sample += "," }
+
+      temp = append(temp, sample)
+      used[sample] = true
+
+      if span > 1 { last = uint32(lastWordLength(sample)) } else { last = now }
+      j += span - 1
+    }
+
+    // join temporary dict into a sentence; capitalize first word
+    s := capitalize(strings.Join(temp, " "))
+
+    // determine punctuation; 1 - period, 2 - question, 3 - exclamation
+    // based on a ratio of 64-21-15, sampled from Shakespeare's Hamlet
+    punct := src.motherr(0, 99)
+
+    if punct <= 63 {
+      s += "."
+    } else if punct <= 84 {
+      s += "?"
+    } else {
+      s += "!"
+    }
+
+    master = append(master, s)
+  }
+
+  return strings.Join(master, " ")
+}
+
+func (ref *dictRef) paragraphs(src *source, min, max uint32) string {
+  var master []string
+  amount := src.motherr(min, max)
+
+  for i := uint32(0); i != amount; i++ {
+    sentence_amount := src.motherr(paragraphMinSentence,
+    paragraphMaxSentence)
+
+    sentences := ref.sentences(src, sentence_amount, sentence_amount)
+    if src.cancelled() { return "" }
+
+    master = append(master, sentences)
+  }
+
+  return strings.Join(master, "\n\n")
+}
+
+// sampleSubstring mirrors sample_substring_with_size in utility.c
+func sampleSubstring(src *source, s string, size int) string {
+  if len(s) == 0 || len(s) < size || size == 0 { return "" }
+  if len(s) == size { return s }
+
+  access := int(src.motherr(0, uint32(len(s) - 1)))
+  for (len(s) - 1) - access < size { access-- }
+
+  return s[access:access + size]
+}
+
+func capitalize(word string) string {
+  if len(word) == 0 || word[0] < 'a' || word[0] > 'z' { return word }
+  return string(word[0] - 'a' + 'A') + word[1:]
+}
+
+// capitalize (every word of a phrase, when phrases are drawn)
+func (ref *dictRef) capitalize(word string) string {
+  if !ref.phrases { return capitalize(word) }
+
+  parts := strings.Split(word, " ")
+  for i := range parts { parts[i] = capitalize(parts[i]) }
+
+  return strings.Join(parts, " ")
+}
+
+func min32(a, b uint32) uint32 {
+  if a < b { return a }
+  return b
+}
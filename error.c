@@ -1,3 +1,5 @@
+//go:build cgo && !chinwag_purego
+
 #include "error.h"
 
 char* cwerror_string(cwdict_t dict, cwerror_t code)
@@ -47,6 +49,11 @@ char* cwerror_string(cwdict_t dict, cwerror_t code)
     }
     else sprintf(result, "unable to sort dict");
   }
+  else if(code == CWERROR_CANCELLED)
+  {
+    sprintf(result, "CWERROR_CANCELLED : ");
+    sprintf(result, "generation was cancelled");
+  }
   else
   {
     sprintf(result, "CWERROR_DICT_UNKNOWN : ");
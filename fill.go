@@ -0,0 +1,274 @@
+package chinwag
+
+import (
+  "fmt"
+  "errors"
+  "reflect"
+  "strconv"
+  "strings"
+)
+
+// FillOption adjusts how Fill generates its text
+type FillOption func(*filler)
+
+// FillDict sets the dictionary used by fields that don't name one with dict=
+func FillDict(dict CWDict) FillOption {
+  return func(f *filler) { f.dict = dict }
+}
+
+// FillSeed makes Fill reproducible; the same seed fills the same values
+func FillSeed(seed uint64) FillOption {
+  return func(f *filler) {
+    src := newSource(uint32(seed ^ (seed >> 32)))
+    f.seed = src.mother
+  }
+}
+
+type filler struct {
+  dict CWDict
+  seed func() uint32
+
+  // struct types being filled, so self-referencing types stay finite
+  active map[reflect.Type]bool
+}
+
+// a parsed `chinwag:"..."` struct tag
+type fillTag struct {
+  name string
+  named namedKind
+  dict *CWDict
+  casing CWCase
+  counted bool
+  count [2]uint64
+}
+
+// Fill walks the struct pointed to by v, generating text for each empty
+// string field tagged with an output kind, its amounts, and optionally a
+// dictionary, a casing (named as in the template function cased) and, for
+// slices, an element count:
+//
+//   Name string `chinwag:"title"`
+//   Bio string `chinwag:"paragraphs,1,3,dict=latin"`
+//   Tags []string `chinwag:"words,1,2,case=kebab,count=3"`
+//   Posts []Post `chinwag:"count=2-5"`
+//
+// Nested structs, pointers and slices are filled too. Nil pointers are
+// allocated only when tagged, or when leading to a struct with tagged
+// fields; empty slices only when tagged with a count. Fields already
+// holding a value are left alone, and "-" skips a field.
+func Fill(v any, opts ...FillOption) error {
+  f := filler{dict: defaultDict, seed: nextSeed,
+  active: map[reflect.Type]bool{}}
+  for _, opt := range opts { opt(&f) }
+
+  value := reflect.ValueOf(v)
+  if value.Kind() != reflect.Pointer || value.IsNil() ||
+  value.Elem().Kind() != reflect.Struct {
+    return fmt.Errorf("fill: expected a pointer to a struct, got %T", v)
+  }
+
+  return f.fillStruct(value.Elem(), value.Elem().Type().Name())
+}
+
+func (f filler) fillStruct(value reflect.Value, path string) error {
+  f.active[value.Type()] = true
+  defer delete(f.active, value.Type())
+
+  for i := 0; i != value.NumField(); i++ {
+    field := value.Type().Field(i)
+    if !field.IsExported() { continue }
+
+    text, tagged := field.Tag.Lookup("chinwag")
+    if text == "-" { continue }
+
+    var tag *fillTag
+    if tagged {
+      parsed, err := parseFillTag(text)
+      if err != nil {
+        return fmt.Errorf("fill: %s.%s: %s", path, field.Name, err)
+      }
+
+      tag = &parsed
+    }
+
+    err := f.fillValue(value.Field(i), tag, path + "." + field.Name)
+    if err != nil { return err }
+  }
+
+  return nil
+}
+
+func (f filler) fillValue(value reflect.Value, tag *fillTag,
+path string) error {
+  switch value.Kind() {
+  case reflect.String:
+    if tag == nil || value.Len() != 0 { return nil }
+    if tag.name == "" {
+      return fmt.Errorf("fill: %s: missing output kind", path)
+    }
+
+    result, err := f.generate(*tag)
+    if err != nil { return fmt.Errorf("fill: %s: %s", path, err) }
+
+    value.SetString(result)
+  case reflect.Struct:
+    return f.fillStruct(value, path)
+  case reflect.Pointer:
+    if value.IsNil() {
+      if !f.fillable(value.Type().Elem(), tag) { return nil }
+      value.Set(reflect.New(value.Type().Elem()))
+    }
+
+    return f.fillValue(value.Elem(), tag, path)
+  case reflect.Slice:
+    if value.Len() == 0 {
+      if !f.fillable(value.Type(), tag) { return nil }
+
+      count := uint64(f.source().motherr(uint32(tag.count[0]),
+      uint32(tag.count[1])))
+
+      value.Set(reflect.MakeSlice(value.Type(), int(count), int(count)))
+    }
+
+    for i := 0; i != value.Len(); i++ {
+      err := f.fillValue(value.Index(i), tag, fmt.Sprintf("%s[%d]", path, i))
+      if err != nil { return err }
+    }
+  default:
+    if tag != nil && tag.name != "" {
+      return fmt.Errorf("fill: %s: cannot generate into %s", path,
+      value.Type())
+    }
+  }
+
+  return nil
+}
+
+// fillable reports whether allocating a value of type t leads anywhere;
+// strings only when tagged with a kind, slices only when tagged with a
+// count, and structs only when they hold tagged fields (and aren't already
+// being filled)
+func (f filler) fillable(t reflect.Type, tag *fillTag) bool {
+  switch t.Kind() {
+  case reflect.String:
+    return tag != nil && tag.name != ""
+  case reflect.Struct:
+    return !f.active[t] && hasFillTags(t, map[reflect.Type]bool{})
+  case reflect.Pointer:
+    return f.fillable(t.Elem(), tag)
+  case reflect.Slice:
+    return tag != nil && tag.counted && f.fillable(t.Elem(), tag)
+  }
+
+  return false
+}
+
+// hasFillTags reports whether struct type t, or a struct it holds (or
+// points to) through an untagged field, has a field tagged for Fill
+func hasFillTags(t reflect.Type, seen map[reflect.Type]bool) bool {
+  if seen[t] { return false }
+  seen[t] = true
+
+  for i := 0; i != t.NumField(); i++ {
+    field := t.Field(i)
+    if !field.IsExported() { continue }
+
+    if text, tagged := field.Tag.Lookup("chinwag"); tagged {
+      if text != "-" { return true }
+      continue
+    }
+
+    elem := field.Type
+    for elem.Kind() == reflect.Pointer { elem = elem.Elem() }
+
+    if elem.Kind() == reflect.Struct && hasFillTags(elem, seen) {
+      return true
+    }
+  }
+
+  return false
+}
+
+func (f filler) generate(tag fillTag) (string, error) {
+  dict := f.dict
+  if tag.dict != nil { dict = *tag.dict }
+
+  result, err := generateSeeded(dict, tag.named.kind, tag.named.min,
+  tag.named.max, f.seed())
+  if err != nil { return "", errors.New(ErrString(dict, err)) }
+
+  return Recase(result, tag.casing), nil
+}
+
+// source draws a one-off generator, for counts, from the fill's seeds
+func (f filler) source() *source {
+  return newSource(f.seed())
+}
+
+// parseFillTag reads "kind[,min[,max]][,dict=name][,case=casing]
+// [,count=n[-m]]"; every part is optional, though string fields need a kind
+func parseFillTag(text string) (fillTag, error) {
+  var tag fillTag
+  var amounts []uint64
+
+  for _, part := range strings.Split(text, ",") {
+    part = strings.TrimSpace(part)
+
+    switch {
+    case part == "":
+    case strings.HasPrefix(part, "dict="):
+      dict, err := templateDict(strings.TrimPrefix(part, "dict="))
+      if err != nil { return tag, err }
+
+      tag.dict = &dict
+    case strings.HasPrefix(part, "case="):
+      casing, ok := namedCases[strings.TrimPrefix(part, "case=")]
+      if !ok { return tag, fmt.Errorf("unknown casing %q", part) }
+
+      tag.casing = casing
+    case strings.HasPrefix(part, "count="):
+      bounds := strings.SplitN(strings.TrimPrefix(part, "count="), "-", 2)
+      if len(bounds) == 1 { bounds = append(bounds, bounds[0]) }
+      tag.counted = true
+
+      for i, bound := range bounds {
+        n, err := strconv.ParseUint(bound, 10, 32)
+        if err != nil { return tag, fmt.Errorf("invalid count %q", part) }
+
+        tag.count[i] = n
+      }
+
+      if tag.count[1] < tag.count[0] {
+        return tag, fmt.Errorf("invalid count %q", part)
+      }
+    default:
+      if n, err := strconv.ParseUint(part, 10, 64); err == nil {
+        amounts = append(amounts, n)
+        continue
+      }
+
+      named, ok := namedKinds[part]
+      if !ok || tag.name != "" {
+        return tag, fmt.Errorf("unexpected %q", part)
+      }
+
+      tag.name, tag.named = part, named
+    }
+  }
+
+  if len(amounts) != 0 && tag.name == "" {
+    return tag, fmt.Errorf("amounts given without an output kind")
+  }
+
+  switch len(amounts) {
+  case 0:
+  case 1:
+    tag.named.min, tag.named.max = amounts[0], amounts[0]
+  case 2:
+    tag.named.min, tag.named.max = amounts[0], amounts[1]
+  default:
+    return tag, fmt.Errorf("too many amounts (%d)", len(amounts))
+  }
+
+  return tag, nil
+}
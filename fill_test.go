@@ -0,0 +1,150 @@
+package chinwag
+
+import (
+  "time"
+  "strings"
+  "testing"
+)
+
+type fillComment struct {
+  Author string `chinwag:"words,2,2"`
+  Body string `chinwag:"sentences,1,2,dict=latin"`
+}
+
+type fillPost struct {
+  Title string `chinwag:"title"`
+  Summary *string `chinwag:"sentences,1"`
+  Tags []string `chinwag:"words,1,1,count=3"`
+  Comments []fillComment `chinwag:"count=2-4"`
+  Related *fillPost
+  Draft string
+  Ignored string `chinwag:"-"`
+  hidden string
+}
+
+func TestFill(t *testing.T) {
+  post := fillPost{Draft: "kept"}
+  if err := Fill(&post); err != nil { t.Fatal(err) }
+
+  if count := len(strings.Fields(post.Title)); count < 2 || count > 5 {
+    t.Errorf("expected a title of 2-5 words, got %q", post.Title)
+  }
+
+  if post.Summary == nil || *post.Summary == "" {
+    t.Error("expected the summary pointer to be allocated and filled")
+  }
+
+  if len(post.Tags) != 3 {
+    t.Errorf("expected three tags, got %d", len(post.Tags))
+  }
+
+  for _, tag := range post.Tags {
+    if len(strings.Fields(tag)) != 1 {
+      t.Errorf("expected single-word tags, got %q", tag)
+    }
+  }
+
+  if len(post.Comments) < 2 || len(post.Comments) > 4 {
+    t.Errorf("expected 2-4 comments, got %d", len(post.Comments))
+  }
+
+  for _, comment := range post.Comments {
+    if len(strings.Fields(comment.Author)) != 2 || comment.Body == "" {
+      t.Errorf("expected nested structs to be filled, got %+v", comment)
+    }
+  }
+
+  if post.Related != nil {
+    t.Error("expected self-referencing pointers to be left nil")
+  }
+
+  if post.Draft != "kept" || post.Ignored != "" || post.hidden != "" {
+    t.Error("expected untagged, skipped and unexported fields to be left alone")
+  }
+}
+
+type fillProfile struct {
+  Created *time.Time
+  Avatar *fillComment
+  Notes []fillComment
+  Aliases []string `chinwag:"words,1"`
+}
+
+func TestFillUntagged(t *testing.T) {
+  var profile fillProfile
+  if err := Fill(&profile); err != nil { t.Fatal(err) }
+
+  if profile.Created != nil {
+    t.Error("expected an untagged *time.Time to stay nil")
+  }
+
+  if profile.Avatar == nil || profile.Avatar.Author == "" {
+    t.Error("expected a pointer to a tagged struct to be allocated and filled")
+  }
+
+  if profile.Notes != nil || profile.Aliases != nil {
+    t.Error("expected slices without a count to stay empty")
+  }
+}
+
+func TestFillExisting(t *testing.T) {
+  post := fillPost{Title: "Kept", Related: &fillPost{}}
+  if err := Fill(&post); err != nil { t.Fatal(err) }
+
+  if post.Title != "Kept" {
+    t.Errorf("expected filled fields to be kept, got %q", post.Title)
+  }
+
+  if post.Related.Title == "" {
+    t.Error("expected an existing pointer to be followed")
+  }
+}
+
+func TestFillSeeded(t *testing.T) {
+  var first, second, other fillPost
+  Fill(&first, FillSeed(5))
+  Fill(&second, FillSeed(5))
+  Fill(&other, FillSeed(6))
+
+  if first.Title != second.Title || first.Tags[2] != second.Tags[2] ||
+  len(first.Comments) != len(second.Comments) {
+    t.Error("expected the same seed to fill the same values")
+  }
+
+  if first.Title == other.Title && *first.Summary == *other.Summary {
+    t.Error("expected another seed to fill other values")
+  }
+}
+
+func TestFillDict(t *testing.T) {
+  var comment fillComment
+  Fill(&comment, FillDict(latin))
+
+  for _, word := range strings.Fields(comment.Author) {
+    if !latin.Include(strings.ToLower(word)) && !latin.Include(word) {
+      t.Errorf("expected %q to come from the Latin dictionary", word)
+    }
+  }
+}
+
+func TestFillErrors(t *testing.T) {
+  var post fillPost
+  if Fill(post) == nil {
+    t.Error("expected a non-pointer to be refused")
+  }
+
+  var bad struct { Count int `chinwag:"words"` }
+  if Fill(&bad) == nil {
+    t.Error("expected generating into an int to fail")
+  }
+
+  var unknown struct { Name string `chinwag:"limericks"` }
+  if Fill(&unknown) == nil {
+    t.Error("expected an unknown kind to fail")
+  }
+
+  var backwards struct { Name string `chinwag:"words,5,2"` }
+  if Fill(&backwards) == nil {
+    t.Error("expected max less than min to fail")
+  }
+}
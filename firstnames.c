@@ -0,0 +1,6 @@
+//go:build cgo && !chinwag_purego
+
+#include "firstnames.h"
+
+const char* const dict_first_names = "Bo,Ada,Amy,Ana,Ann,Ari,Ash,Ava,Bea,Ben,Dot,Eli,Eva,Eve,Gus,Ida,Ike,Iva,Ivy,Jan,Jim,Joy,Kai,Leo,Lou,Mae,Max,Mel,Mia,Ned,Pam,Pat,Rae,Ray,Rex,Rob,Roy,Sal,Sam,Sue,Ted,Tom,Uma,Val,Vic,Zoe,Abby,Abel,Adam,Alan,Alex,Alma,Amos,Andy,Anna,Arlo,Axel,Beth,Bill,Brad,Cara,Carl,Cass,Chad,Clay,Cleo,Cody,Cole,Cora,Dale,Dana,Dave,Dean,Dina,Dora,Doug,Drew,Edna,Ella,Elsa,Emil,Emma,Enid,Eric,Erin,Esme,Etta,Evan,Ezra,Fern,Fred,Gail,Gary,Gene,Gina,Glen,Gwen,Hank,Herb,Hope,Hugo,Igor,Ilsa,Ines,Iris,Irma,Ivan,Jack,Jade,Jake,Jane,Jean,Jeff,Jess,Jill,Joan,Joel,John,Jude,Judy,June,Karl,Kate,Kent,Kira,Kurt,Lana,Lara,Leah,Lena,Leon,Lila,Lily,Lisa,Lois,Lola,Lucy,Luke,Lulu,Luna,Mack,Mara,Mark,Mary,Maya,Milo,Mina,Mona,Nate,Nell,Nico,Nina,Noah,Nora,Norm,Omar,Opal,Otis,Otto,Owen,Paul,Pete,Phil,Reed,Rita,Rosa,Rose,Ross,Ruby,Russ,Ruth,Sara,Saul,Seth,Stan,Sven,Tara,Tess,Theo,Tina,Toby,Todd,Vera,Wade,Walt,Will,Yara,Yves,Zack,Zara,Aaron,Adele,Agnes,Aiden,Alfie,Alice,Alina,Alvin,Amara,Amber,Andre,Angus,Anita,Annie,Anton,Ariel,Avery,Basil,Becky,Bella,Benny,Betty,Blair,Blake,Boris,Brett,Brian,Bruce,Bruno,Bryce,Caleb,Carla,Carol,Casey,Cecil,Chloe,Chris,Clara,Clark,Cliff,Clyde,Colin,Cosmo,Craig,Cyril,Daisy,Darla,Delia,Della,Derek,Diana,Dolly,Donna,Doris,Dusty,Dylan,Eddie,Edgar,Edith,Elise,Eliza,Ellis,Elmer,Elsie,Elton,Emily,Ethan,Ethel,Faith,Felix,Fiona,Flora,Floyd,Frank,Freda,Gemma,Grace,Greta,Hazel,Heidi,Helen,Henry,Holly,Homer,Isaac,James,Janet,Jenna,Jerry,Jonah,Josie,Jules,Karen,Keith,Lance,Laura,Linus,Mabel,Marco,Margo,Maria,Marta,Mateo,Maude,Miles,Molly,Nadia,Nancy,Olive,Oscar,Pearl,Peggy,Penny,Percy,Piper,Polly,Quinn,Ralph,Rhoda,Robin,Rocco,Rufus,Sadie,Scott,Selma,Silas,Sofia,Trudy,Vince,Viola,Wanda,Wendy,Willa,Zelda,Adrian,Agatha,Albert,Alexis,Alfred,Amelia,Andrea,Angela,Archie,Arnold,Arthur,Aubrey,Audrey,August,Aurora,Austin,Barney,Bertha,Bianca,Bonnie,Brenda,Brooke,Buster,Calvin,Carmen,Cedric,Claude,Connie,Curtis,Daniel,Daphne,Dennis,Duncan,Eileen,Elaine,Ernest,Esther,George,Gerald,Gloria,Gordon,Hannah,Harold,Harvey,Howard,Imogen,Jasper,Lester,Lionel,Maggie,Marvin,Millie,Miriam,Morris,Oliver,Stella,Ursula,Xavier,Abigail,Alberta,Barbara,Bernard,Bridget,Camille,Cecilia,Celeste,Charles,Charlie,Chester,Frances,Harriet,Beatrice,Philippa";
+const unsigned dict_first_names_len = 397;
@@ -0,0 +1,9 @@
+#ifndef __FIRSTNAMES_K4T8RW2P_H
+#define __FIRSTNAMES_K4T8RW2P_H
+
+#include "chinwag.h"
+
+extern const char* const dict_first_names;
+extern const unsigned dict_first_names_len;
+
+#endif
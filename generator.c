@@ -1,3 +1,5 @@
+//go:build cgo && !chinwag_purego
+
 // Implementation of the generator file
 //
 // Contents are confined to object synthesis,
@@ -489,40 +491,69 @@ U32 hashr(const char* string, U32 min, U32 max)
     return min + (hash(string) % (max - min + 1));
 }
 
+// generator state is kept per-thread (see CW_THREAD_LOCAL), so that
+// concurrent callers (e.g. goroutines sharing one dictionary) never race on it
+static CW_THREAD_LOCAL mother_t state = { 1, 0, { 199112345, 177667890,
+444454321, 196409876, 987654321 } };
+
+// applies a seed to a freshly reset generator
+static void mother_init(U32 seed)
+{
+    state.number = 0;
+    state.matka[0] = 199112345; state.matka[1] = 177667890;
+    state.matka[2] = 444454321; state.matka[3] = 196409876;
+    state.matka[4] = 987654321;
+
+    // one-line, seed-based, multiply-with-carry
+    // assignment loop...whew!
+    for(I8 i = 0; i != 4; ++i) state.matka[i] ^= (seed >> (i * 2));
+    state.start = 0;
+}
+
+void mother_seed(U32 seed)
+{
+    mother_init(seed);
+}
+
+mother_t mother_save()
+{
+    return state;
+}
+
+void mother_restore(mother_t saved)
+{
+    state = saved;
+}
+
 U32 mother()
 {
-    static U8  start = 1;
-    static U32 number, matka[5] = { 199112345, 177667890, 444454321,
-    196409876, 987654321 };
+    U32* matka = state.matka;
 
     // initialize on first run
-    if(start)
+    if(state.start)
     {
-        // get seed val based on milliseconds (previously seconds)
+        // get seed val based on milliseconds (previously seconds), mixed
+        // with the thread's own state address to separate threads seeded
+        // within the same millisecond
         struct timeval time;
         gettimeofday(&time, NULL);
 
-        U32 seed = time.tv_usec;
-
-        // one-line, seed-based, multiply-with-carry
-        // assignment loop...whew!
-        for(I8 i = 0; i != 4; ++i) matka[i] ^= (seed >> (i * 2));
-        start = 0;
+        mother_init((U32)time.tv_usec ^ (U32)(uintptr_t)&state);
     }
 
     // perform linear bitshift combinations; mere nanoseconds
     // slower than xorshift, but yields better randoms due to
     // a more globalized array processing technique
-    number += (matka[1] << 12) + (matka[2] >> 6)
-           +  (matka[3] << 10) + (matka[4] >> 8)
-           +  (matka[0]^(matka[0] >> 7));
+    state.number += (matka[1] << 12) + (matka[2] >> 6)
+                 +  (matka[3] << 10) + (matka[4] >> 8)
+                 +  (matka[0]^(matka[0] >> 7));
 
     // shift values in the array toward the front (i.e. "[0]")
     matka[0] = matka[1]; matka[1] = matka[2];
     matka[2] = matka[3]; matka[3] = matka[4];
 
     // push new working number onto the back of the array (i.e. "[4]")
-    matka[4] = (matka[4]^(matka[4] << 6))^(number^(number << 13));
+    matka[4] = (matka[4]^(matka[4] << 6))^(state.number^(state.number << 13));
 
     // combine two array indices for further randomization
     return matka[4] + (matka[2] + matka[2] + 4);
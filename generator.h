@@ -16,8 +16,28 @@
 U32 hash(const char* string);
 U32 hashr(const char* string, U32 min, U32 max);
 
+// storage kept per-thread
+#if defined(_MSC_VER)
+#define CW_THREAD_LOCAL __declspec(thread)
+#else
+#define CW_THREAD_LOCAL __thread
+#endif
+
+// state of the calling thread's generator
+typedef struct mother_state_type {
+  U8 start;
+  U32 number;
+  U32 matka[5];
+} mother_t;
+
 // custom mother-ish RNG; it is mother-like in terms
 // of the quality of the random numbers, but 2.5 times as fast. 
+// state is per-thread; seeding makes the sequence reproducible, and saving
+// and restoring lets a seeded run leave the thread's sequence untouched
+void mother_seed(U32 seed);
+mother_t mother_save();
+void mother_restore(mother_t saved);
+
 U32 mother();
 U32 motherr(U32 min, U32 max);
 F32 motherf();
@@ -0,0 +1,77 @@
+package chinwag
+
+// Index keeps a hash index of the dictionary's entries, making Include and
+// Exclude take constant time rather than scanning every row. The index is
+// kept up to date as words are appended or placed; only changes that may
+// alter or remove entries (Tweak, Prune, Clean and Close) leave it to be
+// rebuilt, on first use. Clones (and so snapshots) keep it.
+func (dict *CWDict) Index() *CWDict {
+  if dict.ref == nil { *dict = Open() }
+
+  dict.ref.indexed.Store(true)
+  return dict
+}
+
+// unindex (drops the index, returning to scanning)
+func (dict *CWDict) Unindex() *CWDict {
+  if dict.ref == nil { return dict }
+
+  dict.ref.indexed.Store(false)
+  dict.ref.members.Store(nil)
+
+  return dict
+}
+
+func (dict CWDict) Indexed() bool {
+  return dict.ref != nil && dict.ref.indexed.Load()
+}
+
+// memberSet is the index itself; it lives on the dictionary, rather than
+// among its derived values, so that additions update it in place
+type memberSet map[string]bool
+
+// members returns the index, building it if necessary, or nil when the
+// dictionary isn't indexed
+func (dict CWDict) members() memberSet {
+  if !dict.Indexed() { return nil }
+
+  for {
+    if cached := dict.ref.members.Load(); cached != nil { return *cached }
+
+    members := make(memberSet, dict.Length())
+    for word := range dict.All() { members[word] = true }
+
+    if dict.ref.members.CompareAndSwap(nil, &members) { return members }
+  }
+}
+
+// indexWords adds words to the index, if it has been built
+func (dict CWDict) indexWords(words ...string) {
+  if dict.ref == nil { return }
+
+  cached := dict.ref.members.Load()
+  if cached == nil { return }
+
+  for _, word := range words {
+    if word != "" { (*cached)[word] = true }
+  }
+}
+
+// indexRows replaces the index, if it has been built, with rows' entries
+func (dict CWDict) indexRows(rows [][]string) {
+  if dict.ref == nil || dict.ref.members.Load() == nil { return }
+
+  members := memberSet{}
+  for _, words := range rows {
+    for _, word := range words {
+      if word != "" { members[word] = true }
+    }
+  }
+
+  dict.ref.members.Store(&members)
+}
+
+// unindexRows drops the index, if any, to be rebuilt on first use
+func (dict CWDict) reindex() {
+  if dict.ref != nil { dict.ref.members.Store(nil) }
+}
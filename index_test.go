@@ -0,0 +1,101 @@
+package chinwag
+
+import (
+  "time"
+  "strconv"
+  "strings"
+  "testing"
+)
+
+func TestIndex(t *testing.T) {
+  dict := OpenEmbedded("Latin")
+  if dict.Indexed() { t.Error("expected dictionaries to start unindexed") }
+
+  dict.Index()
+  if !dict.Indexed() { t.Error("expected an indexed dictionary") }
+
+  for _, word := range []string{"aqua", "dolor", "terra"} {
+    if !dict.Include(word) || dict.Exclude(word) {
+      t.Errorf("expected %q to be found", word)
+    }
+  }
+
+  if dict.Include("grinch") || !dict.Exclude("grinch") {
+    t.Error("expected grinch to be missing")
+  }
+
+  // the index follows changes, updated in place
+  dict.PlaceWords("grinch")
+  if !dict.Include("grinch") { t.Error("expected a placed word to be found") }
+
+  dict.AppendWords("whoville")
+  if dict.ref.members.Load() == nil || !dict.Include("whoville") {
+    t.Error("expected an appended word to be indexed in place")
+  }
+
+  dict.Prune()
+  if dict.ref.members.Load() != nil || !dict.Include("whoville") {
+    t.Error("expected pruning to rebuild the index")
+  }
+
+  dict.Tweak(strings.ToUpper)
+  if dict.Include("grinch") || !dict.Include("GRINCH") {
+    t.Error("expected a tweaked word to be found as tweaked")
+  }
+
+  clone := dict.Clone()
+  if !clone.Indexed() || !clone.Include("AQUA") {
+    t.Error("expected a clone to keep its index")
+  }
+
+  dict.Unindex()
+  if dict.Indexed() || !dict.Include("AQUA") || dict.Include("aqua") {
+    t.Error("expected an unindexed dictionary to scan as before")
+  }
+
+  var blank CWDict
+  if blank.Index(); !blank.Indexed() || blank.Include("") {
+    t.Error("expected a blank dictionary to be indexable")
+  }
+}
+
+func TestIndexInsertIfAbsent(t *testing.T) {
+  words := make([]string, 5000)
+  for i := range words { words[i] = "w" + strconv.Itoa(i) }
+
+  insert := func(indexed bool) time.Duration {
+    dict := Open()
+    if indexed { dict.Index() }
+
+    start := time.Now()
+    for _, word := range words {
+      if dict.Exclude(word) { dict.AppendWord(word) }
+    }
+
+    if dict.Length() != uint64(len(words)) {
+      t.Errorf("expected %d words, got %d", len(words), dict.Length())
+    }
+
+    return time.Since(start)
+  }
+
+  scanned, indexed := insert(false), insert(true)
+  if indexed > scanned {
+    t.Errorf("expected indexed inserts (%v) to beat scanning (%v)", indexed,
+    scanned)
+  }
+}
+
+func TestUniqueWordsAtLength(t *testing.T) {
+  dict := OpenEmbedded("Latin")
+
+  // long enough that scanning the words so far would show
+  result, err := GenerateSeeded(dict, Words, 10000, 10000, 5)
+  if err != nil { t.Fatal(ErrString(dict, err)) }
+
+  seen := map[string]bool{}
+  for _, word := range strings.Fields(result) {
+    if seen[word] { t.Fatalf("expected unique words, got %q twice", word) }
+    seen[word] = true
+  }
+}
@@ -10,7 +10,10 @@
 #ifndef __INGREDIENT_9TPR28FI_H
 #define __INGREDIENT_9TPR28FI_H
 
+// SSE intrinsics are only available (and only needed) on x86 targets
+#if defined(__SSE__)
 #include <xmmintrin.h>
+#endif
 
 #include "chinwag.h"
 
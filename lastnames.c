@@ -0,0 +1,6 @@
+//go:build cgo && !chinwag_purego
+
+#include "lastnames.h"
+
+const char* const dict_last_names = "Ash,Day,Elm,Fox,Fry,Key,Law,Lee,May,Nye,Oak,Orr,Ames,Ball,Bean,Beck,Bell,Bond,Boyd,Buck,Bush,Byrd,Cain,Camp,Carr,Case,Clay,Cobb,Cole,Cook,Dale,Daly,Dane,Dean,Dell,Duke,Dunn,Dyer,Eden,Fair,Fenn,Ford,Gage,Gale,Gill,Good,Gray,Hale,Hall,Hart,Hawk,Hill,Holt,Hood,Hope,Horn,Howe,Hunt,Hyde,Ivey,Judd,Kane,Kemp,Kent,Kidd,King,Lake,Lamb,Lane,Lark,Lind,Lock,Long,Love,Lowe,Mace,Mann,Mead,Mill,Moon,Moss,Nash,Neal,Page,Park,Parr,Penn,Pike,Pine,Plum,Pope,Rain,Rand,Reed,Rice,Rich,Rock,Rose,Ross,Rowe,Rush,Sage,Salt,Shaw,Sims,Snow,Swan,Tate,Tree,Vale,Wade,Wall,Ward,Ware,Webb,West,Wolf,Wood,Wren,York,Acres,Adair,Adams,Alder,Allen,Arden,Avery,Baker,Banks,Beach,Berry,Black,Blair,Bloom,Booth,Bowen,Brand,Brown,Burke,Burns,Chase,Child,Cline,Combs,Crane,Cross,Curry,Davis,Dixon,Doyle,Drake,Eaton,Ellis,Emery,Evans,Faulk,Field,Finch,Flint,Flynn,Frost,Gable,Gates,Gibbs,Glass,Grant,Green,Grove,Hardy,Hayes,Heath,Hobbs,House,Hurst,Irons,James,Kirby,Knapp,Lewis,Lynch,March,Marsh,Mason,Miles,Moore,Noble,North,Olson,Owens,Paine,Payne,Perry,Poole,Pratt,Price,Quill,Quinn,Ridge,Roach,Robin,Sands,Scott,Sharp,Shore,Slate,Sloan,Small,Spark,Spear,Stark,Steel,Stone,Storm,Swift,Thorn,Tower,Vance,Watts,Wells,White,Wilde,Wills,Yates,Young,Abbott,Archer,Atwood,Bailey,Barber,Barker,Barlow,Barnes,Baxter,Bishop,Brewer,Brooks,Bryant,Burton,Butler,Carter,Conner,Cooper,Cotton,Dalton,Decker,Farmer,Farrow,Fisher,Forest,Foster,Fowler,Fuller,Glover,Golden,Gordon,Harper,Hudson,Ingram,Jacobs,Jarvis,Jewell,Keller,Knight,Mercer,Miller,Morrow,Oakley,Pearce,Potter,Ramsey,Rhodes,Rivers,Sawyer,Silver,Strong,Taylor,Temple,Tucker,Turner,Vaughn,Walker,Waters,Weaver,Winter,Wright,Ambrose,Baldwin,Bamford,Bennett,Bradley,Collins,Fleming,Gardner,Garland,Hammond,Meadows,Osborne,Summers,Wheeler,Caldwell,Dewberry,Kettleby,Larkspur,Sprocket,Whistler,Abernathy,Blackwood,Buttercup,Gumbleton,Hazelwood,Honeycutt,Oddsworth,Quickfoot,Rosewater,Underhill,Wimbleton,Woolworth,Cattermole,Marblehead,Nettlefold,Puddleston,Tumbleweed,Waddington,Brightwater,Fairweather,Pebblecombe,Thistlewood,Featherstone,Fiddlesworth,Merriweather";
+const unsigned dict_last_names_len = 330;
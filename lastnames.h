@@ -0,0 +1,9 @@
+#ifndef __LASTNAMES_Q7MZ3D9V_H
+#define __LASTNAMES_Q7MZ3D9V_H
+
+#include "chinwag.h"
+
+extern const char* const dict_last_names;
+extern const unsigned dict_last_names_len;
+
+#endif
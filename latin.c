@@ -1,3 +1,5 @@
+//go:build cgo && !chinwag_purego
+
 #include "latin.h"
 
 const char* const dict_latin = "a,c,d,e,f,k,l,m,n,o,p,q,r,t,ab,ac,ad,ah,ai,an,ap,as,at,au,be,cn,de,di,do,ec,ei,em,en,eo,eq,et,eu,ex,fe,ha,he,hs,hu,id,in,io,is,kl,lo,ls,mi,ne,ni,no,ob,oh,oi,os,pl,pr,si,sp,ss,st,ti,tr,tu,ut,va,abs,act,aer,aes,aex,afa,age,ago,aha,ain,aio,ait,ala,alo,ama,amb,ami,amo,ann,apo,app,apr,ara,aro,ars,arx,asa,ast,aug,aut,ava,ave,bae,bas,bat,bee,beo,bes,boa,boo,bos,box,bua,bul,bus,caf,ceu,cio,cis,cit,coa,cof,com,cor,cos,cum,cur,dea,dec,dem,des,dia,dii,dis,diu,dos,dum,duo,dux,edo,ego,eia,eli,emo,eno,ens,era,ero,etc,eva,far,fas,fax,feb,fel,fer,fio,flo,for,fur,git,gry,hac,hau,hei,hem,heu,hic,hin,hio,huc,hue,hui,ibi,ico,ile,imo,imp,ina,ion,ira,iro,ita,jam,jan,jod,jul,jun,jus,kal,kum,lac,lar,lea,leg,leo,lex,lis,luo,lux,mai,mam,man,mas,mei,mel,mem,meo,mil,mio,mna,mos,mox,mus,nae,nam,nec,neo,neu,nex,nil,nix,non,nos,nov,nox,num,nun,nuo,nux,oct,odi,ohe,ops,ora,oro,ovo,pan,par,pax,per,pes,phy,pio,pix,plo,pol,pop,pro,pus,qua,que,qui,qum,quo,qur,rea,ren,res,rex,rho,ros,ruo,rus,sal,sat,sed,sen,ser,set,seu,sex,sic,sin,sol,sto,sub,sui,suo,sus,tam,tat,ter,tib,tim,toc,tot,tui,tum,tus,ubi,uls,una,uro,uti,uva,vae,vah,vas,vav,vel,ver,via,vir,vis,vix,vos,vox,zai,zea,zio,abax,abba,abdo,abeo,abra,aceo,acer,acia,acna,acor,acra,acro,acsi,acta,acte,actu,acuo,acus,adam,adar,addo,adeo,adno,ador,aedo,aeer,aena,aeon,aera,aero,afer,agea,ager,agma,agna,agon,alba,albo,alce,alea,alec,aleo,ales,alex,alga,algu,alia,alii,alio,aloe,alte,alto,alum,alus,amen,ames,amia,ammi,amor,amos,anas,anca,ango,anne,anno,ansa,anta,ante,anus,aper,apes,apex,apio,apis,apra,apte,apto,apua,apud,apus,aput,aqua,arar,arca,arco,arcs,area,areo,aria,aris,armo,arna,aron,aros,arra,arte,arto,arum,arva,asia,asio,assa,asso,asta,asto,astu,asty,atat,ater,atta,audo,aula,aura,auro,aveo,avia,avis,avos,avus,axio,axis,axon,baal,baca,baia,balo,baro,baxa,bebo,beda,beia,belo,bema,bene,beta,beth,beto,bibo,biga,bino,bios,bito,boca,boia,bona,boto,boum,bova,bovo,bria,brya,bubo,buda,bufo,bule,bura,caco,cado,cael,caia,caio,cala,calo,calx,cama,cano,capa,caph,capo,care,caro,casa,casu,cata,cate,cato,cava,cavo,cedo,celo,cena,ceno,cepa,cepe,ceps,cera,cero,ceua,ceva,ceyx,chia,chus,cibo,cici,cieo,cilo,cite,cito,clam,clon,cluo,coco,coda,coec,coel,coeo,cogo,coix,cola,colo,coma,come,como,cong,cons,copa,coph,copo,cops,cora,cors,coss,coum,cous,coxa,coxo,cras,creo,crus,crux,cubi,cubo,cuci,cudo,culo,cuma,cumi,cuna,cupa,cupo,cura,curo,cuso,cyix,cyma,cyna,dama,daps,dato,dedo,dego,dein,demo,dens,deus,dica,dico,dido,dies,diis,dine,diox,dira,dito,dium,dius,diva,doga,dolo,doma,domo,dono,doto,doxa,duco,duis,duro,ebur,ecca,ecce,ecfo,edax,edus,effo,egeo,ehem,eheu,ehoi,elix,eloi,elul,eluo,emax,enim,eous,ephi,epos,equa,erga,ergo,eris,erro,eruo,erus,esca,esus,etas,etsi,euge,euro,euus,exeo,exim,exin,exos,exto,exul,exuo,ezra,faba,faex,fala,falx,fama,fano,fars,faux,felo,fera,fere,fero,feto,fido,figo,fine,fini,fleo,flos,fluo,fons,fors,frio,frux,fuco,fuga,fugo,fumo,furo,furs,gata,gaza,gelo,gelu,gemo,gena,geno,gens,genu,gero,gith,glas,glis,grex,grus,gula,gyro,halo,hama,hara,haud,haut,have,heia,heli,hera,here,heri,heth,heus,heva,hinc,homo,hora,humi,humo,hyas,ibex,ibis,ibix,icio,icon,idea,idem,ideo,idos,idus,iens,ilex,ille,illo,ilum,immo,imus,inde,indo,ineo,inno,inri,iota,ioth,ipse,iris,isai,isse,iste,isti,isto,item,iter,itus,ixon,joco,juba,jubo,juda,jugo,juno,jure,juro,juvo,kalo,labo,lact,lama,lana,lanx,late,laus,lava,lavo,laxe,laxo,lego,lema,lena,leno,lens,leto,levo,libo,lien,liga,ligo,lima,limo,lino,lito,lixa,loba,loco,lous,ludo,lues,luna,luno,lupa,luxo,lynx,lyra,mala,male,malo,mane,mano,mare,maro,mars,mart,meio,melo,mena,mens,merx,mese,meta,meto,meus,mica,mico,mill,milo,mima,mina,mino,mire,miro,misy,modo,mola,molo,moly,mons,mora,mors,mosa,moto,mula,musa,muto,myxa,nais,nata,nato,navo,neco,nego,nemo,nepa,nero,nete,neve,nexo,nisi,nodo,nola,nolo,nona,nota,noto,nove,novi,novo,noxa,nubo,nubs,nudo,nuga,nunc,nuto,obba,obdo,obeo,obex,occo,ocis,octo,odeo,odio,odor,offa,oiei,olea,oleo,olim,olla,olle,olor,olus,omen,omne,onus,onyx,opto,opus,orbo,orca,ordo,orno,orto,oryx,otho,otis,otus,ovis,ovum,paco,paga,pala,pane,papa,paro,pars,pava,pavo,pecu,pena,pepo,pera,pero,peto,pica,pico,pigo,pila,pilo,pipa,piso,pius,plas,plex,plio,pluo,plur,plus,plut,pone,pono,pons,popa,post,pote,poto,prae,prex,puer,puga,puls,pupa,pusa,puto,pyga,pyra,quam,queo,quia,quin,quis,quod,quom,quor,quot,quum,quur,raca,rado,rana,rapa,rare,raro,rego,reno,reor,repo,rete,reus,rica,rigo,rima,ripa,rite,rixa,rodo,rogo,roma,roro,rosa,rota,roto,rudo,ruga,rugo,runa,ruro,rusi,ruta,sade,saga,salo,sane,sano,sapa,sapo,saul,scio,seco,sedo,seia,sept,sera,sero,seta,sext,sica,sida,sido,sifo,sima,sine,sino,sipo,sive,soca,sono,sons,sors,spes,spqr,spuo,styx,subo,sudo,sueo,sugo,sumo,supo,sura,suum,suus,taxo,tego,tela,telo,temo,terg,tero,teth,texo,thau,thea,thus,thya,tina,tiro,toga,tono,trax,tres,trex,trin,trio,trux,tuba,tunc,tuor,tute,tuto,tuus,uber,udus,ulna,ulva,umbo,umeo,umor,unda,unde,undo,ungo,unio,unus,urbs,urco,urna,ursa,urus,usus,uter,utor,utro,utut,uvor,uxor,vaco,vado,vage,vaha,veho,velo,vena,vere,vero,veru,veto,vexo,vieo,visa,viso,vita,vito,vivo,voco,volo,vomo,voro,voto,vulo,vult,xvir,zain,zelo,zeno,zeta,zona,abbas,abico,abies,abiga,abigo,abito,abluo,abnuo,absis,absit,absto,absum,absus,acceo,accio,achor,acide,acies,acina,aclys,acnua,acona,acron,acror,acrum,actio,actor,actum,actuo,actus,acueo,acula,acuna,acute,adamo,adaro,addax,adedo,adeps,adflo,adfor,adfuo,adger,adhoc,adhuc,adigo,adimo,adips,adito,adluo,admeo,adnuo,adoro,adque,adqui,adquo,adruo,adser,adsto,adsum,adsuo,adubi,adulo,aduno,aduro,aecor,aecum,aecus,aedes,aedis,aedon,aedus,aeger,aegis,aegre,aenum,aenus,aeque,aequo,aesum,aetas,aevum,aevus,afflo,affor,affuo,afluo,afore,agaga,agape,agaso,agema,agens,agero,ageto,agger,agina,agino,agios,agite,agito,agmen,agnos,agnua,agnus,agoga,agoge,aguna,aiens,aisne,aizon,ajuga,alapa,albeo,albis,albor,album,albus,alcea,alces,aleph,algeo,algor,algus,alias,alibi,alica,alium,alius,allec,allex,alluo,allus,almus,alnus,alpha,alpis,alsus,altar,alter,altor,altum,altus,aluta,alvus,amans,amare,amata,ambio,ambon,ambro,amens,amias,amica,amice,amico,amita,amium,ammeo,amnis,amodo,ampla,ample,amplo,amula,amylo,anclo,ancon,ancra,ancus,angor,anima,animo,annon,annuo,annus,anser,antea,anteo,antia,antis,antoo,anxie,anxio,apage,aphya,aphye,apica,apina,apios,apium,apsis,apsit,apsto,apsum,aptha,aptus,aquor,arabs,arale,arbor,arbos,arceo,arche,arcte,arcto,arcuo,arcus,ardea,ardeo,ardor,ardus,arena,arens,arguo,arida,aride,ariel,aries,armum,armus,arnus,aroma,arrha,arruo,arsen,arsis,artio,artro,artum,artus,arula,arura,arvix,arvum,arvus,ascea,ascia,ascio,asina,asper,aspis,aspuo,asser,assis,assum,assuo,assus,aster,astur,astus,asyla,atate,athla,atlas,atque,atqui,atrox,attat,auceo,aucto,audax,audem,audeo,audio,augeo,auger,augur,aulax,aulix,aulon,aulos,aulus,aurea,auris,aurum,ausum,ausus,autem,autus,avare,aveho,avena,avens,avide,avite,avium,avius,avoco,avolo,axedo,azura,babae,bacar,bacca,baeto,balbe,balis,ballo,balux,banca,barba,barbo,barca,baris,basio,basis,batia,batis,batto,batuo,batus,baubo,baxea,beate,beber,bebra,bekah,belga,belle,bello,belua,benna,berna,betis,bibax,bibio,bifax,bifer,bigna,bigus,bilbo,bilis,bilix,bimus,binio,binus,bipes,bisen,bison,bissa,bisse,bivia,bobus,bocas,boius,bolis,boloe,bolos,bolus,bomba,bonum,bonus,boria,boxum,braca,breve,brevi,brisa,brito,bruma,bryon,bucca,bucco,bugia,bulga,bulla,bullo,burdo,buris,burra,bursa,buteo,butio,buxum,buxus,cabus,cacao,cacus,cadus,caeco,caedo,caela,caelo,caena,caeno,caepa,caepe,caesa,caeso,cafea,calco,calda,caleo,calix,calor,calos,calta,calva,calvo,calyx,campe,camum,camur,camus,caneo,canes,cania,canis,canna,canno,canon,canor,canto,canua,canum,canus,capax,caper,capio,capis,cappa,capra,capsa,capso,capto,capua,capus,caput,carbo,cardo,careo,carex,caris,caros,carpa,carpo,carro,carta,carus,casce,casia,casso,caste,casus,catax,catta,catus,cauda,caula,cauma,caupo,causa,causo,caute,cauto,cavea,caveo,cavia,cavum,cavus,celer,celes,celia,cella,celox,celse,celum,cemos,cento,cenum,ceras,cerdo,cerea,ceres,ceria,cerno,cerro,certe,certo,cerva,ceryx,cesso,cetos,cetra,cette,cetus,ceveo,chalo,chama,chane,chaos,chara,chaus,chele,chely,chema,chere,chilo,chium,chius,choma,chora,chors,chria,cibus,cicer,cicur,cilio,cillo,cimex,ciner,cingo,cinis,cinus,cipus,circa,circo,ciris,cista,citer,citra,citro,citus,civis,clamo,clare,claro,clava,clavo,clema,clepo,clima,clino,clive,clodo,cludo,clueo,cluma,cluna,clura,clusa,cnide,cnisa,coalo,coaxo,cobio,cocio,cocoa,cocos,cocta,cocus,codex,coemo,coena,coeno,cohum,cojux,coles,colis,colon,color,colos,colpa,colum,colus,colyx,comes,comis,comma,commi,comte,conca,condo,conea,conia,conor,conss,conus,copia,copis,coppa,copta,copto,coqua,coque,coquo,coram,corax,corda,corgo,coris,cornu,corsa,corus,costa,cotes,cotho,cotio,cotta,coxim,coxus,crebo,credo,cremo,crena,crepa,crepo,creta,crete,cripa,criso,crita,croca,croco,croma,croto,cruor,cubus,cucus,cujas,cujus,culex,culix,culpa,culpo,culte,culus,cumba,cummi,cuneo,cunio,cupes,cupio,cupla,cuppa,cures,curia,curio,curis,curro,curso,curto,curvo,cusio,cusuc,cutio,cutis,cybus,cylix,cylon,cymba,cynas,cynon,cyphi,cytis,dalia,damma,damno,danus,dapis,dasea,dasia,datio,dator,datum,datus,david,deago,deamo,debeo,decas,decem,decet,decor,decum,decus,dedux,defio,deflo,deleo,delta,deluo,demeo,demum,demus,dense,denso,denuo,depso,deque,deruo,deses,desub,desum,desuo,deunx,deuro,devio,devus,diana,dicax,dicio,dicis,dicto,digma,digne,digno,diluo,dinus,diota,dirum,diruo,dirus,disco,disdo,disto,ditio,ditto,dives,divum,divus,doceo,dogma,doleo,dolor,dolus,domna,domne,domus,donec,donum,dosis,draco,drama,dromo,druis,dryas,ducto,dudum,dulce,dulco,dulia,dumus,duplo,durum,durus,eadem,ebibo,eccam,eccas,eccli,eccum,ecfio,ecfor,edico,edius,edolo,edomo,educo,effio,efflo,effor,egens,egero,eicio,eiero,ejulo,ejuro,elata,elate,elevo,elido,eligo,elimo,eludo,emano,emico,enato,eneco,enema,enico,enixe,enodo,ensis,enubo,eodem,eosos,ephod,epops,epoto,epula,eques,equus,erado,erica,erigo,erogo,error,eruca,erugo,ervum,etiam,eugae,euhoe,eurus,evado,evans,eveho,eviro,evito,evoco,evolo,evomo,exaro,excio,execo,exedo,exero,exico,exide,exigo,eximo,exlex,exoro,exors,expes,expio,expuo,exsto,exsul,exter,extra,extum,exudo,exulo,exuro,faber,fabre,facio,fagus,falda,fallo,falso,famen,fames,fanum,fanus,fares,fatua,fatue,fatum,fauna,faveo,favor,favus,fedus,feles,felio,felis,felix,fello,femen,femur,fenum,fenus,ferax,feria,ferio,ferme,ferox,ferus,fervo,feteo,fetor,fetus,fiala,fiber,fibra,ficte,ficus,fides,fidis,fidus,filia,filix,filum,fimum,fimus,findo,fingo,finio,finis,firmo,fixum,fixus,fligo,flora,fluus,focal,focus,fodio,foede,foedo,foeto,fomes,foras,forda,foris,forma,formo,forte,forum,forus,fossa,fovea,foveo,fraus,fremo,freno,frico,frigo,frixo,fromo,frons,frugi,fruor,fucus,fugax,fugio,fullo,fumus,funda,fundo,funis,funus,furax,furca,furia,furio,furor,fusco,fusus,futuo,gadus,gaius,galba,galea,galeo,ganea,ganeo,gaola,garba,garda,garum,gasum,gatta,gelda,gelum,gelus,gemma,gemmo,gener,genes,genum,genus,gerra,gerro,gesto,gigas,gigno,gilda,gildo,gimel,gitti,glaba,glans,gleba,glosa,glubo,gluma,gluto,gnata,gnovi,gobio,gomor,grate,gravo,grego,groma,gruis,gruma,gryps,gulda,gumia,gummi,gunna,guoma,gusto,gutta,gypso,gyrus,habeo,haicu,hamus,harpe,harum,hasta,haveo,hebeo,hebes,hedus,heluo,herba,hereo,heres,herma,heros,herus,hexas,hibix,hiemo,hiems,hiera,hilum,hippo,hisco,hodie,holus,honor,honos,horeb,horia,humor,humus,hydra,hymen,hyrax,ianus,ictus,ignio,ignis,iivir,ilico,ilict,ilion,illac,illex,illic,illim,illoc,illuc,imago,imber,imbuo,imito,impar,impio,impos,inane,inaro,incus,index,india,induo,indus,iners,infer,infio,inflo,infra,inhio,inibi,inlex,innuo,inops,inpar,inpos,inruo,insto,insum,insuo,inter,intra,intro,intus,inula,inuro,irate,irnea,irruo,istac,istic,istoc,istuc,itero,jacca,jaceo,jacio,jacob,jacto,janua,janus,jecur,jento,jesus,jocor,jocur,jocus,jovis,jubar,jubeo,judas,judes,judex,juger,jugis,jugum,julis,julus,jungo,jurgo,juste,jusum,juvat,juxta,kaeso,kaput,kardo,karus,koppa,kyrie,labda,labeo,labes,labia,labor,labos,lacer,lacte,lacto,lacus,laedo,laena,laete,laeto,laeva,lambo,lamed,lamia,lamma,lamna,lanio,lanna,lapis,lappa,lapso,large,largo,larix,laros,larua,larus,larva,larvo,laser,lasso,lateo,later,latex,latio,lator,latro,latus,laudo,laura,laute,laxus,lebes,legio,lemma,lemur,lenio,lenis,lente,lento,lepas,lepor,lepos,lepra,lepus,letum,levis,levor,liana,liber,libet,libra,libro,libum,libya,libye,liceo,licet,liger,ligia,limax,limen,limes,limma,limus,linea,lineo,lingo,linio,linum,liquo,litra,litus,liveo,livor,lobia,lobus,locum,locus,lodix,logos,logus,longe,lorea,lorum,lorus,lotor,lotos,lotus,lubet,lucar,lucas,luceo,lucus,ludia,ludio,ludus,lugeo,lumen,lupio,lupus,lurco,luror,lusio,lusor,luter,lutum,lutus,luxus,macea,macer,macia,macte,macti,macto,madeo,maena,magia,magis,magma,magus,maius,major,malum,malus,malva,mamma,mando,maneo,manga,mango,manhu,mania,manis,manna,manus,mappa,marca,marco,margo,maria,marlo,marra,massa,masso,mater,matta,maumo,mavis,mazer,mazza,medie,medio,meile,mello,melos,melum,melus,memor,menda,mensa,menta,mento,merda,mereo,merga,mergo,merso,merum,merus,meses,meson,metor,metuo,metus,migma,migro,miles,milio,mille,mimus,minax,mineo,mingo,minor,minuo,minus,miror,mirus,miser,missa,mitis,mitra,mitto,modus,moene,moles,molta,molto,momen,moneo,morio,moror,morua,morum,morus,moses,motio,motus,moveo,mucor,mucro,mucus,mugil,mugio,mulco,mulio,multa,multi,multo,mulus,mumia,mundo,munio,munus,murex,muria,murra,murus,musca,musso,mutto,mutuo,mutus,myops,myrra,mysta,myxum,myxus,nable,nadir,naias,nanus,naris,narro,narta,narto,nassa,nasus,natio,natis,natus,nauta,navis,navus,necne,necto,nedum,nefas,nempe,nemus,nenia,nepos,neque,nerva,neuma,nexum,nexus,nicto,nidor,nidus,niger,nigro,nihil,nilum,nimio,nimis,ningo,ningt,nisan,nisus,niteo,nitor,nixor,nixus,noceo,noctu,nodus,nomas,nomen,nonne,norma,nosco,noteo,notio,notum,notus,novem,novus,noxia,nubes,nubis,nudus,nugor,numen,nuper,nupta,nurus,nutus,obaro,obruo,obses,obsto,obsum,obsuo,obvio,ocior,ocrea,odium,odoro,oephi,olens,oleum,oliva,ollus,olyra,omega,omnia,omnis,onero,opaco,opera,opero,opium,optio,opupa,orbis,orbus,orcus,origa,origo,orior,ornus,orsum,orsus,ortor,ortus,ortyx,oryza,oscen,oscus,ossum,otior,otium,ovile,oxydo,padus,paean,paene,paeon,pagus,palam,palea,palla,palma,palor,palpo,palum,palus,palux,panax,pando,pango,panis,pansa,papas,pappo,parca,parce,parco,pareo,pario,parma,parum,pasca,pasco,pateo,pater,pator,patro,paulo,pausa,pauso,paveo,pavio,pavor,pavus,pecco,pecto,pecus,pedes,pedum,pegma,pelex,pello,pelta,penas,pendo,penes,penis,penna,penso,pente,penum,penus,pepon,perca,perdo,pereo,pergo,perna,persa,petra,petro,phala,phase,phito,phoca,phyle,picea,picus,piens,piger,piget,pigro,pilum,pilus,pingo,pinna,pinso,pinus,piper,pipio,pirum,pirus,pisum,pitta,pixis,placo,plaga,plago,plane,plato,plebs,plene,pleps,plico,plodo,ploro,pluma,pluto,podex,poema,poena,poeta,polio,polum,polus,pompa,pompo,pomum,pomus,pondo,ponto,porca,porna,porro,porta,porto,posco,posea,potio,potis,potor,potus,praes,pravo,premo,primo,prior,prius,privo,proba,probe,probo,proco,prodo,proin,promo,prope,prora,prosa,prout,pruna,psora,pubes,pudeo,pudet,pudor,puera,pugil,pugio,pugna,pugno,pulex,pulmo,pulpa,pulso,pulto,pumex,pungo,punio,punus,purgo,purum,purus,pusio,pusus,puteo,puter,putro,putus,puxis,pycta,pyxis,quaad,quare,quasi,quies,quint,quoad,rabbi,rabio,racha,radio,radix,raeda,ramex,ramus,rapax,rapio,rapto,rapum,rarus,ratio,ratis,ratus,ravus,reboo,recta,recte,reddo,redeo,redux,reflo,regia,regio,regno,remeo,remex,remus,reneo,renuo,repto,repuo,reses,resto,retro,rheno,ribes,rideo,rigeo,rigor,rimor,ringa,risor,risus,ritus,rivus,rixor,robbo,robor,robur,robus,rodus,rogus,rolvo,rubeo,ruber,rubia,rubor,rubus,ructo,rudis,rudus,rufus,rugio,ruina,rumex,rumor,rumpo,runco,rupes,ruror,rutus,sacco,sacer,sacro,saepe,saeps,saeta,sagax,sagma,sagum,sagus,salax,salio,salix,sallo,salpa,salto,salum,salus,salve,salvo,sanna,sanus,sapio,sapor,sario,satan,satio,satis,sator,satur,satus,sauna,saxum,scabo,scala,scato,scena,scida,scius,scobo,scola,scopa,scopo,screo,sebum,secor,secum,secus,sedda,sedeo,sedes,seges,seius,selah,sella,semen,semis,seneo,senex,senio,senus,separ,sepes,sepia,sepio,seren,seria,serio,sermo,serpo,serra,serro,serta,serum,serus,serva,servo,sexus,siban,sicco,sicut,sidus,sifra,sifus,sigma,signo,sileo,siler,silex,silus,silva,simia,simul,simus,sinum,sinuo,sinus,sipar,sipho,sipus,siren,sirpo,sirus,sisto,sitio,sitis,situs,socer,socia,socio,sodes,solea,soleo,solor,solox,solum,solus,solvo,sonor,sonus,sopio,sopor,sorex,soror,soter,spado,spata,spero,spica,spico,spina,spino,spira,spiro,spons,spuma,spumo,sputo,stega,stela,stibi,stipo,stips,stiva,stola,stria,strio,strix,struo,stupa,suada,subdo,subeo,suber,subex,sucus,sudis,sudor,sudum,sudus,sufes,sulco,sulla,sulum,sumen,summa,summe,super,supra,surgo,suria,surpo,surus,susum,sutor,syria,syrma,syrus,tabeo,tabes,tabum,taceo,taeda,tagax,talea,talio,talis,talla,talpa,talus,tamen,tango,tapes,tardo,taxus,tecel,telis,telum,temno,tenax,tendo,teneo,tener,tenor,tensa,tento,tenuo,tenus,tepeo,tepor,terci,teres,tergo,terma,terra,testa,testu,teter,theca,thema,thrax,threx,thyia,tiara,tibia,tilia,timeo,timor,tinea,tineo,tingo,tinus,titan,titio,titus,tofus,tollo,tomix,tonat,tonna,tonos,tonsa,tonus,toral,torno,torta,torto,torus,totus,traba,trabs,trado,traex,traha,traho,trama,trano,trans,tremo,trias,trica,trico,triga,tripl,trite,trudo,truso,tuber,tubur,tubus,tueor,tumba,tumeo,tumor,tundo,turba,turbo,turma,turpe,turpo,tutor,tutus,typus,tyros,tyrus,ubius,ulcus,uligo,ullus,ulmus,ultio,ultor,ultra,ultro,ulula,ululo,umbra,umbro,umens,uncia,uncus,unguo,unice,upupa,uredo,urgeo,urina,urino,ursus,usque,ustio,ustor,usura,utens,utica,utrum,vacca,vacuo,vadio,vador,vadum,vafer,vagio,vagor,vagus,valde,valeo,valet,vallo,valor,valva,vanus,vapor,vappa,vario,varix,varus,vasto,vasum,vasus,vates,vatis,vecto,vegeo,veles,vello,velox,velum,velut,vendo,veneo,venia,venio,venor,venum,venus,verax,vergo,verna,verno,verpa,verro,verso,verto,verum,verus,vespa,vesta,veter,vetus,vibro,vicia,vicis,vicus,viden,video,viduo,vigeo,vigil,vigor,vilis,villa,vimen,vinal,vinco,vinea,vinia,vinum,viola,violo,vireo,virga,virgo,viror,virum,virus,visco,visio,vison,visum,visus,vital,vitex,vitil,vitio,vitis,vitta,vitus,vivax,vivus,volgo,volup,volva,volvo,vomer,vorax,votum,voveo,vulgo,vulva,warra,werra,xenon,xeres,xylon,yatus,zaeta,zagon,zamia,zebra,zelus,zenon,zerum,abacus,abante,abavia,abavus,abbibo,abbito,abdico,abdite,abduco,aberro,abfero,abfluo,abhinc,abicio,abinde,abitio,abitus,abjugo,abjuro,ablego,abloco,abludo,abnato,abnego,abnodo,abnueo,abnuto,aboleo,abolla,aborto,abrado,abrodo,abrogo,absens,absida,absone,absono,absque,absumo,abunde,abundo,abusio,abusor,abusus,abutor,acacia,acanos,acanus,acarna,acarne,acarus,acatus,accano,accedo,accido,accidt,accieo,accino,accola,accolo,accubo,accudo,accuro,accuso,acedia,acerbe,acerbo,acerra,acerus,acervo,acesco,acesis,acetum,acheta,achlis,achras,acidum,acidus,acinos,acinum,acinus,acopos,acopum,acorna,acoron,acoros,acorum,acorus,acredo,actaea,actito,active,actrix,aculos,acumen,acutor,acutus,acylos,adaero,adagio,adamas,adamus,adapto,adaquo,adarca,adarce,adbibo,adbito,adcedo,adcola,adcolo,adcubo,adcudo,adcuro,addico,adduco,aderro,adesco,adesus,adfero,adfigo,adfleo,adfluo,adfrio,adgemo,adgero,adhalo,adhamo,adicio,adindo,aditio,aditus,adjugo,adjuro,adjuto,adjuvo,adlavo,adlego,adlevo,adlido,adligo,adlino,adludo,adnato,adnego,adnius,adnodo,adnoto,adnuto,adoleo,adolor,adonai,adopto,adorea,adoria,adorio,adorno,adparo,adpeto,adplex,adpono,adquin,adrado,adrepo,adrigo,adrodo,adrogo,adroro,adscio,adsedo,adsero,adsido,adsolo,adsono,adspuo,adsugo,adsumo,adtero,adtexo,adtigo,adtina,adtono,adtulo,adtuor,adulor,adveho,advelo,advena,advivo,advoco,advolo,adytum,aeduus,aegreo,aegror,aegrum,aemula,aemulo,aeneum,aeneus,aequor,aequum,aequus,aercus,aereus,aerius,aeruca,aerugo,aestas,aestuo,aestus,aether,aethon,aethra,aetoma,afanna,affero,affigo,affleo,affluo,affrio,aforem,afores,aforet,africa,agalma,agamus,agatur,agedum,agenda,aggemo,aggero,aggeus,agilis,agipes,agnata,agnina,agolum,agonal,agonia,agonio,agrius,ahenum,ahenus,aizoon,alacer,alaris,alatus,alauda,alausa,alazon,albedo,albens,albico,albion,albugo,alcedo,alcima,alcyon,alebre,alesco,algens,alieno,alifer,aliger,alipes,alipta,aliqua,aliqui,aliquo,alisma,aliter,alitus,aliubi,aliuta,allavo,allego,allevo,allido,alligo,allino,allium,alloco,alludo,alneus,alogia,alogus,alopex,alphos,alphus,alsine,alsius,altare,altrix,alumen,alumna,alumno,alveum,alveus,alypon,amando,amaror,amarum,amarus,amasco,amasia,amasio,amatio,amator,amatus,amazon,ambago,ambedo,ambiga,ambigo,ambulo,amburo,amento,ametor,amicio,amicus,amigro,amilum,amitto,ammium,ammodo,amoene,amoeno,amomis,amomon,amomum,amotio,amoveo,amplio,amplus,ampola,amputo,amtruo,amulum,amurca,amurga,amusia,amusos,amylum,ancala,ancale,ancele,anceps,ancile,ancips,ancora,ancter,ancula,anculo,ancyla,andena,andron,andruo,anesum,anetum,angina,anglia,anglus,anguis,angulo,anhelo,anicla,anilis,animal,animax,animus,anisum,annale,annata,annato,annego,annius,annodo,annona,annoto,annuto,annuum,annuus,anonis,ansula,anteeo,anthus,antias,antlia,antrum,antruo,anulus,anxior,anxius,apalus,apello,aperio,aperte,aperto,aphaca,aphtha,apiana,apluda,apocha,apollo,aporia,aporio,apparo,appeto,applar,applex,appono,aprico,apruco,apruna,apsens,apsida,apsone,apsono,apsque,apsumo,aptrum,apyros,aquate,aqueus,aquila,aquilo,aquola,aquula,arabia,aracia,aracos,aranea,araris,arater,aratio,arator,aratro,aratum,arcano,arcera,archon,arcion,arctos,arctus,arcula,arcuma,ardens,arduum,arduus,arduvo,arenga,areola,aresco,argema,argute,arguto,aridum,aridus,ariera,arieto,arinca,ariola,ariolo,arista,ariste,armita,arquus,arrabo,arrepo,arrigo,arrodo,arrogo,arroro,artaba,artius,arundo,arveho,arviga,arvina,asarum,asceta,ascius,ascopa,asella,asilus,asinus,asotia,asotus,aspere,aspero,aspiro,assedo,assero,assido,assisa,assolo,assono,assugo,assula,asthma,astrum,astruo,astula,astute,asylum,atatae,atavia,atavus,ategro,atenim,athena,atheos,athera,atheus,athlon,athlum,atizoe,atomos,atomus,atopto,atquin,atrium,attate,attero,attexo,attice,attigo,attina,attono,attulo,attuor,atvero,atypus,auceps,auctio,auctor,auctus,aucupo,audens,audito,aufero,augmen,auguro,aulaea,aulula,aurata,aureax,aureus,auriga,aurigo,aurora,auroro,aurugo,aurula,auspex,auster,author,autumo,avarca,avarus,avello,averro,averta,averto,avidus,avipes,avitus,avorro,avorto,axicia,axilla,axioma,axitia,axulus,azonus,azotus,azrael,azurum,azymum,azymus,baccar,baccor,bacile,bacrio,bacula,badius,badizo,bajulo,balans,balbus,balena,balito,ballux,balnea,baluca,banana,bancus,bannum,bannus,baptes,barbio,barbus,bardus,barile,baripe,barrio,barrus,baruch,basium,bassus,battis,battuo,baubor,bdella,beatum,beatus,beccus,belbus,belion,bellax,bellio,bellis,bellor,bellua,bellum,bellus,belone,beluus,berbex,bernus,berula,bervex,bestia,betizo,betula,bibale,biblia,biblus,bibrax,bicaps,biceps,bicips,bicors,bidens,biduum,biduus,bilanx,bipeda,biplex,birota,birrum,birrus,biurus,bivira,bivium,bivius,bladum,bladus,blande,blando,blatio,blatta,blatto,blatum,blavus,bleium,blitum,boatus,bolona,bombax,bombio,bombus,bombyx,boopes,boopis,boreas,boreus,borith,borius,borras,borrio,boscas,boscis,boscus,botane,botono,botrio,botrus,botryo,bovida,bovile,braces,bracio,bracis,bratus,bravio,bregma,brevio,brevis,britto,bromos,bronte,brucus,brunus,brutes,brutum,brutus,bubile,bubino,bublus,bubula,bubulo,bucale,buccea,bucina,bucino,bucula,bulbos,bulbus,bulima,bulimo,bullio,bundon,bunias,bunion,bupaes,burgus,burius,burrio,burrus,bustar,bustum,buvino,buxans,buxeus,byrrus,byssum,byssus,cacabo,cachla,cactos,cactus,cacula,cadium,cadmea,cadmia,caecus,caedes,caedis,caeles,caelia,caelum,caelus,caenum,caesar,caesim,caesio,caesna,caesor,caesum,caesus,caetra,caetus,cafeum,calcar,calceo,calcio,calcis,calcus,caldor,caldum,caldus,caleco,calfio,calico,calida,calide,caliga,caligo,calleo,callim,callis,callum,callus,calota,calpar,caltha,calvar,calveo,calvio,calvor,calvus,camara,camaro,cambio,camela,camena,camera,camero,camino,campio,campso,campus,camter,canaba,canale,cancer,candeo,candor,canens,canica,canina,canipa,canore,cantio,cantor,cantus,capedo,capero,capito,capnos,cappas,caprea,capsum,capsus,captio,captor,captum,captus,capudo,capula,capulo,carbas,carcer,carena,careor,careum,carica,caries,carina,carino,carisa,carmen,carnis,carota,carrum,carrus,cartus,caryon,cascus,caseta,caseum,caseus,casila,casito,casleu,casnar,cassia,cassis,cassum,cassus,castor,castra,castro,castum,castus,casula,cateia,catela,catena,cateno,cattus,catula,caucus,caudex,caulis,caulla,caurio,caurus,causea,causor,caussa,cauter,cautes,cautim,cautio,cautis,cautor,cautum,cautus,cedens,cedria,cedris,cedrus,celate,celere,celero,celsus,celtis,celtus,celula,celuma,cenito,censeo,censio,censor,censum,census,centum,cenula,cepaea,cephas,cephen,cephus,cepina,cerais,cercis,cereus,cerium,cernuo,ceroma,cerrus,certim,certor,certum,certus,cerula,cervix,cervos,cervus,cespes,cessim,cessio,cessum,cessus,cestos,cestus,cetera,cetero,chaere,chanan,channe,charta,charus,chasma,chelyn,chemia,cherub,chlora,chorda,chorea,chorus,chroma,chylus,chymus,cicada,cicaro,ciccum,cicera,cicero,cicuma,cicuro,cicuta,cignus,cilium,cimber,cimico,cinara,cinnus,cinyra,ciphus,cippus,ciprus,circen,circes,circos,circum,circus,cirrus,cisium,cissos,citara,citate,citrea,citrum,citrus,civica,civile,clades,clamis,clamor,clamos,clamys,clango,clareo,claror,claros,clarus,clasis,clatro,claudo,clausa,claves,clavis,clavus,clepta,clerus,cliens,climax,clingo,clinsa,clipeo,clivia,clivis,clivos,clivum,clivus,cloaca,cloaco,clocca,clodus,clonos,cluaca,cludus,cluens,clueor,clunis,clupea,clupeo,clusor,clusum,clusus,clutus,clypeo,cnason,cnatus,cnecos,cnemis,cnicus,cnissa,cnodax,coacte,coacto,coaddo,coapto,coarmo,coarto,coasso,cobaia,cobios,cobius,coccio,coccum,coccus,coccyx,cocina,cocino,cocito,coclea,cocles,coclia,coctio,coctor,coctum,coctus,cocula,coecas,coeles,coelum,coelus,coenon,coenum,coepio,coepto,coerro,coetus,cofrus,cogito,cogulo,cohors,coicio,coitio,coitus,colens,coleus,colias,coligo,colina,collis,collum,colluo,collus,colona,coloro,comans,comedo,cometa,comice,comito,comium,comiva,commeo,commis,compar,compes,compos,compte,comtus,comula,concha,concio,condio,condus,conexe,conexo,confio,conflo,conger,conila,conium,conjux,conluo,conpar,conpes,conpos,conruo,consol,consto,consul,consum,consuo,consus,contio,contor,contra,contuo,contus,conula,conyza,coopto,copior,copona,coprea,copula,copulo,coquos,coquus,corban,corbis,corcus,cordax,cordus,corion,corior,corium,corius,cornix,cornum,cornus,corona,corono,corpus,corruo,corsum,corsus,cortex,cortus,corvus,coryza,cosmos,cossim,cossis,cossus,costos,costum,costus,cotila,cotula,cotyla,coutor,crabro,cracca,crambe,cramum,crasse,crasso,crater,cratio,cratis,creber,crebra,crebre,crebro,credra,creduo,cremor,cremum,crenum,crepax,creper,crepis,cresco,cresso,cretio,cretus,cribro,crimen,crinio,crinis,crinon,crinum,crisis,crispo,crisso,crista,crithe,crocea,crocia,crocio,crocis,crocum,crocus,croton,crucio,crudus,crupta,crusma,crusta,crusto,crypta,cubans,cubile,cubito,cucubo,cuculo,cucuma,culeus,culina,cullus,culmen,culmus,culter,cultio,cultor,cultum,cultus,cumera,cummis,cumque,cumulo,cuncta,cuncto,cunela,cuneus,cunica,cunila,cunnio,cunnus,cunula,cupedo,cupide,cupido,cupita,cupona,cuppes,cupula,curago,curans,curara,curate,curena,curene,curito,curius,currax,currus,cursim,cursio,cursor,cursus,curtus,curvor,curvum,curvus,cuspis,custos,cyamos,cyamus,cyanea,cyanos,cyanus,cyatus,cybaea,cybion,cybium,cyceon,cyclas,cyclus,cycnon,cycnus,cygnus,cyitis,cymula,cynice,cynops,cyphus,cypira,cypris,cypros,cyprum,cyprus,cyrena,cyrene,dablas,daemon,daleth,damium,damnas,damnum,dampno,damula,daniel,daphne,dapino,dartos,dataim,daucon,daucos,daucum,dealbo,dearmo,deauro,debibo,debrio,decano,decedo,decens,decido,decima,decimo,decoco,decolo,decore,decoro,decubo,decuma,decumo,decunx,dedect,dedico,dedolo,dedomo,deduco,deerro,defeco,defero,defico,defigo,defleo,defluo,defuga,defugo,degero,degulo,deguno,dehinc,deicio,deifer,deinde,deitas,dejero,dejugo,dejuro,dejuvo,delavo,delego,delero,deleth,delevo,delibo,delico,deligo,delimo,delino,deliro,delito,deludo,deluto,demano,demens,demeto,demuto,denaso,denato,denego,denixe,denoto,denseo,densus,dentex,dentio,dentix,denubo,denudo,deocco,deopto,deorio,deosum,depalo,depilo,depleo,depluo,depono,depudt,depuio,depuro,deputo,derado,dercea,derepo,derigo,derivo,derodo,derogo,deseco,deseps,desero,desico,desido,desino,desolo,despuo,desudo,desugo,desumo,detego,detero,detexo,detono,deutor,deveho,develo,devexo,devito,devium,devius,devoco,devolo,devomo,devoro,devote,devoto,dexter,dextra,dextre,diacon,diaeta,diagon,dialis,dibalo,dictio,dictor,dictum,dictus,diduco,diesis,dietim,difflo,digero,dignor,dignum,dignus,dijugo,dilato,dilaxo,dilido,diligo,dilute,dimano,dimeto,dimetr,dimico,dinoto,dioces,dioryx,dioryz,diovis,dipsas,dirado,diraro,dirigo,dirimo,dirivo,disamo,discus,dispar,dissuo,ditius,divexo,divido,divino,divise,doctor,doctus,dolium,dolose,domina,domino,domito,domnus,dorcas,dorcus,dorius,dormeo,dormio,dorsum,dragma,dromas,druida,dubito,dubium,dubius,ductim,ductio,ductor,ductus,duello,dulcis,dulcor,duonus,duovir,duplex,duplum,duplus,ebenum,ebenus,ebrius,ebullo,ebulum,ebulus,eccere,echoos,ecloga,ecquid,edepol,edisco,editio,editor,editus,edoceo,edulis,edurus,effero,effluo,effugo,effuse,egenus,egloga,ejecto,ejicio,elabor,elater,elatio,elatus,electa,elegia,elegus,elicio,elingo,eliquo,elixus,elluor,elongo,eluceo,eluvio,emaneo,emblem,embryo,emendo,emereo,emergo,emigro,emineo,eminus,emiror,emitto,emoneo,emoveo,emptio,emptor,emundo,emungo,emunio,enarro,enervo,enigma,eniteo,enitor,enodis,epicus,epotus,epulor,epulum,equile,equiso,equito,eremus,erilis,eripio,erodio,erraum,eructo,erudio,erumpo,erutor,erynge,esdras,esicia,esther,estimo,esurio,etenim,etesia,etheca,ethica,ethice,euhans,euhios,euhius,eundem,europa,eurous,evacuo,evagor,evanno,evanuo,evasio,evasto,evello,evenio,evenit,everro,everto,evinco,evolvo,evovae,evulgo,exacuo,exalto,examen,exardo,exarmo,exatio,excavo,excedo,excido,excieo,excito,excolo,excors,excubo,excudo,excuso,exedra,exeges,exerro,exerto,exhalo,exicco,exilio,exilis,exinde,existo,exitus,exodus,exolvo,exopto,exorno,exosso,exosus,expers,expeto,expilo,expiro,expleo,expono,exseco,exsero,exsico,exsors,exspes,exspuo,exsudo,exsulo,extera,extruo,exulto,exundo,exurgo,exuvia,fabius,fabula,fabulo,facies,facile,factio,factor,factum,facula,faedus,faeles,faenum,faenus,faeteo,faetor,falere,fallax,falsum,falsus,famula,fanale,fandus,farcio,farina,farsia,fascea,fascia,fascis,fastus,fatale,fateor,fatigo,fatuus,faunus,fautor,febris,femina,fenero,feoffo,ferale,ferina,ferior,ferito,ferrum,fertum,ferula,ferveo,fervor,fessus,festum,festus,fetura,feudum,fibula,fibulo,fictio,fictor,fictus,fidens,figura,figuro,filius,firmus,fiscus,fixura,flagro,flamen,flamma,flammo,flatus,flaveo,flavor,flavus,flecto,fletus,flexus,floreo,fluito,flumen,fluxus,focale,focilo,fodico,fodina,fodrum,fodrus,foedus,foenum,foenus,foeteo,foetor,foetus,folium,follis,forcia,fordus,forfex,fornax,fornix,forpex,forsan,forsit,fortis,fossio,fossor,fragor,fragro,fragum,framea,frango,frater,fraudo,fremor,frendo,frenum,frenus,fretum,fretus,frigeo,frigor,frigus,frixus,fronto,frutex,frygia,fulcio,fulgeo,fulgor,fulguo,fulgur,fulica,fuligo,fulmen,fultus,fulvus,fumeus,fumigo,funale,fundus,funero,fungor,fungus,furfur,furnax,furnus,furtim,furtum,furvus,fuscus,fustis,gaesum,gafrum,gallia,gallus,ganeum,gannio,garrio,gattus,gaudeo,gazela,geldum,gelida,gelide,gemino,genava,genero,genius,genoma,gentil,genual,germen,gerula,gestio,gestum,gestus,gibber,gibbus,gigans,gilbus,gilvus,glaber,glacio,glaeba,glarea,glaria,glesum,glisco,globus,glomus,gloria,glossa,glubeo,gluten,glutio,glutto,gnaeus,gnarus,gnatus,gnecos,gnosco,gobius,gonger,gothus,gracia,gradus,gramen,gramma,grando,granum,grates,gratia,gratis,grator,gratus,gravis,gravor,grecus,griffo,grippa,grumus,grypho,grypus,guarra,guerra,guilda,gummis,gurges,gustus,gutter,guttur,guttus,gwerra,gypsum,habena,habito,haedus,haereo,hagios,hahahe,halica,halito,hallec,hallus,hamula,harena,harpax,harvix,haurio,havens,hebeto,hebria,hector,hedera,heiros,helica,helluo,helops,heluor,hemera,hemina,heptas,hercle,hermes,herois,herous,hiatus,hiemps,hierus,hilaro,hinnio,hinnus,hircus,hirnea,hirtus,hirudo,hoedus,honoro,hornus,horoma,horreo,horror,hortor,hortus,hospes,hostia,hostio,hostis,humano,humens,hyaena,hyalus,hydria,hydrus,hymera,hymnus,hypate,iambus,ibidem,idioma,idiota,idolon,idolum,idonee,igitur,igneus,ignoro,ilicet,illico,illido,illigo,illinc,illino,illudo,imbibo,imbito,imbrex,imitor,immolo,immuto,impero,impeto,impius,impleo,impluo,impono,impune,impure,imputo,inalbo,inanio,inanis,inauro,inbibo,inbito,incaro,incedo,inceps,incero,inchoo,incido,incilo,incino,incito,incoho,incola,incolo,incubo,incudo,incuso,indago,indico,indies,induco,induro,inedia,inerro,inesco,infamo,infans,infero,infigo,infimo,influo,infrio,infula,infulo,ingemo,ingeno,ingens,ingero,ingruo,inguen,inhalo,inicio,initio,initus,injuro,inlago,inlido,inlino,innato,innoco,innovo,innubo,inopia,inpeto,inpius,inpono,inpune,inquit,inrigo,inrogo,inrumo,insane,inseco,insero,insido,insile,insipo,insito,insolo,insono,insons,inspuo,instar,insudo,insula,insumo,insupo,intego,intexo,intime,intimo,intono,intuor,inundo,inungo,invado,inveho,invest,inviso,invito,invius,invoco,involo,iodium,iratus,irinum,irinus,ironia,irrepo,irrigo,irrimo,irrito,irrogo,irroro,irrumo,israel,istinc,italia,italus,itaque,iterum,itidem,itiner,jactio,jactus,jamjam,jaspis,jejuno,jocose,jubilo,juctim,judaea,judico,jugulo,julius,juncus,junior,junius,jussio,jussum,jussus,justum,justus,juxtim,labium,laboro,labrum,lacero,lacuna,lacuno,laesio,laeter,laetor,laetus,laevus,lagena,lageos,lagois,lagona,laicus,lameth,lamina,lampas,lancea,laneus,lanius,lanugo,lapido,lapsio,lapsus,lardum,largio,largus,lassus,latens,latina,latine,latino,latito,latius,laurea,laurus,lautus,lavabo,leaena,lectio,lector,lectus,lembus,lentor,lentus,lepide,lesbis,lesbos,lesbus,lessus,lethum,levigo,levita,libens,libere,libero,libido,licens,liceor,lichen,licium,lictor,lienis,lignor,lignum,ligula,lilium,limbus,limino,limito,lineus,lingon,lingua,linquo,linteo,linter,lipara,lippio,lippus,liqueo,liquet,liquor,litera,litigo,litrum,littus,litura,lituus,livens,logion,logium,lolium,longus,lophos,loquax,loquor,loreus,lorica,lotium,lubens,lubido,lucido,lucina,lucius,lucror,lucrum,luctor,luctus,lucumo,ludius,luella,lumbus,lumino,lunter,lurcho,lurcor,luscus,lusito,lustro,luteus,lutito,lyceum,lympha,lympho,lytrum,maccis,maccus,macedo,macero,macies,macium,mactus,macula,maculo,madide,maenas,maereo,maeror,maeste,maesto,maevia,magnas,magnes,magnus,majuma,maltum,mammon,mamona,mamzer,mancus,mandra,manica,manico,manius,mannus,mansio,mantum,manzer,mapale,marceo,marcor,marcus,marita,marito,marius,marmor,marmus,martyr,mastex,mastix,matara,matrix,mattea,mattya,matula,mature,maturo,maurus,mavors,mavult,maxime,maxume,meator,meatus,meddix,medela,medens,medeor,medica,medice,medico,medito,medium,medius,melita,melite,melota,melote,memini,memoro,mendax,mendum,mensis,mensor,mentha,mentio,mentum,merces,mercor,merens,mereor,merges,mergus,merito,merops,merula,messis,messor,metior,metrum,micans,migale,migrus,milies,milito,milium,miluus,milvus,mimula,minera,minito,minium,minius,minoro,minuta,minute,misceo,misere,misero,missio,missus,mistes,mistus,mitigo,mixtim,mixtio,modero,modius,moecha,moenus,moereo,moeror,moeste,moesto,molior,mollio,mollis,moneta,moneto,monile,morbus,mordax,mordeo,morior,morsus,morula,moruta,motrum,moyses,mulceo,mulcta,mulcto,mulgeo,mulier,mullus,mulsum,multae,multot,multum,multus,mundus,munero,munium,murena,muries,murmur,muscus,musica,mustes,mustum,mustus,mutilo,muttio,mutuor,mutuus,mydion,myopia,myrias,myrica,myrice,myrrha,myrtum,myrtus,mystax,mystes,mythos,mythus,nablum,naevus,namque,naptha,nardum,nardus,nascor,natrix,natura,naturo,naucum,naulum,nausea,nauseo,nausia,nausio,navale,navigo,navita,nazara,nebula,nebulo,necdum,necnon,nectar,necubi,negito,neptis,nequam,nequeo,nequis,nervus,nescio,neuter,neutro,nichil,nigreo,nimbus,nimium,nimius,nineve,ninguo,ninive,nitrum,niveus,nocens,noctua,nocuus,nomine,nomino,nondum,noster,nothus,notula,novale,noxius,nudius,nullus,numero,nummus,nuncia,nuncio,nuntia,nuntio,nuptia,nutrio,nutrix,nympha,nymphe,obarmo,obduco,obduro,obedio,obelus,obesus,obicio,obiter,obitus,oblido,obligo,oblimo,oblino,obnixe,obnubo,oboleo,obolus,obrepo,obrogo,obruza,obryza,obsero,obsido,obsono,obtego,obtero,obtexo,obturo,obviam,obvius,occano,occedo,occido,occino,occubo,occulo,occupo,occuro,ocelum,ocimum,ocimus,ocinum,oculus,odiose,odoror,odorus,ofella,offero,offigo,offoco,offuco,ogdoas,oggero,olidus,olitor,olivum,omasum,ominor,omitto,omnino,onager,onycha,onyche,opacus,opella,operio,operor,opicus,opifer,opifex,opilio,opimus,opinio,opinor,oppedo,oppeto,oppido,oppilo,oppleo,oppono,oprepo,opsono,optume,oralis,oratio,orator,oratus,orbita,orchas,orchit,ordeum,ordino,ordior,orexis,orgium,oriens,ornate,osanna,oscito,osseus,ostium,ostrea,ostria,ostrum,otitis,ovatio,ovulum,oxonia,pactio,pactor,pactum,pactus,paedor,paelex,paetus,pagina,palear,palile,pallas,palleo,pallio,pallor,palmes,palmus,palpor,palpus,pandus,pannus,pantex,panton,papaia,pappus,papula,parcus,pardus,parens,paries,parito,partim,partio,partum,partus,parvus,pascha,pascua,passer,passim,passio,passum,passus,pastor,pastus,patens,patera,patina,patino,patior,patria,paucum,paucus,paullo,paulum,paulus,pauper,pausea,pausia,pavito,pecten,pectus,pedale,pedica,pedico,pedius,peioro,peiuro,pejero,pejuro,pellax,pellex,pellis,pelvis,pendeo,pensio,pensum,peplum,peplus,perago,peramo,peraro,percio,perdiu,perdix,peredo,perflo,perimo,perluo,permeo,pernio,pernix,pernot,pernox,perodi,peroro,perpes,perses,persis,persto,perula,peruro,pessum,pestis,petaso,petrus,phaedo,pharao,phasma,phiala,phimus,phoebe,phycis,piamen,piceus,pictor,pictus,pietas,pignus,pigror,pileum,pileus,pineus,pingue,pipilo,pirata,piscis,piscor,pistor,placeo,placet,placza,planes,plango,planta,planto,planum,planus,plasma,plasmo,platea,plaudo,plebes,plecto,plegio,plenus,plerus,plexus,plipio,plumbo,pluvia,pneuma,poclum,podium,poenio,poenus,poesis,polion,polium,pollen,polleo,pollex,pollum,polluo,pollus,pollux,pondus,pontus,popina,popino,poples,poplus,populo,porceo,porcus,porrum,porrus,portio,portus,possum,postea,postis,potens,potior,potito,potius,potrix,praeco,praeda,praedo,praeeo,pratum,pratus,pravus,prebeo,precia,precor,prelum,prenda,prendo,prenso,presso,pridem,pridie,primas,primum,primus,prinus,privus,probus,procax,procer,procor,procul,procus,prodeo,prodio,proflo,profor,proles,proluo,promus,pronus,propie,prorex,proruo,prosto,prosum,prosus,protos,protus,pruina,prunum,prunus,prurio,psallo,psecas,pubens,pudens,puella,pugilo,pugnax,pugnus,pulcer,pulcre,pullum,pullus,pulsus,pulvis,pumico,punior,puppis,pupula,pusula,puteal,puteus,putris,pyctes,pyelus,pyrius,python,quadra,quadro,quaero,quaeso,qualis,qualum,qualus,quamde,quando,quanto,quaqua,quasso,quater,quatio,queror,quicum,quidam,quidem,quidni,quiete,quinam,quinct,quippe,quiris,quivis,quodsi,quojas,quojus,quonam,quoque,quoquo,quosum,quotum,quotus,rabies,rabula,racana,radico,radior,radius,ramale,rameus,ramnum,rapina,raptim,raptor,raptum,raptus,rasito,raucus,raudus,reageo,realis,reapse,reatus,rebito,recedo,recens,recido,recino,recito,recolo,recreo,rectio,rector,rectum,rectus,recubo,recuro,recuso,redamo,redigo,redimo,redono,reduco,refero,refert,refigo,refluo,refuto,regero,regina,regius,regnum,regula,reicio,relaxo,relego,relevo,relido,religo,relino,remano,remigo,remora,remote,rennuo,renodo,renovo,renuto,reparo,repedo,repens,repeto,repleo,replum,repono,reputo,reseco,resero,resido,resina,resono,respuo,restat,restio,restis,resumo,retego,retexo,retina,retono,reveho,revelo,revera,reviso,revivo,revoco,revolo,revomo,rhenus,rhetor,rictum,rictus,ridica,rienes,rigens,riguus,ringor,ripula,riscus,ritual,robeus,robigo,robius,roboro,rogito,roseus,rubens,rubeta,rubeus,rubigo,rubius,ructor,ructus,rudens,rumino,rumpia,ruptor,rursum,rursus,ruscum,ruscus,russus,rustum,rutilo,rutrum,rutula,sabath,sabine,saccus,sacoma,sacrum,saepes,saepio,saevio,saevus,sagena,sagino,sagmen,salaco,salina,saliva,sallio,salsum,salsus,saltem,saltim,saltus,saluto,salveo,salvia,salvus,samech,samera,samius,sancio,sandyx,sanies,sannio,sappir,sarcio,sardus,sargus,sarisa,sarrio,sartio,sartor,satago,satias,satius,satura,saturo,saucio,saulus,savior,savium,saxeus,scaber,scacus,scaena,scalpo,scando,scapha,scapus,scateo,scazon,scelus,scheda,schema,schola,sciens,scilla,scindo,scinus,scipio,scipus,scirpo,scirto,scisco,scisma,scitor,scitum,scitus,scobis,scoria,scotia,scotus,scriba,scribo,scrofa,scruta,scruto,sculpo,scurra,scutra,scutum,secedo,secius,sectio,sector,secubo,sedile,seduco,sedulo,segnis,seipse,selego,seligo,semino,semita,semper,seneca,senior,senium,sensim,sensum,sensus,sentio,sentis,sentus,separo,sepelo,sepono,septem,septim,septum,sequax,sequor,seraph,sereno,series,serius,sertum,sertus,servio,servus,sesque,sesqui,sessio,setius,seubus,sevoco,sextus,sibilo,siccum,siccus,sicera,sicine,siclus,sicubi,sicula,sicuti,siglum,signum,silaba,silens,siligo,simila,simile,similo,simitu,simius,simula,simulo,sindon,sinope,siphus,sirius,sirpea,sirpia,sirpus,situla,smalto,smegma,smyrna,sobrie,soccus,socius,socors,socrus,soldus,solido,solito,solium,somnio,somnus,sophia,sophos,sophus,soporo,sorbeo,sorbum,sorbus,sordeo,sordes,sospes,spadix,spaera,spargo,sparta,sparus,spatha,specio,specto,specus,spelta,spensa,sperma,sperno,sphera,sphinx,spicio,spinus,spisso,spolio,sponda,sponsa,sponso,sponte,sporta,spurco,sputum,squama,stacta,stacte,stagno,stamen,stapes,stapis,stater,statim,statio,stator,statua,statuo,status,stelio,stella,stello,stemma,sterno,sterto,stibis,stigma,stilio,stilla,stillo,stilus,stimmi,stipes,stiria,stirps,storax,storea,storia,strabo,strena,strepa,strepo,strido,striga,strigo,strues,struix,struma,studeo,stulte,stupeo,stupor,stuppa,stupro,sturax,stylus,styrax,suadeo,suadus,suasor,suasus,suavis,subdio,subedo,subigo,subito,subium,subluo,subruo,substo,subsum,subter,subtum,subtus,subula,subura,succus,suebus,suesco,suetus,suffes,suffio,sufflo,sulcus,sulfur,sulpur,summas,summum,summus,supero,supino,suppar,suppus,supter,surdus,surena,surius,sursum,susque,sutela,sutura,syrius,syrtis,tabula,tactio,tactus,taedeo,taedet,taedio,taenia,taeter,talare,tallus,tamdiu,tandem,tantum,tantus,tapete,tardus,tarmes,taurea,taurus,taxeus,teanus,tebeth,techna,tector,tectum,tectus,tegmen,tegula,tellus,temere,temero,tempto,tempus,tenuis,tenura,teredo,tergeo,tergum,tergus,termen,termes,ternus,terreo,terror,tersus,tertio,tescum,testis,testor,testum,tetrao,teucer,textor,textum,textus,thaspi,therma,thesis,tholus,thomas,thorax,thraex,thymum,thymus,tiaras,tignum,tigris,timide,tinguo,tinnio,tisana,titius,titubo,togata,togula,tolero,tomata,tonale,tondeo,tonium,tonsor,tonsus,tophus,topice,tormen,tormin,tornus,torpeo,torpor,torreo,torris,torrus,torsio,tortor,torvus,trabea,trabes,tracto,tradux,tragum,trahea,tramen,trames,tremis,tremor,tribas,tribuo,tribus,tricor,triens,trifur,trigen,trigon,trilix,trimus,trinus,tripes,tripus,triste,tritus,tropos,tropus,trudis,trulla,trunco,tubero,tudito,tuisso,tuitio,tulipa,tumbus,tumulo,tunica,turben,turdus,tureus,turgeo,turgor,turpis,turris,turtur,tussio,tussis,tutela,typhon,tyrsus,uberte,ubinam,ubique,ubivis,ulcero,ulixes,ulmeus,ultime,ultrix,umecto,umerus,umesco,umidum,umidus,umquam,unctio,unctor,unctus,unguen,ungueo,unguis,ungula,unicus,unitas,uniter,unquam,upilio,urceus,ureter,urgueo,urinor,urtica,usitor,uspiam,usquam,ustilo,ustulo,usurpo,uterum,uterus,utilis,utinam,utique,utlago,utpote,utputa,uvesco,uvidus,vacive,vacuus,vaenum,vaflum,vagina,valens,valgus,valles,vallis,vallum,vallus,vannus,vapide,vaporo,vapulo,varico,varius,vastus,vatius,vecors,vectio,vectis,vector,vegeto,vellea,vellus,veluti,vemens,veneno,veneri,venero,venter,ventus,vepris,verber,verbum,vereor,vermis,vernix,vernus,verpus,verres,verris,versio,versor,versum,versus,vertex,vervex,vescor,vescus,vesica,vesper,vester,vestio,vestis,vetero,vetust,viator,vicies,victor,victus,viduus,vietus,vigens,vigies,vigilo,vigoro,vilica,vilico,villus,vincio,vindex,vineus,vipera,virago,virens,virido,virtus,viscer,viscum,viscus,visito,viteus,vitium,vitreo,vitrum,vitula,vixdum,vocito,vocula,volans,volens,volgus,volito,volnus,volpes,voltur,voltus,volupe,voluto,vomica,vomito,vorago,vorsum,vorsus,vortex,voster,vulgus,vulnus,vulpes,vultis,vultur,vultus,xenium,xerxes,xystus,zenith,zinzio,zmegma,zmyrna,zonula,zythum,abactor,abactus,abamita,abarceo,abbatia,abditum,abditus,abdomen,aberceo,abfugio,abhibeo,abigeus,abjecte,abjecto,abjicio,abjungo,abjurgo,ablacto,ablacuo,ablatio,ablator,ablutio,abluvio,abnepos,abnocto,abomino,aborior,aborsus,abortio,abortum,abortus,abripio,abrumpo,abrupte,abscedo,abscido,abscise,absento,absilio,absisto,absocer,absolvo,absonia,absonus,abstulo,absurde,abundus,abusive,abusque,abverto,abyssus,acapnos,acapnus,acation,acatium,accanto,accendo,accento,accepta,accepto,accerso,accessa,accingo,accipio,accitio,accitus,acclamo,acclaro,acclino,acclive,accludo,accordo,accredo,accumbo,accurro,accusso,acedior,acerbum,acerbus,acernia,acernus,acertas,acervus,achanum,acharis,acharna,acharne,achates,achetas,acicula,acoetis,aconiti,acorion,acosmos,acquiro,acritas,acriter,acroama,activus,actuose,actutum,acuitas,aculeus,acumino,acutale,acutule,adactio,adactus,adaeque,adaequo,adagium,adaquor,adaucto,adaugeo,adbello,adclamo,adclaro,adclino,adclive,adcludo,adcredo,adcumbo,adcurro,addecet,addenso,addicta,addisco,additio,addoceo,adducte,adeptio,adeptus,adesdum,adfaber,adfabre,adfamen,adfania,adfatim,adfatus,adfecte,adfecto,adficio,adfingo,adfinis,adfirmo,adfixio,adfixum,adfixus,adfligo,adfodio,adfremo,adfrico,adfundo,adgnata,adgravo,adgrego,adhaese,adhereo,adhibeo,adinflo,adipeus,adipson,adipsos,adjaceo,adjicio,adjungo,adjutor,adjutus,adlabor,adlambo,adlatro,adlaudo,adlecto,adlevio,adlicio,adlisio,adluceo,adludio,adluvio,admigro,admiror,admitto,admodum,admoneo,admotio,admoveo,admugio,admulco,adnarro,adnecto,adnepos,adnexio,adnexus,adnicto,adnitor,adnixus,adnullo,adobruo,adonium,adoptio,adoreum,adoreus,adorior,adorium,adpalis,adpango,adpareo,adparet,adpario,adpello,adpendo,adpingo,adplico,adplodo,adploro,adpluda,adporto,adposco,adpotus,adprime,adprimo,adprobe,adprobo,adprono,adpugno,adquiro,adralis,adrideo,adripio,adrisio,adrisor,adrosor,adsecla,adsecue,adservo,adsicco,adsideo,adsidue,adsiduo,adsigno,adsilio,adsisto,adsitus,adsocio,adsoleo,adsolet,adspiro,adstruo,adsulto,adsurgo,adtagen,adtendo,adtente,adtento,adtenuo,adtillo,adtineo,adtingo,adtollo,adtraho,adtremo,adulans,adulter,adultus,adumbro,aduncus,adurgeo,adusque,adustio,adustum,adustus,advecto,advenio,advento,advenus,adverro,adversa,adverse,adverso,adverto,advolvo,advorsa,advorse,advorso,advorto,adzelor,aedifex,aedifio,aedilis,aeditua,aedituo,aegides,aegroto,aelinon,aelinos,aelurus,aemulor,aemulus,aenator,aenigma,aenipes,aenulum,aeramen,aeraria,aeratus,aerifer,aerinus,aeripes,aerosus,aerumna,aerusco,aesalon,aesopus,aestimo,aestive,aestivo,aestumo,aeterno,aetites,aetitis,aevitas,affaber,affabre,affamen,affania,affatim,affatus,affecte,affecto,afficio,affingo,affinis,affirmo,affixio,affixum,affixus,affligo,affodio,affremo,affrico,affundo,afluens,aforent,africus,agantur,agathum,agellus,agerius,aggaeus,aggravo,aggrego,agnatio,agnatum,agnatus,agninus,agnitio,agnitor,agnitus,agnomen,agnosco,agonium,agonizo,agralis,agrippa,agrosus,aheneus,aientia,aigilps,alabeta,alacris,alarius,albaris,albatus,albesco,albicor,albidus,albinus,albucum,albucus,albulus,albumen,alcinus,alcohol,alcyone,alearis,aleator,alebris,alecula,alerius,aletudo,algebra,algesco,algidus,algosus,aliatum,alicubi,alicula,alienor,alienum,alienus,alietum,alioqui,aliptes,aliquam,aliquid,aliquis,aliquit,aliquod,aliquot,alitudo,alitura,aliubei,aliunde,allabor,allambo,allatro,allaudo,allecto,allevio,allicio,allisio,alluceo,alludio,allusio,alluvio,almitas,almucia,alpinus,altanus,alteras,alterco,alterne,alterno,althaea,altilis,alucita,alumnor,alumnus,alveare,alysson,amanter,amarico,amasius,amatrix,ambages,ambigue,ambitio,ambitor,ambitus,amellus,amentia,amentum,amercio,america,amictus,amicula,amineus,amissio,amissus,amitina,ammento,ammigro,ammiror,ammitto,ammodum,ammoneo,ammotio,ammoveo,ammugio,ammulco,amnacum,amnesis,amnicus,amoenum,amoenus,amolior,ampelos,amphora,amplexo,amplius,amptruo,ampulla,amusium,amussis,amystis,anadema,analyzo,ananasa,anatina,anaudia,anchora,anchusa,ancilla,ancisus,anculus,andreas,anellus,anemone,anethum,anetina,angaria,angario,angarus,angelus,anglice,angorio,angueus,angulus,angusta,anguste,angusto,anhelus,anicula,anilito,animans,animose,animula,annalis,annecto,annexio,annexus,annicto,annifer,annitor,annixus,annonor,annosus,annullo,annulus,anquina,anquiro,ansatus,anstruo,anteago,antehac,anteida,antemna,antenna,antepes,anteris,antesto,antevio,anthera,anthias,anthrax,anticus,antidea,antideo,antique,antiquo,antista,antisto,anulare,anxifer,aparine,apathia,aperito,apernor,apertio,apertor,apertum,apertus,apexabo,apharce,apiacus,apianus,apiatus,apicius,apicula,apiscor,aplanes,aplysia,apocope,apoculo,apogeus,apologo,apopsis,aporior,apozema,apozima,apozymo,appalis,appango,appareo,apparet,appario,appello,appendo,appingo,applico,applodo,apploro,appluda,apporto,apposco,appotus,apprime,apprimo,approbe,approbo,approno,appugno,apricor,apricum,apricus,aprilis,aprinus,apronia,aproxis,aprugna,aprunus,apscedo,apscido,apscise,apsento,apsilio,apsisto,apsocer,apsolvo,apsonus,apstulo,apsurde,aptatio,apterus,aptotum,apulsus,aqualis,aquatio,aquator,aquatum,aquatus,aquilex,aquilus,aquosus,arabica,arabice,arachis,araneum,araneus,arangia,arantia,aratius,aratrum,arbilla,arbiter,arbitra,arbitro,arbitum,arboria,arbusto,arbutum,arbutus,arcanum,arcanus,arcella,arcerso,arcesso,archias,archium,arcirma,arction,arctous,arculum,ardalio,ardelio,ardeola,ardesco,ardiola,arealis,arenula,arfacio,arferia,argemon,argilla,argitis,argutia,argutor,argutus,argyros,arianis,arianus,ariolus,aristis,aritudo,armatus,armenia,armenta,armifer,armiger,armilla,armonia,arnacis,arralis,arramio,arresto,arrhabo,arrideo,arripio,arrisio,arrisor,arrosor,arrugia,arsella,artaena,artatus,artemon,arteria,artifex,artopta,artufex,artutus,aruncus,aruspex,arvalis,ascalia,ascalpo,ascella,ascendo,ascesis,ascisco,ascites,ascitus,ascribo,ascyron,asellus,asianus,aspalax,aspargo,aspecto,aspello,aspergo,asperum,aspicio,asporto,aspredo,assecla,assecue,asservo,assesio,assicco,assideo,assidue,assiduo,assigno,assilio,assisto,assitus,associo,assoleo,assolet,assulto,assurgo,astacus,astator,astatus,asteria,asterno,asticus,astituo,astolos,astrepo,astrido,astrion,astupeo,asturco,astutia,astutus,astytis,asureus,atattae,atermum,athleta,atnatus,atocium,atratus,atritas,atritus,atrotus,atrusca,attacus,attagen,attagus,attamen,attatae,attegia,attegro,attendo,attente,attento,attenuo,atticus,attillo,attilus,attineo,attingo,attollo,attorno,attraho,attremo,aucella,aucilla,auctito,auctoro,auctrix,aucupor,audacia,audiens,auditio,auditor,auditus,aufugio,augesco,auginos,augitis,auguror,augusta,auguste,augusto,aulaeum,aulicus,auraria,aurator,auratus,aureola,auresco,aurifer,aurifex,auriger,aurigor,auritus,aurosus,aurufex,auspico,austere,austium,austrum,autenta,authoro,authrix,autumno,auxilio,auxilla,aventer,averium,avernus,aversim,aversio,aversor,aversum,aversus,avicula,avitium,avolsio,avolsor,avorsor,avorsus,avulsio,avulsor,axungia,azanius,azotice,azotius,azureus,azurium,azymita,babbius,babulus,babylon,bacalia,bacalis,bacatus,bacchar,bacchia,bacchor,bacchus,baccina,bacifer,bacilis,baculum,baculus,badisso,baditis,baetica,baijulo,bajolus,bajulus,balaena,balanus,balatro,balatus,balinea,balista,ballena,ballium,ballote,balluca,balneum,balteum,balteus,bambusa,bancale,banchus,bannita,bannium,bapheum,bapheus,baphium,baptizo,barbara,barbare,barbula,barcala,baritus,barocus,baronia,barosus,barycus,basella,bastaga,batenim,bathrum,batiaca,batioca,batiola,bechion,behmoth,belgium,belivus,bellule,benefio,benigne,besalis,betisso,betulla,bibilis,bibitor,biblium,bibosus,bibulus,bicolor,bicomis,bicoxus,biennis,biferus,bifidus,bifilum,biforis,biforus,bifrons,bigamia,bigamus,bigatus,bigener,bigenus,bijugis,bijugus,bilibra,bimaris,bimater,bimatus,bimeter,bimulus,binatio,binubus,biopsia,biremis,biretum,birotus,bisetus,bisolis,bisomum,bisomus,bisonus,bitumen,biturig,bivirga,bladium,blaesus,blandio,blandum,blandus,blatero,blatium,blattea,blavius,blechon,blendea,blennus,bliteum,bliteus,blodius,blovius,boarius,boethus,bolbine,boletar,boletus,bolites,bombilo,bombito,bonasus,bonatus,bonitas,bononia,boreale,botryon,botulus,bovatim,bovilis,bovinor,bovinus,bovista,brabeum,brabium,brabyla,braceus,bracile,bracina,bractea,bradium,brances,brancha,brastes,brattea,bravium,brevium,broccus,brochon,brochus,broncus,brontea,brontes,bruchus,brunius,bruscum,bruteus,bryonia,bubalus,bubleum,bubulus,bucaeda,buccina,buccino,buccula,bucella,buceras,bucerus,bucetum,bucinum,bucinus,bucitum,buculus,bufalus,bugella,bugenes,bugillo,bugonia,bulbine,buleuta,bulimia,bulimos,bulimus,bulligo,bullula,bumasta,bumelia,bunitus,bupaeda,buricus,bursula,busequa,busycon,buttuti,buturum,butyron,buxetum,buxifer,buxosus,caballa,cacabus,cacalia,cacatus,cacumen,cadaver,cadivus,caducum,caducus,cadytas,caecias,caeduus,caelebs,caeleps,caenito,caenula,caepina,caesius,caespes,caestar,caestos,caestus,caesura,caiatio,calamus,calasis,calatio,calator,calbeus,calcata,calceus,calcium,calculo,calefio,calenda,calesco,calicia,calidum,calidus,calipha,callais,callens,callide,calligo,callion,caloria,caltula,calumma,calymma,camacum,camaeus,camaura,cambium,cambria,cambuta,camella,camelus,camilla,caminus,camisia,campana,campsor,campter,camurus,canalis,canatim,candela,candens,candico,candida,candide,candido,canesco,caninus,canitia,cannaba,canneus,cannula,canonia,canorum,canorus,canthus,cantilo,cantito,cantrix,canutus,capella,caperro,capesco,capesso,capetum,capisso,capital,capitum,capnias,capnion,capnios,cappara,cappari,caprago,caprale,caprile,caprina,caprona,capsula,captito,captiva,captivo,captrix,captura,capulum,capulus,carabus,carduus,carenum,careota,caresco,carians,carinor,carinus,cariota,carisus,caritas,carmino,carnale,carnero,carneus,carolus,carotis,carphos,carptim,carptor,carrago,carrico,carroco,carruca,cartago,cartula,caryota,casamus,casaria,casmila,cassida,cassita,cassito,cassius,castigo,castrum,castula,casurus,catampo,catasta,catella,caterva,catillo,catinum,catinus,catocha,catomun,catomus,catulio,catulus,caucula,caudeus,caudica,cauitio,caulias,caupona,causate,caussor,causula,cautela,cavamen,cavatio,cavator,cavatus,cavefio,caverna,caverno,cavilla,cedreus,cedrium,celatim,celator,celatum,celeber,celebro,celeste,celeuma,cellola,cellula,celocla,celthis,cenabum,cenalis,cenatio,cenator,cenatus,censura,centena,centrum,cepetum,cephalo,cepitis,ceponis,ceposus,cepulla,ceraria,cerasum,cerasus,ceratia,ceratum,ceratus,cercius,cercops,cereale,cerinum,cerinus,ceritis,cernulo,cernuus,ceronia,cerosus,cerotum,cerreus,cerussa,cervesa,cervina,cervula,cespito,cestros,cetaria,ceterum,ceterus,cetosus,chalcas,chalcis,chalcus,chalybs,chanaan,chanius,charazo,chartus,chelium,chelyon,chersos,chilias,chiloma,chlamis,chlamys,chloron,chlorum,choenix,choeras,choeros,choicus,cholera,cholras,chordus,choreus,chorion,chorios,chorius,chorona,chrisma,chromis,chrysos,chrysus,cibalis,cibatio,cibatus,cibisis,ciboria,cicinus,ciconia,cidaris,cilliba,cinaris,cinctor,cinctum,cinctus,cingula,cinifes,ciniflo,ciniphs,circaea,circino,circito,circius,circlus,circueo,circulo,cirsion,cisanus,cission,cisthos,cistula,citatim,citatio,citatus,citeria,cithara,citimus,citipes,citrago,citreum,citreus,citrium,civicus,civilis,civitas,clabula,clamito,clamium,clamose,clangor,clarico,clarigo,classis,clatrum,clatrus,claudeo,claudus,clausum,clausus,clavile,clavola,clavula,clemens,cleonia,cleptes,clidion,clidium,clienta,clinice,clipeum,clipeus,clivius,clodico,clodigo,clodius,clonizo,clouaca,clupeum,clupeus,cluster,clusura,clypeum,clypeus,clysmus,clyster,cneoron,cneorum,cnestor,coactim,coactio,coactor,coactum,coactus,coadoro,coaduno,coaequo,coaevus,coagito,coagulo,coarcto,coarguo,coaudio,cocetum,cochlea,cochlia,cochlis,cochlos,cocinus,coclaca,coclear,coctura,coculum,coenito,coenula,coeptum,coeptus,coerceo,coercio,coertio,cofanus,cofinus,cognata,cohereo,coheres,cohibeo,cohorto,coillum,coinquo,cojecto,colafus,colatus,colesco,colices,colicon,colicus,collare,collaxo,collega,collema,collevo,collido,colliga,colligo,collimo,collina,collino,colloco,colluco,colludo,collyra,colobos,colobum,colonia,colonus,colotes,coluber,colubra,columba,columen,columis,columna,colurus,comacum,comaros,comatus,combibo,combino,comburo,comedus,comesor,comesus,cometes,comicus,cominus,comisor,comitas,comiter,comitio,comitor,commeio,commers,commeto,commino,commode,commodo,commolo,commoro,commoto,communa,commune,commuro,commuto,comosus,compaco,compago,comparo,compars,competo,compilo,compleo,complex,complus,complut,compono,compoto,comptor,comptus,computo,comunis,conamen,conatio,conatum,conatus,conbibo,conburo,concaco,concado,concalo,concavo,concedo,concelo,concero,conchis,concido,concieo,concino,concise,concito,concors,concreo,conctio,concubo,concuro,condama,condico,condoma,condomo,condono,conduco,conduro,conecto,conesto,conexio,conexum,conexus,confero,confido,configo,confine,conflax,conflox,confluo,conflux,confrax,confrio,confuga,confuse,confuto,congelo,congemo,congero,congius,congrex,congrua,congrue,congruo,congrus,congyro,conicio,conicus,conifer,coniger,conisco,conitor,conitum,coniveo,conjuga,conjugo,conjunx,conjuro,conlabo,conlaxo,conlega,conlevo,conlido,conligo,conlimo,conlino,conloco,conluco,conludo,conmers,conmoro,conmuro,connexe,connexo,conpago,conparo,conpeto,conpilo,conpleo,conplus,conplut,conpono,conpoto,conputo,conrado,conrepo,conreus,conrigo,conrivo,conrodo,conrogo,conruda,conrugo,consano,conscia,conscio,conseco,consedo,consens,consero,consido,consolo,consona,consone,consono,consors,conspuo,constat,consudo,consueo,consulo,consumo,contans,contego,contero,contexo,contiro,contreo,contrio,contuor,convalo,convaso,conveho,convelo,convena,convexo,conviso,conviva,convivo,convoco,convolo,convomo,convoro,coopero,coorior,coortus,coperio,coperor,copiata,copiola,copiose,copulor,coquina,coquino,coquito,coquula,coragus,corambe,coranus,corbita,corbona,corbula,corcota,cordate,corduba,cordyla,coriago,coricus,corneus,cornuta,corolla,coronis,corporo,corrado,correpo,correus,corrigo,corrivo,corroco,corrodo,corrogo,corruda,corrugo,corsica,cortina,corulus,corusco,corycus,corylus,corytos,corytus,cosigno,cosmeta,cotaria,cotenea,cotidie,cotidio,cotinus,cotonum,cotoria,cracens,crapula,crassus,cratera,creagra,creamen,creatio,creator,creatum,creatus,credens,credito,cremito,cremium,cremnos,crepida,crepido,crepito,creptio,creteus,cretula,cretura,cribrum,crimino,crinale,crispum,crispus,critica,croccio,croceus,crocias,crocino,crocito,crocota,crocuta,crosmis,crotale,crotolo,crudele,crudito,cruente,cruento,crumena,crumina,crumino,crustum,cubatio,cubator,cubicus,cubital,cubitio,cubitor,cubitum,cubitus,cuccuru,cuculio,cuculla,cuculus,cucumis,cucurru,cuimodi,cujatis,culcita,culigna,culilla,culiola,culleum,culleus,culmeus,culpito,cultrix,cultura,cululla,cumbula,cumerum,cuminum,cumquam,cumulus,cunaria,cunctim,cunctor,cunctum,cunctus,cupedia,cupella,cupidus,cupiens,cupisco,cupitor,cupitum,cupitus,cuppedo,cuppula,cupreus,curatio,curator,curatus,curcuma,curilis,curiose,currens,cursito,cursura,curulis,cuspido,cutitus,cyamias,cyaneus,cyathus,cybaeus,cybicus,cyclops,cycneus,cycnion,cycnium,cydarum,cydonea,cydonia,cymbium,cymbula,cyminum,cymosus,cynicus,cynifes,cyniola,cyniphs,cynodon,cynomia,cyperis,cyperon,cyperos,cyperum,cyperus,cypiros,cypirus,cypreus,cyprius,cysthos,cytinus,cytisum,cytisus,dacrima,daedale,damalio,dammula,damnose,damnula,dampnum,danicus,danista,dapalis,daphine,daphnon,dapifer,dapifex,dapsile,dasypus,dataria,dativus,dautium,deacino,deactio,deartuo,deasceo,deascio,debatuo,debello,debilis,debitio,debitor,debitum,debitus,decacro,decalco,decalvo,decanto,decanus,decarno,decarpo,dececro,decello,deceris,decerno,decerpo,decerto,decimum,decimus,decipio,decisio,declamo,declaro,declino,declive,decludo,decocta,decollo,decolor,decondo,decoquo,decorio,decoris,decorum,decorus,decotes,decremo,deculco,deculto,decumas,decumbo,decuria,decurio,decuris,decurro,decurto,decusis,decusso,decutio,dedecor,dedecus,dedigno,dedisco,deditio,deditus,dedoceo,dedoleo,defaeco,defalta,defamis,defendo,defensa,defenso,deficio,defindo,defingo,definio,defixio,defixus,defloro,defluus,defodio,defoedo,deforis,deforme,deformo,defremo,defrico,defrudo,defrugo,defruor,defruto,defugio,defundo,defusio,defutuo,degener,deglubo,degrado,degravo,degrumo,degusto,dehabeo,dehibeo,dehisco,dehorio,deicida,deifico,deintus,deipara,dejecte,dejecto,dejicio,dejugis,dejungo,delabor,delaevo,delambo,delasso,delatio,delator,delecto,delenio,deletio,deletus,delibro,delibuo,delicia,delicio,delicus,delineo,delingo,delinio,deliquo,delirus,delitor,delonge,delotus,delphin,delphis,delucto,delumbo,delusio,delusor,demagis,demando,demento,demereo,demergo,demetor,demigro,deminuo,demiror,demisse,demitto,demolio,demoror,demoveo,demptio,demugio,demusso,denarro,denigro,denique,denormo,dentale,dentrix,deonero,deorsom,deorsum,deorsus,depalmo,depango,depasco,depecto,depello,dependo,deperdo,depereo,depilis,depingo,deplaco,deplano,deplois,deploro,depoclo,depolio,depompo,deponto,deporto,deposco,deprans,depravo,depreco,deprimo,depromo,depubes,depugis,depugno,depulpo,depulso,depungo,depurgo,depuvio,depygis,deraino,deratio,derecta,derecte,derecto,derideo,derigeo,deripio,derisio,derisor,derisus,derosus,derupio,desalto,deserpo,deserta,desicco,desicut,desideo,desidia,desiduo,designo,desilio,desipio,desisto,desitus,desolvo,despero,despumo,dessico,destico,destina,destino,destruo,desulco,desulto,desuper,desurgo,detendo,detenso,detento,detesto,detineo,detonso,detorno,detorso,detraho,detrudo,detundo,deturbo,deturpo,deurode,devagor,devasto,devecto,devello,devenio,devergo,deverro,deverto,devexum,devexus,devigeo,devinco,devolvo,devorto,devotio,devotor,devotus,devoveo,dextans,dextera,dextere,dextrum,diabole,diacope,diadata,diadema,dialion,dianium,dianius,dianoea,dianome,diarium,diarius,diaulos,diazoma,dibapha,dicatio,dicator,dicatus,dicrota,dictito,didymus,diecula,diffamo,differo,diffido,diffleo,diffluo,diffors,diffugo,diffuse,digamia,digamma,digamus,digitus,dignans,dignoro,dihesis,dijovis,dijungo,dilabor,dilanio,dilatio,dilator,dilaudo,dilemma,dilexio,dilibuo,dilinio,dilogia,diloris,diluceo,dilutum,dilutus,diluvio,dimacha,dimeter,dimetor,dimidia,dimidio,diminuo,dimitto,dimolio,dimoveo,dinosco,dioeces,dioptra,diphyes,diplois,diploma,dipyrus,diratio,dircium,directa,directe,directo,diribeo,diripio,diritas,dirumpo,dirutio,discedo,discido,discors,discuto,diserte,disicio,disilio,disjugo,disparo,dispono,disputo,disraro,disseco,dissero,dissico,dissilo,dissipo,dissors,dissupo,distans,ditator,ditesco,ditonum,ditonus,diurnum,diurnus,diutule,divello,divendo,diverro,diverso,diverto,divinus,divisim,divisio,divisor,divisus,divitia,divorto,divulgo,docilis,doctrix,documen,dodrans,dolabra,dolosus,dominor,dominus,domitor,donatio,donator,donatus,dormito,dotalis,dotatio,dotatus,drachma,drapeta,drappus,ducamen,ducatus,ducenti,ducissa,duellio,duellum,dulcedo,dulcoro,dumetum,dummodo,dumosus,duplico,duramen,duratio,duritia,duumvir,eatenus,ebiscum,eboreus,ebullio,ebureus,eburnus,ecastor,ecbasis,eccille,ecdicus,ecfatum,ecfatus,ecfloro,ecfugio,ecfundo,echidna,echinus,ecligma,econtra,ectenia,ectheta,ectypus,eculeus,edictum,edormio,edulium,effatha,effatum,effatus,effecte,efferus,effervo,effetha,effetus,efficax,efficio,effigia,effigio,effingo,effligo,effloro,effodio,efformo,effreno,effrons,effugio,effundo,effusio,effusus,effutio,effutuo,egestas,egoista,egregie,eileton,ejectio,ejectus,ektheta,elaboro,elapsus,elargio,electio,elector,electum,electus,elegans,elegeia,eleison,elephas,eleyson,elimate,elimino,elogium,eloquor,elucido,eluctor,elumbis,eluvies,elytrum,emaculo,emarceo,embamma,emblema,embolum,embolus,emercor,emereor,emerita,emetior,eminens,emissio,emolior,emollio,emorior,emulsio,emuncte,enascor,enavigo,endivia,energia,enervis,enervus,enodate,enormis,ensifer,ensiger,entheca,entitas,enubilo,enucleo,enumero,enuntio,enutrio,enzymum,epastus,ephebia,ephebus,ephesos,ephesus,ephorus,episema,epitoma,epitome,epitomo,eppheta,eptheca,equestr,equidem,equinus,eradico,ercisco,erectio,erectus,eremita,ereptio,ericius,erratum,erronee,eructuo,erudero,eruptio,ervilia,escendo,essedum,essonio,estuans,esuries,eternus,ethicum,ethicus,etiamsi,eugepae,eulogia,euminis,euripus,euronus,evagino,evectio,eventum,eventus,eversio,eversor,evictio,evidens,evigilo,evincio,evulsio,exactio,exactor,exactus,exaequo,exagito,examino,exanimo,exantlo,exaudio,excaeco,excello,excelsa,excelse,excepto,excerpo,excetra,excindo,excipio,excisus,excitor,exclamo,excludo,exclusa,excoquo,excorio,excubia,exculco,exculpo,excurro,excusso,excutio,execror,executo,exegeta,exemplo,exenium,exequia,exequor,exerceo,exfugio,exhedra,exheres,exhibeo,exhorto,exiguus,exilium,eximius,exitium,exoculo,exonero,exorior,exostra,expalpo,expando,expecto,expedio,expello,expendo,expensa,experta,explano,explico,explodo,exploro,expolio,exporto,exposco,exprimo,expromo,expugno,expurgo,exquiro,exsatio,exscreo,exserto,exsicco,exsilio,exsisto,exsolvo,exspiro,exstruo,exsuflo,exsulto,exsurdo,exsurgo,extasia,extasis,extendo,extenuo,extergo,externo,exterus,extimum,extimus,extollo,extraho,extrico,extrudo,extumum,extumus,extundo,exturbo,exubero,exululo,exupero,exurgeo,fabella,fabrica,fabrico,fabrile,fabulor,facesso,facetia,facetus,facilis,facinus,factito,factura,facunde,faecula,faenero,faeneus,faenile,faginus,falisca,familia,famosus,famulor,famulus,fanulum,farrago,farreus,fascino,fastigo,fatalis,fatifer,fatisco,faustus,fautrix,favilla,favisor,favitor,februum,fecundo,feditas,fefello,fellato,fellico,fellito,felonia,femella,feminal,feminus,feneror,feralis,ferctum,ferinus,feritas,ferocia,ferocio,ferraus,ferreus,ferrous,ferrugo,fervens,festino,festive,festuca,fetidus,fetifer,fetosus,fibroma,ficatum,ficetum,fictice,fictile,fidelia,fidelis,fidicen,fiducia,figlina,figulus,filetum,filiola,filtrum,fimbria,finalis,finalus,finctus,finitio,firmale,fiscale,fiscina,fistuca,fistula,fixtura,flabrum,flacceo,flaccus,flagito,flagrum,flamina,flavedo,flebile,flictus,floccus,florens,floreus,fluctio,fluctuo,fluctus,fluidus,fluvius,focaria,focillo,foculus,fodorus,foedero,foetura,foramen,forceps,fordeum,foresta,formica,formido,formose,formula,fornico,fortuna,fortuno,fossile,fractio,fragmen,francus,frendeo,frictio,frigdor,frixura,frondeo,frontal,fructus,fruitio,frustra,frustro,frustum,frutico,frygius,fucosus,fulcrum,fulgens,fulgueo,fulguro,fulmino,fumator,fumidus,fumifer,fumosus,functio,fundius,funebre,funesto,funivia,furcula,fuscina,fusilis,futatim,futilis,futurus,gabalus,galatea,galatia,galaxia,galbeus,galeola,galerum,galerus,galetta,gallina,garunna,gasalis,gasosus,gaudium,gaunaca,gausapa,gausape,gehenna,gelamen,gelidus,geminus,gemitus,gemmeus,genesta,genimen,genista,genital,genitor,genitus,genuale,genuine,germana,germino,gerulum,gerulus,gestito,gingiva,glabrio,glacies,gladius,glaesum,glaucom,glaucus,glebula,glomero,glorior,glosema,glutino,gluttio,gnascor,gorilla,gorytos,gorytus,gradior,gradual,graecia,graecor,graecus,granata,grandio,grandis,graphia,graphis,grassor,gravate,gravedo,gravida,gravido,gravito,gremium,gressus,grillus,griphus,griseus,groccio,grossus,grundio,grunnio,gryllus,guberno,gulosus,guttula,gyratus,habacuc,habilis,habituo,habitus,haeduus,haesito,hahahae,halatio,halitus,hamatus,hamiota,hapalus,hariola,harpago,harundo,harviga,hasisum,hastile,hauddum,haustus,hebenum,hebenus,hebesco,hebraea,hegumen,hejulor,helluor,helxine,herbula,herctum,hercule,heremus,herodes,herodio,heroina,heroine,hexeris,hiberno,hibrida,hiemans,hilaris,hilarus,hinulus,hirundo,hisopum,hisopus,hispane,histrio,hiulcus,hocceus,homilia,honeste,honesto,honorus,horalis,hordeum,horizon,horreum,hortius,hosanna,hospita,hospito,huccine,humanum,humanus,humecto,humerus,humidum,humidus,humilio,humilis,hybrida,hydolum,hydrops,hygiena,hymnizo,hypaton,hysopum,hysopus,iambeus,ibiscum,idcirco,ideirco,idipsum,idithum,idoleum,idolium,idoneus,ignarus,ignavia,ignavus,ignesco,ignifer,ignipes,ignitus,ignosco,ignotus,iliacus,ilignus,illabor,illatio,illatro,illecto,illicio,illinio,illotus,illuceo,illusio,illusor,illutus,immanis,immemor,immergo,immigro,immineo,imminuo,immitis,immitto,immotus,immugio,immunio,immunis,impages,impedio,impello,impendo,impensa,impense,impetro,impetus,impexus,impiger,impigre,impingo,implano,implico,imploro,impoene,importo,imprimo,improbo,impubes,impubis,impugno,impunis,impurus,inaequo,inaudio,inauris,inausus,incanto,incanus,incapax,incarno,incendo,incenso,incepto,incerno,incerto,incesso,incesto,inciens,incilis,incingo,incipio,incisim,incisio,incitus,inclamo,inclino,includo,incoquo,increpo,inculco,inculte,incumbo,incuria,incurro,incurso,incurvo,incutio,indecor,indicus,indidem,indigeo,indiges,indigis,indigne,indigus,indoles,indotia,induoia,indutia,indutio,induvia,inebrio,ineptio,ineptus,inergia,inermis,inermus,inertia,infabre,infamia,infamis,infatuo,infelix,infenso,inferia,inferne,inferus,infeste,infesto,inficio,infidus,infimus,infindo,infirme,infirmo,infitia,infligo,infodio,informo,infremo,infreno,infrico,infrons,infumus,infundo,infusco,infusio,ingigno,ingrate,ingravo,inhibeo,inimico,iniquus,initium,injecto,injicio,injungo,injuria,injurio,injurus,injussu,inlabor,inlatio,inlimis,inlotus,inluceo,inlusio,inlusor,inlutus,inmanis,inmemor,inmitto,inmunis,innatus,innecto,innitor,innubus,inolens,inormis,inpages,inpedio,inpello,inpensa,inpetro,inplano,inplico,inprimo,inprobo,inpubes,inpubis,inpunis,inquiam,inquies,inquino,inquiro,inrasus,inrideo,inrisio,inritus,inrumpo,insania,insanio,insanum,insanus,inscius,insecto,insemel,insequo,inserto,inservo,insideo,insidia,insidio,insigne,insilio,insillo,insimul,insinuo,insipio,insisto,insitio,insitor,insitus,inspico,inspiro,instans,instigo,instita,instruo,insuflo,insulio,insulta,insulto,insuper,insurgo,integer,integre,integro,intendo,intente,intento,intepeo,interea,intereo,interim,intibum,intibus,intimus,intingo,intremo,intrico,introeo,intubum,intubus,intueor,intumus,intutus,inultus,inumbro,inunguo,inustum,inustus,invasio,invenio,invergo,inverto,invicem,invicto,invideo,invidia,invidus,invisus,invitus,involgo,involvo,invorto,invulgo,ionicus,ipsimus,irascor,ironice,irradio,irretio,irrideo,irrisio,irrisor,irrisus,irritus,irrugio,irrumpo,ischium,isicium,israhel,isthmus,italica,itinero,jactans,jactito,jactura,jaculor,jaculum,jaculus,janitor,jejunus,jesuita,joannes,jocatio,jocosus,joculor,jocunde,jocundo,jubatus,jubilum,jubilus,jucunde,jucundo,judaeus,judaizo,jugalis,jugerum,jugiter,juglans,jugosus,jugulum,jugulus,junceus,junctim,junctus,jupiter,juratus,jurgium,justico,juvamen,juvenca,juvenis,juvenor,juventa,kalator,kalenda,labarum,labasco,labefio,lacerna,lacerta,lacesso,lacinia,lacrima,lacrimo,lacruma,lacryma,lactans,lactens,lacteus,lactuca,lacunar,ladanum,laetans,laganum,lagoena,laguena,laiciso,lamella,lamenta,lamento,lammina,lampada,lanatus,lancino,landica,langueo,languor,laniena,laniger,lanista,lanosus,lapsana,laquear,laqueum,laqueus,largior,laridum,lasania,lasanum,latebra,laterna,laterus,latesco,latinus,latomus,latraus,latrina,laureus,lautium,lavatio,laxatus,laxitas,lectica,lectito,lectrix,legalis,legatio,legator,legatum,legatus,legifer,legumen,lenimen,lenitas,leniter,lentigo,leodium,lepidus,lesbias,lesbium,lesbius,lesbous,letalis,letania,letifer,levamen,levatio,levitas,leviter,lexicon,libamen,libatio,libella,liberta,liberto,liburna,libycus,licitus,ligamen,ligator,ligneus,ligurio,limatus,limosus,lingula,liniger,linteum,linteus,litania,litatio,literum,littera,livesco,lividus,lixivus,localis,locatio,locator,loculus,locusta,locutio,logicum,logicus,lolligo,longule,loquela,loratus,loreola,loretum,loripes,lubrico,lucerna,lucesco,lucesct,lucidus,lucifer,lucisco,lucisct,lucubra,lucubro,lucusta,ludicer,lugubre,lumbare,lunaris,lunatus,lupanar,lupatum,lupatus,lupinum,lupinus,lupulus,lurchor,luridus,lustror,lustrum,lutesco,lutetia,lutosus,luxatio,luxuria,luxurio,lychnus,lyricus,maceria,macesco,machina,madefio,madesco,madidus,maeleth,maerens,maestus,maevius,magicus,magnale,maizium,majalis,malacia,malacus,malagma,malaria,malefio,malifer,maligno,malitia,malleus,mamilla,mammale,mammona,mamonas,manceps,mancipo,manduco,manibia,manioca,manlius,mansito,mansues,mantele,mantica,mantile,manubia,mappula,marcens,marchio,margino,marinus,marisca,maritus,martius,mastice,mastigo,materia,matrona,maturus,maxilla,maximus,maxumus,medella,medians,mediate,medicor,medicus,meditor,medulla,melicus,melioro,melisma,melissa,melleus,melodes,melodia,melodum,melodus,melotes,melotis,membrum,memento,memiens,memoria,mendico,mensula,mensura,mensuro,mensuum,mentior,mentula,meracus,merenda,meridio,meritum,meritus,mespila,messala,metreta,metuens,michina,miliare,militia,militus,minerva,minitor,minutal,minutia,minutim,minutus,mirator,misereo,miseret,miseria,miseror,misinga,missile,missito,mitesco,mitulus,mobilis,moderor,modicum,modicus,modulor,modulus,moechor,moechus,moerens,moestus,molaris,moleste,molesto,molimen,molitio,monacha,monitio,monitor,monitus,monstro,moralis,morator,moratus,morosus,mortual,mortuus,morulus,motetum,motivum,motivus,mozetta,mucidus,mugilis,muginor,mugitus,mulctra,mulinus,muneror,munifes,munimen,munitio,munitor,munitus,muraena,muralis,muratus,murdrum,murinus,murmuro,murreus,musaeus,musicus,musinor,musivum,musivus,mussito,mustela,musteus,mutatio,mutilus,mutuens,mutulus,myalgia,myoparo,myrteus,mystice,mytulus,nablium,naphtha,nasutus,natalis,natator,natatus,nativus,navalis,naviger,naviter,nazarus,nazista,nebrida,necesse,necesso,neclego,necunde,nefarie,negatio,negator,neglego,negligo,negotio,nequior,nervius,nescius,nexilis,nidulus,nigrans,nigredo,nigrita,nihilum,nimirum,ninguis,nitella,nitesco,nitidus,nivalis,nivosus,nobilis,nocivus,nodosus,nomisma,nonanus,nonnemo,nonnisi,noscito,notatio,notesco,notitia,novalis,novamen,novatio,novella,novello,noverca,novitas,noviter,noxalis,nubifer,nubilis,nubilum,nubilus,nucatum,nucleus,nuditas,nugator,nullibi,numerus,numisma,numquam,numquid,nunciam,nuncium,nuncius,nuncupo,nundina,nunquam,nunquid,nuntium,nuntius,nusquam,nutamen,nutrico,nutrior,obaudio,obducto,obedens,obfendo,obfirmo,obfusco,objaceo,objecto,objicio,objurgo,oblatio,oblatro,oblecto,oblitus,oblivio,obnatus,obnitor,obnixie,obnixus,oboedio,oborior,obortus,obrepto,obretio,obrizum,obrizus,obrussa,obruzus,obrysum,obrysus,obryzum,obscene,obscuro,obsecro,observo,obsideo,obsidio,obsigno,obsisto,obsitus,obsonor,obstino,obstruo,obtendo,obticeo,obtineo,obtingo,obtundo,obtusus,obtutus,obumbro,obuncus,obustus,obvenio,obverto,obvolvo,occaeco,occasio,occasus,occatio,occator,occento,occidio,occipio,occiput,occisio,occisor,occludo,occulco,occulte,occulto,occumbo,occurro,occurso,oceanus,ocellum,ocellus,octipes,october,odiosus,oestrus,oesypum,offendo,offensa,offenso,officio,offirmo,offucia,offundo,ogganio,oleosus,olfacio,olfacto,olympia,olympus,omentum,omissus,omnifer,onustus,opertum,opertus,ophites,opipare,oporteo,oportet,oppando,oppidum,opprimo,oppugno,oprepto,opscene,opsecro,opsonor,optatio,optatum,optatus,opticus,optimas,optineo,optingo,optivus,optumus,opulens,oraclum,orarium,orarius,oratrix,orbator,orbitas,orcivus,orditus,orestes,orfanus,organum,oricula,ornatus,orphana,osculor,osculum,ostendo,ostento,ostreum,otiolum,otiosus,ovanter,ovarium,ovicula,ovillus,ozonium,pabulor,pabulum,pacalis,pacatum,pacatus,paciens,pacifer,pacisco,paedico,paenula,paganum,paganus,pagatim,pagella,palatum,pallaca,pallens,pallium,palmeus,palmula,palmyra,palpito,pampino,panacea,panaces,panicum,panicus,panifex,panthus,papalis,papatus,papaver,papilio,papilla,papissa,papista,papyrio,papyrum,papyrus,parasis,paratus,parento,parilis,paritas,pariter,parmula,parocha,parosis,parotis,parthus,partior,partite,pascuum,pascuus,passive,pastino,patefio,patella,patesco,patiens,patinus,patrius,patrizo,patrona,patruus,patulus,paullum,paullus,paululo,paupero,pavefio,pavesco,pavidus,pecunia,pedalis,pedamen,pedatus,pelagus,pelamis,pelamys,pellego,pellico,peloris,pelusia,penetro,peniteo,penitet,penitus,pennula,pensito,pentral,penuria,peracer,peragro,perbene,perbibo,perbito,percido,percieo,percuro,percuto,perdite,perdoco,perdolo,perdomo,perduco,perduro,peregre,peregri,perenno,pererro,perfero,perfigo,perfluo,perforo,perfuga,perfuro,pergula,perinde,peritia,peritus,perjuro,perlego,perligo,perlito,permano,permuto,pernego,peroleo,peropus,perorno,perosus,perpaco,perpluo,perpoto,perquam,perrepo,perrogo,persano,perseco,persido,persona,persono,pertego,pertexo,pertica,pertito,pervado,perveho,pervius,pervolo,pessimo,pessona,petasio,petasus,petesso,petilus,petisso,petitio,petitor,pexatus,phaedon,phalanx,phalera,philema,philyra,phlegma,phoenix,phrigia,phrygia,phylaca,physica,physice,picaria,pictura,pignero,pignoro,pigredo,pilanus,pilatus,pilleum,pilleus,pilosus,pimenta,pinetum,pinguis,pinifer,piniger,pinnula,pipetta,pipulum,pipulus,piscina,pistris,pistrix,pituita,placide,placito,placius,plagula,plancus,planeta,plangor,plantar,plastes,plaudeo,plausor,plausus,plegius,plennus,plevina,plicato,plinius,plumbum,plumeus,pluteus,pluvius,poculum,podagra,poenior,poetica,poetria,polaris,polenta,polimen,politus,pollens,pollueo,polulum,polulus,polypus,pomifer,pomosus,pondero,pontius,popanum,populor,populus,porisma,porosus,porrigo,portale,portula,positio,positor,positus,possido,posthac,posthoc,postibi,postulo,postuum,postuus,potator,potiono,praebeo,praecox,praedor,praefor,praendo,praepes,praeses,praesto,praesul,praesum,praeter,praetor,praeuro,prandeo,pransor,prasius,precium,prensio,pressio,pressus,prester,pretium,pridile,primula,priscus,prisona,pristis,proavia,proavus,probrum,procedo,procere,procido,procreo,procubo,procudo,procuro,prodico,prodigo,produco,profano,profero,profluo,progero,proicio,proinde,prolato,prolixe,prolixo,proludo,promono,prompte,prompto,pronoea,pronuba,propago,propalo,propero,propino,propior,propola,propoma,propono,proprie,propter,proquam,prorepo,proreta,proreus,prorito,prorogo,prorsum,prorsus,proseda,prosero,prosper,protego,protelo,protero,proveho,provivo,provoco,provolo,provomo,proximo,proxior,prudens,prurigo,psalmus,psaltes,psithia,psythia,ptisana,ptongus,pubesco,publice,publico,publius,pudicus,puellus,puertia,pugilus,pugneus,pulcher,pulchre,puleium,pulenta,pullulo,pumilio,pumilus,punctim,punctio,punctum,punicus,punitor,pupilla,pupulus,purista,purpura,pussula,pustula,putamen,putatio,putator,putesco,putidus,putredo,pylorus,pyramis,pyrites,pyromis,pyropus,pyrrica,pyrrice,pytisma,pytisso,quadrin,quadrum,quadrus,quamdiu,quamvis,quandiu,quantum,quantus,quassus,quattor,quatuor,quercus,querela,quernus,questus,quianam,quiddam,quidnam,quiesco,quietus,quinque,quintum,quintus,quinymo,quisnam,quisque,quomodo,quondam,quoniam,quopiam,quoquam,quorsom,quorsum,quorsus,quoties,rabboni,rabidus,rabiola,rabiose,racemus,rachana,ramosus,ramulus,rancens,rapidus,rapulum,raresco,rasilis,rastrum,rastrus,ravenna,rebello,recaleo,recanto,reccido,recello,recepto,recingo,recipeo,recipio,reclamo,reclino,recludo,recondo,recoquo,recrepo,recudeo,recumbo,recurro,recurso,recurvo,recutio,redhalo,redicor,redimio,reditio,reditus,redivia,redoleo,redundo,reduvia,refello,referio,reficio,refingo,refluus,reformo,refoveo,refreno,refrico,refugio,refugus,refulgo,refundo,regalis,regigno,regimen,regulus,regusto,rehendo,rejecto,rejicio,relabor,relatio,relator,relatus,relicum,relicus,religio,reluceo,remaneo,remigro,remisse,remitto,remoror,remotus,removeo,remugio,renarro,renideo,renitor,reparco,repecto,repello,rependo,repente,reperco,reperio,replico,reporto,reposco,reprimo,reprobo,reptile,repudio,repugno,repulsa,repulso,repungo,repurgo,requies,requiro,resacro,resecro,reservo,resideo,resigno,resilio,resimus,resipio,resisto,resolvo,resonus,respiro,restito,resulto,resurgo,retardo,retendo,retento,reticeo,retineo,retorta,retraho,retundo,retusus,revello,revenio,reverto,revinco,revolvo,rhombus,rhomium,rhytium,ricinum,rigatio,rigesco,rigidus,rimosus,rivalis,rivulus,rixosus,roberia,robotum,rogalis,rogamen,rogatio,rogator,romanus,romphea,romulus,roridus,rorifer,rosetum,rostrum,rotatio,rotundo,rubesco,rubetum,rubinus,rubrica,rucheta,rufinus,rufulus,rugosus,ruminor,runcina,runcino,rusceus,russula,rustica,rustice,rutilus,rutundo,sabaoth,sabinus,sabucus,sabulum,saburra,saeclum,saeptum,sagatus,sagitta,sagitto,sagulum,salebra,saliens,salinum,salomon,salsedo,salsugo,salsura,saltito,saluber,samaria,sambuca,sanctio,sanctor,sanctus,sanesco,sanguis,sanitas,sapidus,sapiens,sapinus,sapphir,sarcina,sardina,sardius,sarmata,sartago,satanas,sativus,satrapa,satraps,satyrus,saucius,saxetum,saxosus,saxulum,scabies,scaevus,scalmus,scalper,scamnum,scandix,scapula,scarpus,scaurus,scelero,schisma,sciolus,sciphus,scirpea,scirpia,scirpus,scirros,scissor,sciurus,sclodia,scomber,scorpio,scortor,scortum,scripto,scrobis,scrotum,scrupus,scrutor,scrutum,scurror,scutale,scutica,scutula,scymnus,scyphus,scytala,scytale,secerno,secludo,secreto,sectura,seculis,seculum,secunda,secundo,securis,securus,secutor,sedatio,sedatus,sedecim,seditio,sedulus,segrego,seichus,seisina,seiugis,sejungo,selibra,sellula,semesus,semibos,semifer,semigro,semimas,seminex,semipes,semisos,semivir,semotus,semoveo,senator,senatus,senecta,senesco,senilis,senipes,senones,sentina,seorsum,seorsus,sepeleo,sepelio,septies,septunx,sequana,sequela,sequens,sequius,serenum,serenus,seresco,sericum,sericus,seriola,seriose,serpens,serrula,sertula,servans,servius,servula,seselis,sessito,setthim,severus,sextans,sextius,sextula,sibilum,sibilus,sibylla,sicanus,siceras,sicilia,siculus,sicunde,sigarum,sigillo,signale,silanus,silesco,silicia,siliqua,sillaba,silpium,similax,similis,simonia,simplex,simplum,simulac,simulus,sinapis,sinopis,siparum,siremps,sirpeus,sirpius,sirupus,sisamum,sistrum,sitella,sitiens,sitthim,smaltum,soboles,sobrina,sobrius,sodalis,sofista,soissus,solamen,solator,solidum,solidus,solinus,solitum,solitus,sollers,solutio,solutus,somnium,sonipes,sonitus,sonorus,soporus,sorbilo,sordide,sorites,sortior,sospita,sospito,spacium,spartum,spasmus,spatior,spatium,spatula,spatule,species,spectio,specula,sphacos,sphaera,spiceus,spiculo,spineus,spissus,spodium,spolium,spondeo,spongea,spongia,sponsal,sponsio,sponsor,sponsum,sponsus,spretio,spretor,spumeus,spurcus,spurium,spurius,squaleo,squalor,squalus,squilla,stabulo,stadium,stagnum,stannum,stantia,stapeda,staphis,statera,statica,stativa,statura,stellio,stercus,sternax,sternuo,stibium,stichus,stimulo,stinguo,stipula,stoicus,stolide,strages,stramen,stratum,stratus,strepes,strideo,stridor,strigio,stringo,stropha,strutio,studium,stultus,stupeus,stuprum,sturgio,sturnus,stygius,styraca,suadela,suavium,subasso,subcido,subduco,subfuro,subicio,subinde,subitus,subjugo,sublego,sublevo,sublica,subligo,sublime,sublimo,sublino,submuto,subnego,subnoto,subnuba,suboles,subolet,suborno,subpuro,subrepo,subrigo,subrogo,subscus,subseco,subsero,subsido,subtero,subtexo,subveho,subvolo,succedo,succido,succino,sucidus,sucinum,sucinus,suffero,suffigo,suffoco,suffuro,suggero,sugillo,suicida,suillus,sulphur,summuto,sumptus,superbe,superdo,superne,superum,superus,supinus,suppedo,suppeto,suppilo,suppleo,supplex,suppono,suppuro,supputo,surrepo,surrido,surrogo,sursisa,suscito,suspiro,susurro,sutilis,syllaba,symbola,synaphe,syncopa,syncopo,synodus,syringa,systema,tabacum,tabanus,tabefio,tabella,taberna,tabesco,tabidus,tacitus,taedeor,taedior,taedium,talaris,taliter,talitha,tamarix,tametsi,tamquam,tangens,tanquam,tapetes,tapetum,tappete,taureus,techina,tegimen,tegumen,telinum,temetum,temperi,tempero,templum,tempori,tenebra,tenebro,tenisia,tentigo,tepefio,tepesco,tepidus,terebro,termino,terreus,territo,tertium,tesella,tesquum,tessera,testeus,testudo,testula,tetanus,tetrans,teucria,textrix,textura,thallus,thapsia,theoria,thiasus,thracia,threcia,threnus,thronus,thunnus,thyinus,thyisca,thymbra,thymion,thynnus,thyrsus,tiberis,tibiale,tibicen,timidus,tinctus,tintino,tippula,titanus,titillo,titulus,toculio,togatus,tolenno,tolleno,tonella,tonsura,topazos,topazus,toreuma,torosus,torpedo,torqueo,torques,torquis,torrens,tortula,tortura,tostrum,totalis,totidem,toxicum,toxicus,tractim,tractus,traduco,trafero,tragula,traicio,transdo,transeo,transno,transuo,trepide,trepido,tresvir,tribulo,tridens,triduum,trienne,trifaux,trimetr,triplex,tripodo,trirota,tristis,tristor,tritura,trituro,trivium,trivius,trochus,troclea,troclia,trucido,trucilo,truncus,trusito,trutina,trutino,tubicen,tullius,tumesco,tumidus,tumulus,tunella,turbedo,turbela,turbido,turifer,turista,turrile,turunda,tutamen,typanum,typicus,uberius,ubertas,ubertim,ultimum,ultumus,umbella,umectus,umerale,uncinus,unciola,unctito,undecim,undecum,undique,undosus,ungella,unguedo,unifico,unigena,univira,urbanus,ursinus,usarius,ustrina,usualis,utrinde,utroque,uxorium,uxorius,vacatio,vaccula,vacefio,vacerra,vacillo,vacivus,vadosus,vagitus,valesco,validus,vanesco,vanilla,vanitas,vapidus,vapulus,varicus,vattium,vavasor,vectura,vegetus,velamen,velifer,velitor,vellaea,vellico,venalis,venatio,venator,venatus,vendico,vendito,venenum,veneror,venetia,venetum,venetus,venosus,ventilo,ventito,venumdo,venundo,venuste,venusto,verbena,verbero,veredus,verfico,veritas,vermino,vernula,verruca,versara,vertigo,verutum,verutus,vesania,vesanus,vespera,vesperi,vestigo,vetulus,vetuste,vexamen,vexatio,vicanus,vicaria,vicatim,vicenus,vicinia,vicinum,vicinus,victima,victimo,victrix,victuma,viculus,vidulus,vigesco,vigilax,vigilia,viginti,vilesco,vilicus,vilitas,villana,villata,villica,villico,villula,vinclum,vindico,vinetum,vinitor,vinosus,violens,violina,viresco,virgeus,virgula,viridis,viridor,virilis,viritim,virosus,vitalis,vitatio,vitifer,vitilis,vitilla,vitreus,vitulus,vivatus,viverra,vivesco,vividus,vivisco,vocalis,vocamen,vocatio,vocatus,vocivus,volatio,volatus,volitio,volitus,volnero,voltium,volucer,volumen,vomitio,vomitor,vomitus,votivus,vulnero,xiphias,xprimus,zabulus,zagonus,zelotes,zizania,zizyfum,zotheca,abactius,abaculus,abaestuo,abalieno,abambulo,abbatizo,abbrevio,abductio,abellana,abequito,abhorreo,abiegnus,abjectio,abjectus,abjudico,ablaqueo,ablepsia,abluvium,abneptis,abnormis,abnuitio,abnumero,abolefio,abolesco,abolitio,abolitor,abominor,aborisco,abortium,abruptio,abruptum,abruptus,abscindo,abscisio,abscisus,abscondo,absconse,absegmen,absentia,absentio,absistus,absolute,absorbeo,absortio,absortus,abstemia,abstergo,abstinax,abstineo,abstraho,abstrudo,abstruse,absumedo,absurdus,abundans,abusivus,academia,acalephe,acanthis,acanthos,acanthus,acaustos,acaustus,accantus,accapito,accelero,accenseo,accensus,accentor,accentus,acceptio,acceptor,acceptum,acceptus,accersio,accessio,accessus,accidens,acclinis,acclivis,acclivus,accolens,accomodo,accordum,accresco,accretio,accretus,accumulo,accurate,accursus,accusito,aceratus,acerneus,acerosus,acetarum,acetasco,acetosus,achantum,achillas,achilles,achynops,acidatas,aciditas,acidulus,acinaces,acinosus,acisulus,aclassis,acolitus,acolytus,aconiton,aconitum,acontias,acontizo,acredula,acridium,acritudo,acroasis,actarius,actorius,actualis,actuaria,actuosus,acuarius,acutalis,acutarus,acutatus,acutulus,adaestuo,adaggero,adalligo,adamator,adambulo,adamplio,adapatio,adaperio,adaresco,adauctor,adauctus,adaugmen,adbrevio,adcelero,adcessio,adcessus,adclinis,adclivis,adclivus,adcolens,adcresco,adcretio,adcretus,adcumulo,adcurate,adcursus,addecimo,addendus,addenseo,addictio,addictus,addivino,addormio,addubito,adductor,adductus,adelphis,ademptio,ademptor,adequito,aderator,adesurio,adfectio,adfector,adfectus,adfiguro,adflator,adflatus,adflecto,adflicto,adfluens,adfrango,adfringo,adfulgeo,adfundor,adgaudeo,adgenero,adgestim,adgestio,adgestum,adgestus,adgnatio,adgnatum,adgnatus,adgnitio,adgnitor,adgnitus,adgnomen,adgnosco,adgredio,adhaereo,adhaesio,adhaesus,adhinnio,adhorreo,adhortor,adhucine,adiantum,adibilis,adimpleo,adingero,adinstar,adipatum,adipatus,adjacens,adjectio,adjectus,adjudico,adjutrix,adjuvans,adlaboro,adlapsus,adlector,adlectus,adloquor,adluctor,adluvies,adluvius,admaturo,admetior,admisceo,admissio,admissor,admissum,admissus,admistio,admistus,admixtio,admixtus,admoenio,admolior,admordeo,admorsus,admutilo,adnavigo,adneptis,adnihilo,adnililo,adnubilo,adnumero,adnuntio,adnutrio,adolesco,adoperio,adoperte,adopinor,adoptata,adoratio,adorator,adordino,adornate,adparate,adparens,adpendeo,adpendix,adpensor,adpetens,adplaudo,adplumbo,adposite,adprecor,adprendo,adprenso,adpretio,adprimus,adprobus,adpropio,adpulsus,adquieto,adrachne,adrectus,adremigo,adrogans,adrotans,adscendo,adscisco,adscitus,adscribo,adsector,adsecula,adsellor,adsensio,adsensor,adsensus,adsentio,adsentor,adsequor,adsertio,adsertor,adsertum,adservio,adsessio,adsessor,adsessus,adsevero,adsibilo,adsiduus,adsimilo,adsimulo,adsocius,adspargo,adspecto,adspergo,adspicio,adstator,adstatus,adsterno,adstituo,adstrepo,adstrido,adstupeo,adsuefio,adsuesco,adsuetus,adsultim,adsultus,adtactus,adtagena,adtempto,adtentio,adtentus,adtestor,adtiguus,adtinguo,adtitulo,adtolero,adtondeo,adtonite,adtracto,adtrecto,adtribuo,adtritio,adtritus,adtumulo,adulater,adulatio,adulator,adultera,adultero,adultrix,adunatio,advectio,advector,advectus,adventor,adventus,adversio,adversor,adversum,adversus,advigilo,advocata,advorsor,advorsum,advorsus,adynamos,aeclesia,aecoreus,aedicula,aedifico,aedituus,aedonius,aegidius,aegilopa,aegilops,aegithus,aegolios,aegresco,aegrotas,aegrotus,aegyptus,aemilius,aeneator,aeneolus,aeniator,aeniolus,aequalis,aequamen,aequatio,aequator,aequipar,aequipes,aequitas,aequiter,aeracius,aerarium,aerarius,aerifice,aerizusa,aeroides,aerugino,aesculus,aessomus,aestifer,aestimia,aestivum,aestivus,aestuose,aetatula,aeternum,aeternus,aethalus,aethiops,affectio,affector,affectus,affiguro,afflator,afflatus,afflecto,afflexus,afflicto,affluens,affrango,affringo,affulgeo,affundor,aforemus,aforetis,afranius,agalmate,agaricum,ageraton,aggaudeo,aggenero,aggestim,aggestio,aggestum,aggestus,aggredio,agiaspis,agilitas,agiliter,aginator,agitatio,agitator,agitatus,aglaspis,agnascor,agnellus,agnomino,agonista,agraphum,agrarius,agrestis,agricola,agripeta,agrosius,agrostis,agrypnia,ahenipes,aithales,alabetes,alaricus,albarium,albarius,albineus,albitudo,albuelis,alburnum,alburnus,alchemia,alchimia,alcibium,alcyonis,alearius,algensis,alibilis,alicaria,alicunde,alimonia,alioquin,aliorsum,aliorsus,alipilus,allaboro,allapsus,allector,alleluia,allergia,alliatum,allobrox,allodium,alloquor,alluctor,alluvies,alluvius,almarium,almities,almonium,almutium,alopecia,alopecis,alsidena,alsiosus,altarium,altercor,altercum,alterius,alternis,alternus,altilium,altispex,altitudo,alucinor,alumnula,alumonia,alvarium,alveatus,alveolus,amabilis,amaracum,amaracus,amaresco,amaricor,amaritas,amariter,amatorie,amaturio,amaxites,ambactus,ambadedo,ambidens,ambiguum,ambiguus,ambiorix,ambitudo,ambivium,ambrosia,ambubaia,ambubeia,ambultus,ambustio,ambustum,amentius,amfitapa,amflexus,amiantus,amicalis,amicicia,amicimen,amicinum,amiciter,amicitia,amicosus,amiculum,amiculus,aminaeus,aminneus,aminnius,amitinus,ammaturo,ammentum,ammetior,ammineus,ammisceo,ammissio,ammissor,ammissum,ammissus,ammistio,ammistus,ammixtio,ammixtus,ammoenio,ammolior,ammordeo,ammorsus,ammutilo,amnensis,amnestia,amoletum,amolitio,amorifer,ampendix,amperium,amplexor,amplexus,ampliter,ampullor,amuletum,amussito,amussium,amygdala,amygdale,anabasis,anagyros,analecta,analemma,analogia,analogus,analysis,anaphora,anarchia,anarchos,anasceue,anataria,anathema,anathemo,anatinus,anatomia,anatomie,anatonus,ancaesum,ancillor,anclabre,ancorago,ancorale,andabata,andremas,aneticus,anetinus,angarium,angarius,angellus,anglicus,anguifer,anguilla,anguinum,anguinus,anguipes,angustas,angustia,angustio,angustum,angustus,anhydros,anicetum,anicetus,anicilla,anilitas,aniliter,anilitor,animalis,animatio,animatus,animosus,animulus,anisatum,annarius,annavigo,annihilo,annualis,annubilo,annumero,annuntio,annutrio,anodynon,anodynos,anodynum,anodynus,anomalia,anomalus,anonymia,anonymos,anonymus,ansarium,antarius,antecedo,antefero,antepono,antequam,anterior,antestes,antestor,antevolo,anthedon,anthemis,anthemum,anthinus,anticato,anticipo,anticuus,antidhac,antilope,antiquum,antiquus,antistes,antonius,anucella,anularis,anulatus,anxietas,anxiosus,anxitudo,aperitio,apertura,aphrodes,apiacius,apiarium,apiarius,apiastra,apicatus,aplestia,aplustre,apocatus,apocynon,apodixis,apodosis,apologia,apologus,apostata,apostato,apostema,apostola,apotheca,apotheco,apparate,apparens,appellum,appendeo,appendix,appensor,appetens,applaudo,applumbo,apposite,apprecio,apprecor,apprendo,apprenso,appretio,apprimus,approbus,appropio,appulsus,aprarius,apricula,aprineus,aprugnus,apscindo,apscisio,apscisus,apscondo,apsconse,apsegmen,apsentia,apsentio,apsidata,apsistus,apsolute,apsorbeo,apstemia,apstergo,apstinax,apstineo,apstraho,apstrudo,apstruse,apsumedo,apsurdus,apsyctos,aptitudo,apyrenum,apyrenus,apyretus,apyrinum,apyrinus,aquagium,aquarium,aquarius,aquatile,aquifuga,aquileia,aquilius,arabilis,arancium,araneans,araneola,arantium,arantius,arbitrix,arbitror,arboreus,arborius,arbustum,arbustus,arbuteus,arcarius,arcatura,arcebion,arcersio,arcessio,archeota,archivum,arcticos,arcticus,arcturus,arcuatim,arcuatio,arcuatus,ardenter,arduitas,arefacio,arenaria,arenatio,arenatum,arenatus,arenosum,arenosus,argemone,argennon,argentum,argestes,aricolor,ariditas,aridulus,arificus,arilator,arithmus,armamaxa,armarium,armatura,armenius,armentum,armigera,armillum,armonica,armonice,arpaston,arquatus,arquitis,arrectus,arremigo,arrhalis,arrhetos,arrogans,arrotans,arsineum,arterium,articlus,articulo,artuatim,artuatus,arutaena,arvernus,arytaena,asarotos,asarotum,asarotus,asbestos,ascaules,ascensio,ascensor,ascensus,ascetria,ascopera,asinalis,asininus,asinusca,asomatus,aspaltus,aspectio,aspectus,aspernor,aspersio,aspersus,asperugo,asplenon,asplenos,asplenum,aspretum,aspriter,assarius,assatura,assector,assecula,assellor,assensio,assensor,assensus,assentio,assentor,assequor,assertio,assertor,assertum,asservio,assessio,assessor,assessus,assevero,assibilo,assiduus,assimilo,assimulo,assisium,associus,assuefio,assuesco,assuetus,assulose,assultim,assultus,assyrius,astaphis,astercum,asterias,asterion,asthenia,astipulo,astralis,astreans,astricte,astricus,astrideo,astrifer,astriger,astringo,astrosus,atatatae,atechnos,atheista,atheneus,atheroma,atomicus,atriolum,atriplex,atrophia,atrophus,attachio,attactus,attagena,attamino,attempto,attentio,attentus,attestor,attiguus,attinguo,attitulo,attolero,attondeo,attonite,attorreo,attracto,attrecto,attribuo,attritio,attritus,attumulo,auctifer,auctiono,auctoror,auctumno,aucupium,audacter,audenter,audentia,augifico,augmento,augurale,augurato,augurium,augurius,augustus,auloedus,aumatium,aunculus,aurarius,auratura,aureatus,aureolus,auricula,aurigans,aurigena,auriolus,aurugino,ausculor,ausculto,ausculum,auspicor,austerus,autentus,authenta,authepsa,authoror,autopsia,autumnal,autumnus,auturgus,auxilior,auxilium,avariter,avaritia,aviarium,aviarius,avicella,aviditas,aviditer,avocatio,avocator,axiculus,azoticus,baburrus,baccalia,baccalis,baccaris,bacchans,bacchius,baccifer,baceolus,bacillum,bacillus,baetulus,baijulus,baillium,balantus,balbutio,balineum,baliolus,baliscus,ballaena,ballatio,ballator,ballista,ballivus,balneare,balsamum,baptisma,baptista,baratrum,barbaria,barbarum,barbarus,barbatus,barbesco,barbiger,barbiton,barbitos,barditus,barrinus,barritus,barython,basaltes,bascauda,basiatio,basiator,basicula,basileum,basileus,basilica,basilice,basilium,basiolum,bassista,bastagia,bastarda,basterna,batallum,batillum,batillus,battalia,battuens,battutus,baubatus,bdellium,beatitas,beatulus,bebrinus,bechicus,beduinus,behemoth,belgicus,bellator,bellicum,bellicus,bellifer,belliger,bellosus,bellulus,beloacos,belualis,beluatus,beluilis,beluinus,beluosus,benedice,benedico,benefice,benevole,benignor,benignus,benivole,benzinum,berillus,berullus,beryllos,beryllus,bessalis,bestiola,betaceus,biarchia,biarchus,biblicus,biblinus,bibonius,bibrevis,bicallis,bicepsos,bicessis,bicinium,bicornis,bicorpor,bicrotum,bidental,biduanus,biennium,bifariam,bifarius,bifestus,bifissus,biformis,bifurcum,bifurcus,bigarius,bigemmis,bilibris,biliosus,bilongus,bimatris,bimestre,binarius,biologia,biologus,bioticus,bipalium,bipalmis,bipalmus,bipartio,bipatens,bipedale,bipennis,bipertio,bipinnis,biprorus,birretum,bisaetus,bisextum,bisextus,bisulcis,bisulcum,bisulcus,bitumino,bivertex,blachnon,blactero,blandior,blattero,blatteus,blavetum,blechnon,blendium,blendius,blennius,blevetum,blovetum,bobsequa,bolarium,bolbiton,bombarda,bombardo,bombulum,bombylis,borealis,boreotis,botanica,botellus,bothynus,botrysos,bovarius,bovillus,brabeuta,brabilla,brabreum,bracatus,brachium,bracilis,branchia,branchos,brandeum,brassica,brenthos,breviale,brevitas,breviter,brocchus,bromosus,brumalis,brumaria,brunetus,brunneus,brutalis,brutesco,bryonias,bubalion,bubonion,bubonium,bubsequa,bubulcus,bucardia,buccella,buccinum,buccinus,bucerius,buglossa,bulbatio,bulbosus,bulbulus,bullatio,bullatus,bullesco,bullitus,bumammus,bumastus,bumbulum,burgaria,burgator,burgeria,burichus,burricus,buthysia,buthytes,buttutti,byssinum,byssinus,caballio,caballus,caccabus,caccitus,cachecta,cachexia,cachinno,cacumino,cadialis,cadmitis,caduceum,caduceus,cadurcum,caecator,caecatus,caecilia,caecitas,caeculto,caecutio,caelamen,caelator,caelatum,caeleste,caelicus,caelifer,caeliger,caelitus,caementa,caemento,caenatio,caenator,caenatus,caenosus,caeparia,caepetum,caeposus,caepulla,caerulum,caerulus,caesitas,caespito,caeterus,calabrix,calathus,calatina,calcaria,calcator,calcatus,calcetum,calcitro,calculum,calculus,caldaria,caldicus,calenter,calfacio,calficio,caligula,caliptra,callaica,callaina,callicia,callidus,callimus,callisco,callosus,calthula,calumnia,calvaria,calvatus,calvesco,calybita,cambitas,cameleon,camillus,camisium,cammaron,cammaros,cammarus,campagus,campania,campanus,campitor,canabula,canarius,canaster,cancamum,cancello,cancroma,candefio,candesco,candetum,candidum,candidus,canentas,canicula,canifera,canities,canitudo,cannabis,cannabum,cannabus,cannetum,canonice,canonizo,cantamen,cantatio,cantator,canticum,canticus,cantillo,cantreda,cantulus,canturio,canusina,capellus,capillus,capistro,capitale,capitium,capnites,capnitis,capparis,cappella,capreida,capreola,caprilis,caprinus,capripes,capronea,capsella,capsicum,captatio,captator,captiose,captivus,capucium,caputium,caracter,carbasum,carbasus,cardamum,cardelis,carectum,carentia,cariosus,carissus,carmelus,carnalis,carnatio,carnatus,carnicis,carnifex,carniger,carnosus,carnufex,carnutes,caroenum,carpasum,carptura,carrucha,carrulus,carthago,carucata,caryinos,caryinus,caryites,caryotis,casarius,caseatus,caseolus,casmilus,castanea,castella,casteria,castitas,castrens,casualis,cataegis,catagusa,catallum,cataplus,catecizo,catellus,catenula,cathedra,catheter,cathetos,cathetus,catillum,catillus,catlitio,catlutio,catonium,catulina,caucalis,cauculus,caudecus,caulator,cauletum,caulodes,cauponor,caupulus,caurinus,causalis,causarie,causatio,causimus,causodes,caustice,cauterio,cautione,cautroma,cautulus,cavannus,cavatura,cavealis,caveatus,caviaris,caviarum,cavillor,cavillum,cavillus,cedenter,cedrinus,celandum,celatura,celebris,celestis,celeusma,cellaris,cellatio,celocula,cementum,cenchris,cenchros,cenobium,censitio,censitor,censitus,centenum,centenus,centipes,centrina,centuria,centurio,ceparius,cerarium,cerarius,cerasium,cerastes,ceratias,ceratina,ceratium,ceratura,ceraules,ceraunus,cerberos,cerberus,cerceris,cercitis,cercurus,cercyrus,cerealis,cerebrum,cereolus,ceriaria,cerifico,cerineus,cerintha,cerinthe,cernulus,cerrinus,cerritus,certamen,certatim,certatio,certator,certatus,certioro,certisco,ceruchus,cervesia,cervical,cervinus,cervisia,cervulus,ceryceum,cerycium,cessatio,cessator,cetarium,cetarius,cetionis,chalceos,chalceum,chalceus,chaldeus,chamelea,chananea,charisma,charitas,charmido,charteus,chartula,chelidon,chelonia,chelysos,chemicus,chemista,cheneros,cheragra,cheregra,cherubim,cherubin,chianter,chininum,chiragra,chiregra,chirocma,chlamyda,chloreus,chlorion,chodchod,choenica,chondris,choragus,choralis,choraula,choraule,chordula,choricum,choricus,chorista,chreston,christus,chromium,chronica,chronius,chryseum,chryseus,chydaeus,chylisma,cibarium,cibarius,cibdelus,cibicida,ciborium,cicatrix,ciconius,cilicium,cilotrum,cimelium,cinaedia,cinaedus,cinctura,cindecoe,cinereum,cinereus,cingulum,cingulus,cinnabar,cinnamon,cinnamum,cinnamus,cinyphes,circaeon,circaeum,circanea,circinus,circiter,circitor,circuago,circulor,circulus,circumdo,circumeo,circumio,circumno,circundo,cirratus,cirritus,cisarius,cisorium,cissaron,cissaros,cissitis,cistella,cisterna,cistifer,citerius,citharus,citratus,citreago,citretum,citrinus,citrosus,citrulus,civilizo,clamator,clamatus,clamosus,clanculo,claresco,claretus,claritas,claritus,clathrum,clathrus,claudico,claudigo,claudius,clausula,clausura,clavator,clavatus,clavicen,claviger,clavulus,clematis,clericus,clibanus,climacis,climacus,clinamen,clinatus,clinicos,clinicum,clinicus,clinopus,clitella,cliticos,clivolus,clivosus,clivulus,cloacula,clostrum,clunalis,clurinus,clusaris,clusilis,clustrum,clybatis,clymenos,clymenus,clystera,cnedinus,cnidinus,coaccedo,coacervo,coacesco,coactile,coactura,coadjuto,coaggero,coagulum,coalesco,coalitio,coalitus,coambulo,coaresco,coarmius,coassumo,coauctio,coaudito,coaxatio,cobaltum,cocainum,coccinum,coccinus,coccygia,cochlear,cocionor,cocleare,cocleola,coctanum,coctilis,coctilum,coctivus,coctonum,coctoria,codicula,coeleste,coeliaca,coelicus,coelifer,coelitus,coemesis,coemptio,coemptor,coenatio,coenator,coenosus,coepulor,coerctio,cogitate,cognatio,cognatus,cognitio,cognitor,cognitus,cognomen,cognosco,cohabito,cohaereo,cohaeres,cohaesio,cohaesus,coherceo,cohortor,cohospes,cohumido,cohurnus,coimbibo,coincido,coinquio,colaphus,colatura,coleatus,colegium,colepium,colimbus,collabor,collambo,collaris,collatio,collator,collatro,collatus,collaudo,collecta,collecte,collesco,colletis,collibro,collicia,collineo,collinio,collinus,collisio,collisus,collubus,colluceo,collugeo,collusim,collusio,collusor,collutio,colluvio,collybus,collyris,colobium,colophon,colorate,coloreus,colorius,colossos,colossus,colostra,columbar,columbor,columbus,columnar,columpna,colurnus,colustra,coluteum,colymbas,colymbus,comaudio,combenno,comedium,comestio,comestor,comissor,comitium,comitiva,commadeo,commando,commaneo,commatus,commenda,commendo,commento,commereo,commigro,commiles,commingo,comminor,comminuo,comminus,commisio,commisor,committo,commodum,commodus,commoneo,commoror,commosis,commotio,commotor,commotus,commoveo,commuate,commulco,commundo,communio,communis,comoedia,comoedus,comoinis,compages,compagus,compalpo,comparco,compareo,compasco,compater,compavio,compecco,compedio,compedus,compello,compendo,compenso,comperco,compereo,comperio,comperte,compesco,competum,compingo,compitum,compitus,complaco,complano,complexo,complico,complodo,comploro,compluor,comporto,compotio,compotor,compraes,comprimo,comprobo,compugno,compulso,compungo,compurgo,computus,conbenno,concaleo,concarno,concatus,concavum,concavus,concepto,concerno,concerpo,concerra,concerro,concerto,concesso,concheus,conchita,conchula,conchyta,conciens,concilio,concingo,concinne,concinno,concipio,concisio,concisor,concisus,concitor,concitus,concivis,conclamo,conclave,conclavo,concludo,concluse,concolor,concoquo,concorde,concordo,concredo,concremo,concrepo,concubeo,conculco,concumbo,concupio,concurro,concurso,concurvo,concutio,condator,condeceo,condecet,condemno,condenso,condepso,condicio,condigne,condisco,conditio,conditor,conditum,conditus,condoceo,condoleo,condomum,condrion,condulco,condulus,condumno,condylus,confacio,conferte,conferva,confervo,conficio,conficto,confideo,confindo,confingo,confinis,confinus,confirmo,confisco,confisio,confixio,conflans,confligo,confluus,confodio,confoedo,conforio,conformo,conforto,confoveo,confremo,confrico,confrigo,confrixo,confugio,confundo,confusim,confusio,confusus,confutor,confutuo,congener,congeria,congerra,congerro,congeste,congesto,conglobo,congrego,congruus,coniacum,coninquo,coniptum,conjaceo,conjecto,conjicio,conjubeo,conjungo,conlabor,conlatio,conlator,conlatro,conlatus,conlaudo,conlecta,conlecte,conlesco,conlibro,conlicia,conlineo,conlinio,conlisio,conlisus,conluceo,conlugeo,conlusim,conlusio,conlusor,conlutio,conluvio,conmereo,conmitto,conmoneo,conmoror,conmotus,conmoveo,conmunis,connatus,connecto,connexio,connexum,connexus,connitor,conniveo,conopeum,conopium,conpages,conparco,conpareo,conpasco,conpavio,conpedio,conpedus,conpello,conpendo,conpenso,conperco,conperio,conpesco,conpetum,conpingo,conpitum,conplaco,conplano,conplico,conplodo,conploro,conpluor,conporto,conpotio,conpotor,conpraes,conprimo,conprobo,conpulso,conpungo,conpurgo,conquino,conquiro,conregio,conregno,conrepte,conrepto,conrideo,conrigia,conripio,conrugis,conrugus,conrumpo,conrupte,consacro,consario,consatio,consavio,conscius,consecor,consecro,consecue,consepio,consepto,conseque,conserba,conserte,conserva,conservo,consigno,consipio,consisto,consitor,consitus,consocer,consocia,consocio,consolor,consonus,consopio,conspiro,consputo,constans,constipo,construo,consuete,consulte,consulto,consummo,consurgo,consutum,contages,contagio,contamen,contatio,contator,contatus,contemno,contemte,contendo,contente,contereo,conterno,contexte,conticeo,contiger,contigno,contigue,contineo,contingo,contingt,continor,continue,continuo,contollo,contonat,contoral,contorte,contrado,contraeo,contraho,contremo,contrico,contrudo,contueor,contumax,contumia,contundo,conturbo,conturmo,contusio,contusum,contutor,contutus,conubium,convador,convallo,convario,convecto,convello,convenio,convenit,convenus,convergo,converro,conversa,converso,converto,convexio,convexum,convexus,convibro,convicus,convinco,conviolo,convisio,convitio,convivor,convolvo,convorro,convotus,convoveo,cooperio,cooperor,coordino,copadium,copertus,cophinus,copiates,copiosus,coptatio,copulate,coquinus,coquulum,coracino,coralium,corallis,corallum,corarius,corbitus,corbonas,corcotta,corculum,cordatus,cordiger,coriolum,corissum,cornatus,cornesco,cornetum,cornicen,cornicor,cornifer,corniger,cornipes,cornulum,cornutus,coronula,corregio,corregno,correpte,correpto,corrideo,corrigia,corripio,corrosio,corrugis,corrugus,corrumpo,corrupte,cortumio,coruscum,coruscus,corvinus,coryceum,corymbus,cosentio,cosidero,cosmetes,cosmicos,cosmicum,cosmicus,cosmitto,costatus,coticula,cotoneum,cotoneus,cottabus,cottanum,cottidie,cottidio,cottonum,coturnix,coturnus,covinnus,coxendix,crabatus,crastino,crateraa,cratitio,creatrix,creatura,crebesco,creditor,creditum,creditus,credulus,crematio,cremator,creperum,crepitus,crepulus,cretaria,cretatus,creterra,crethmos,cretosus,cribello,cricetus,criminor,crinalis,criniger,crininus,crinitus,crisimus,crispans,crispico,cristula,criticum,criticus,crobylos,crocatio,crocatus,crocinum,crocinus,crocitus,crocodes,crocotas,crocotta,crotalum,crotalus,cruciata,crucifer,crudelis,crudesco,cruditas,cruenter,cruentus,crumilla,cruralis,crustula,crusulum,cubitura,cuccubio,cucullio,cucullus,cucumula,cucurrio,cucutium,cuferion,culcitra,culearis,culicare,culillus,culmosus,culpatio,culpatus,culposus,cultello,culullus,cumatile,cumation,cumatium,cummagis,cummitio,cumprime,cumulate,cunarius,cunctans,cuneatim,cuneatio,cuneatus,cuneolus,cunilago,cuparius,cupedium,cupidium,cuppedia,cuprinus,curalium,curandus,curatela,curatrix,curatura,curculio,curialis,curiatim,curiatus,curionus,curiosus,currilis,currulis,cursatio,cursivus,cursoria,curvamen,curvatio,curvatus,curvesco,curvitas,cussinus,custodia,custodio,cuticula,cybindis,cyclicus,cydonium,cydonius,cymatile,cymation,cymatium,cymbalon,cymbalum,cymindis,cynanche,cynarium,cynismus,cynoides,cynomyia,cynosura,cyprinum,cyprinus,cypselus,cyreneus,cyrillus,cytropus,dactylis,dactylus,daduchus,daedalus,dagnades,dalmatia,damascos,damascus,damnatio,damnator,damnatus,damnosus,dapatice,daphneas,daphnias,dapsilis,datarius,deaconus,deaduoco,deambulo,debattuo,debilito,debitrix,debucino,decanium,decantus,decapito,december,decemvir,decennal,decennis,decenter,decentia,deceptio,deceptor,deceptus,decessio,decessor,decessus,decetero,decidens,deciduus,decimana,decipula,decitans,declinis,declivis,decoctio,decoctor,decoctum,decoctus,decoloro,decontor,decontra,decresco,decretio,decretum,decrusto,decumana,decuncis,decuplus,decuriat,decursio,decursus,decussio,decussis,dedecens,dedecoro,dedignor,deductio,deductor,deductus,defatigo,defectio,defector,defectus,defenero,defensio,defensor,defensum,deferveo,defessus,defetigo,definite,deflaglo,deflagro,deflammo,deflecto,defletio,deflexio,deflexus,deflocco,defloreo,deflorio,defluxio,defluxus,deforcio,deformis,deformus,defossum,defossus,defraudo,defretum,defringo,defrusto,defrutum,defuncta,defungor,degenero,deglabro,deglutio,degrumor,dehaurio,dehonoro,dehortor,deiferus,deificus,deinceps,deisatus,dejectio,dejector,dejectus,dejudico,dejurium,delaboro,delacero,delapido,delapsus,delatura,delectio,delector,delectus,deletrix,delibero,delicata,delicate,delicium,delicius,delictor,delictum,delicuus,delimito,delinguo,delinquo,deliquia,deliquio,deliquus,delirium,delitigo,delubrum,deluctio,deluctor,delumbis,delustro,demelior,demensio,demensum,demensus,dementer,dementia,dementio,demereor,demersio,demersus,demetior,deminoro,demissio,demissus,demitigo,demolior,demordeo,demorior,demulceo,demutilo,demuttio,denarium,denarius,denascor,denavigo,denditio,denomino,densatio,densitas,dentatus,dentista,dentitio,denumero,denuntio,deoperio,deoratus,depansum,deparcus,depascor,depastio,depectio,depector,depeculo,dependeo,depensio,depetigo,depictio,deplango,deplanto,deplatio,deplexus,deplumis,depoculo,deponens,depopulo,deportio,depraedo,deprecio,deprecor,deprendo,deprensa,depresse,depretio,depudico,depulsio,depulsor,dequeror,derectim,derectio,derector,derectum,derectus,derogito,deruptum,deruptus,desaevio,descendo,descindo,descisco,describo,descrobo,desculpo,desectio,desersum,desertio,desertor,desertum,desertus,deservio,desidero,desidies,desommis,desonans,desorbeo,despecto,desperno,despicio,despicor,despicus,despolio,desponso,desposco,desputum,desquamo,desterno,desterto,destillo,destitor,destituo,desubito,desubulo,desuefio,desuesco,desuetus,desultor,desursum,detectio,detector,detentio,detentus,detergeo,deterior,deterius,deterreo,detersio,detestor,detondeo,detonsio,detorreo,detracto,detrecto,detritus,detrunco,detrusio,deuteros,deuterus,deversor,deversus,devescor,devestio,deviatio,deviator,devictio,devincio,devolvor,devorsor,devotrix,dextella,dexterum,dextrale,diabetes,diabolus,diabulus,diaconia,diaconus,diacopus,diadoche,diaeteta,diagonus,diaiteon,dialogus,dialysis,dialyton,diameter,diamoron,diapasma,diapason,diapente,diaphora,diastema,diastole,diataxis,diatonon,diatonos,diatonum,diatonus,diatriba,diaxylon,dibaphus,dibucino,dicacule,dicrotum,dictamen,dictatio,dictator,dictatum,dicticos,dicturio,didrachm,didragma,diductio,dieteris,diffamia,diffindo,diffingo,diffinio,diffluus,diffugio,diffundo,diffusio,diffusor,diffusus,diffutuo,difringo,digammon,digammos,digammus,digeries,digestim,digestio,digestum,digestus,dignatio,dignitas,dignitos,dignosco,diiambus,dijudico,dijuncte,dikerion,dikerium,dilacero,dilamino,dilapido,dilapsio,dilargio,dilargus,dilatura,dilectio,dilector,dilectus,diligens,dilorico,dilucide,diluculo,diludium,diluvies,diluvium,dimensio,dimensus,dimetior,dimetria,dimetros,dimidium,dimidius,dimissio,dimissor,dimissus,dimminuo,dimolior,dinumero,diocesis,diogmita,dionymus,dionysus,diopetes,dipsacos,dipteros,diptotum,directim,directio,director,directum,directus,direptio,direptor,dirimens,dirrumpo,diruptio,discaveo,discepto,discerno,discerpo,discindo,discludo,discolor,discolus,discoquo,discordo,discrepo,discribo,discumbo,discurro,discutio,disertus,disgrego,disidium,disjicio,disjungo,dispando,dispareo,dispello,dispendo,dispenso,disperdo,dispereo,dispergo,disperse,dispesco,dispicio,displodo,dispolio,disponso,dispungo,disquiro,disratio,disrumpo,disserto,dissicio,dissideo,dissigno,dissilio,dissitus,dissocio,dissolvo,dissonus,dissulto,distendo,distenno,distillo,distineo,distraho,disturbo,ditonica,diurnale,diutinus,divaliis,divarico,divergeo,diversus,dividuus,dochmius,doctrina,dolenter,doliolum,domigena,dominica,dominium,domitius,donarium,donatrix,dormitio,dorsuale,drachuma,dubietas,ducianus,ductilis,dulcesco,dulciter,dumnorix,dumtaxat,duntaxat,duodecim,duodecum,duplaris,duplatio,durities,dynamica,dynastes,dynastia,dyscolus,dyspnoea,eboracum,eboratus,ebriacus,ebrietas,ebriolus,ebriosus,eburatus,eburneus,eburones,eccillam,eccillud,eccillum,eccistam,ecclesia,echeneis,eclipsis,ecstasia,ecstasis,edibilis,edissero,educatio,educator,edulcoro,effarcio,effectio,effector,effectus,effemino,effercio,effertus,effigies,effluxus,effrenus,effringo,effugium,effulgeo,effultus,effuttio,egelidus,egoismus,egredior,egregius,egressio,egressus,egritudo,einlatus,ejaculor,ejulatio,ejulatus,ejusmodi,electrum,elenchus,elephans,elevatio,elinguis,elocutio,elocutus,eloquens,eloquium,elucesco,elucubro,emanatio,emancipo,embolium,emendico,ementior,emeritum,emeritus,eminulus,emistrum,emmanuel,emoderor,emodulor,empathia,emphasis,emporium,empyreus,empyrius,emunctio,encaenio,encenium,endromis,enhydris,enimvero,enitesco,enodatio,ensicula,eparchia,ephebeum,ephesius,ephoebus,ephpheta,epicopus,epidemia,epilogus,epiphora,epistola,epistula,epitheca,epogdoos,epogdous,equester,equestre,equuleus,eremitis,erogatio,eroticus,erroneus,erubesco,eruditio,eruditus,erumpnus,erungion,erungium,eryngion,eryngium,erysimon,erysimum,escarius,eschaeta,esecutio,essendio,essendum,essentia,essentio,essonium,esuriens,esuritio,esuritor,ethnicus,etiamnum,etiamtum,eucharis,eugeneus,eunuchus,euphonia,euphonus,euphoria,eusebius,eutyches,evalesco,evanesco,evanidus,eventilo,everbero,evilesco,eviratus,eviscero,evocatio,evocator,evocatus,evolutio,exacerbo,exacutio,exacutus,exaestuo,exaggero,exanimis,exanimus,exaperio,exarchia,exarchus,exaresco,exaspero,excalceo,excalcio,excarpus,excelsum,excelsus,exceptio,exceptus,excessus,excidium,exclusio,excogito,excresco,excrucio,excursio,excursus,excussio,excussus,executio,executor,exemplar,exemplum,exemptio,exemptus,exentero,exequior,exercens,exercito,exhaurio,exheredo,exhilaro,exhonoro,exhorreo,exhortor,exilitas,exiliter,exinanio,existimo,existumo,exolesco,exoletus,exoratio,exorbito,exorcizo,exordior,exordium,exosculo,exoticus,expansio,expatior,expedius,expensio,expensum,expensus,experior,expertus,expiatio,expiscor,explanto,explaudo,expletio,explodeo,explosio,exponens,expresse,exprobro,expugnax,expulsio,exsaturo,exscindo,exscribo,exsculpo,exsecror,exsectio,exsecuto,exsequia,exsequor,exsilium,exsomnis,exspecto,exspergo,exspolio,exstasia,exstasis,exsterno,exstirpo,exsufflo,exsultim,exsupero,extemplo,extensio,extensor,extensus,extergeo,exterius,externus,exterreo,extimulo,extinguo,extispex,extorris,extranea,extremum,extremus,extritio,ezechiel,fabrefio,fabricor,fabrilis,fachirus,facialis,facultas,facundia,facundus,faecatus,faeneror,faetidus,fagineus,fagottum,falarica,falcanus,falcatus,falcidia,falcifer,falcitas,falerius,falernum,faliscus,fallacia,falsitas,fantasia,farcimen,farinula,farratus,fasciola,fascista,faseolus,fasianus,fastidio,fastosus,fatiscor,fatuitas,faucitas,favonius,fecundus,feficius,fellator,femineus,fenestra,feodalis,fercuium,ferculum,feretrum,ferialis,feriatio,feriatus,fermento,ferraria,ferratus,ferrivia,ferrumen,fertilis,ferumino,fervesco,fervidus,festinus,festivus,fetialis,fetifico,fetuosus,fetutina,ficedula,fictilis,ficulnus,fidicina,fidicula,figilina,figulina,filiatio,filiolus,firmamen,firmitas,firmiter,fiscalis,fiscella,fissilis,flagello,flagrans,flaminia,flammeum,flammeus,flammula,flatilis,flavesco,flavitas,flebilis,flexilis,flexipes,floresco,floridus,florifer,floriger,fluctuor,fluenter,fluentum,fluentus,flustrum,fluvidus,fluxilis,focarius,foeditas,foetidus,foetifer,foetosus,foliatum,foliatus,fomentum,fontalis,fontanus,foraneus,forensis,foricula,formalis,formonse,formosus,fornicor,forsitam,forsitan,fortasse,fortiter,fortuito,fortuitu,foruitus,fossatum,fossatus,fossilis,fracesco,fragilis,fraglans,fragosus,fraxinus,fremitus,frequens,fricatio,fricatus,frigesco,frigidus,frivolus,frondeus,frugalis,frugifer,frunesco,frustror,frutetum,fruticor,fulcimen,fulgesco,fulgidus,fullonia,fulmenta,fumifico,fumigans,fumigium,fundamen,fundatio,funditor,funditus,funebris,funereus,funestus,funginus,fungosus,furcifer,furcilla,furcillo,furialis,furiosus,furtivus,futtilis,gabinius,galbanen,galbanum,galbanus,galbinum,galbinus,galeatus,galilaea,gallicus,gammarus,gannitus,gardinum,garritus,garrulus,gateleia,gaunacum,gausapes,gausapum,gelasius,gelatina,geldonia,gemellio,gemellus,gemmatus,gemmifer,generose,genetica,genetrix,genialis,gentiana,genticus,gentilis,genuinus,geologia,geologus,gergovia,germania,germanus,gestamen,gestatio,gingiber,glaucoma,globalis,globosus,globulus,gloriola,gloriose,glossema,glutinum,gnaritas,gothicus,grabatus,gracilis,graculus,gradalis,gradatim,gradatio,graminus,granatum,granatus,granifer,granitum,granulum,grapheum,graphice,graphium,gratuito,gratulor,gravamen,gravatim,gravatus,gravidor,gravidus,gravitas,graviter,gregalis,gregatim,gremiale,gummitio,gurculio,gurgulio,gustatus,guttatim,gymnicus,gypsatus,habetudo,habilito,habitans,habitudo,habraeus,hactenus,haedilia,haedilla,haedinus,haedulea,haedulus,haeresis,halcycon,hannapus,hannibal,harenula,hariolor,hariolus,harmonia,harpyria,haruspex,hastatus,hastilis,haustrum,hebdomas,hebenius,hebetudo,hebraeus,hebraice,hectarea,helvella,helvetia,hepteris,herbesco,herbidus,herbifer,herbosus,hercisco,hercules,heredito,heremita,herodius,heroicus,hesperia,hesperus,hetaeria,hetairia,hethanim,hexapeda,hibernia,hibernum,hibernus,hibiscum,hibiscus,hiemalis,hiericus,hilarium,hilarius,hinnitus,hinnulus,hippurus,hircinus,hirsutus,hispania,hispanus,hispidus,hissopum,hissopus,historia,hocusque,hoedilla,hoedinus,hoedulus,homagium,homicida,homogium,homullus,homuncio,honestas,honestor,honestus,honorate,honorius,horarium,horarius,horminum,hormonum,horresco,horridus,horrifer,hortamen,hortatio,hortator,hortatus,hortulus,hosianna,hospitor,hospitus,hosticus,hostilis,hucusque,humectus,humerale,hutesium,hydoneus,hydromel,hymnicus,hymnodia,hypnosis,hypogeum,hypogeus,hysginum,hyssopum,hyssopus,hysteria,iambicus,idolatra,idolicus,ignatius,ignigena,ignorans,iligneus,illaboro,illaesus,illaqueo,illumino,illunius,illustre,illustro,illuvies,imaginor,imbellis,imberbis,imberbus,imbrifer,imitamen,imitatio,imitator,immensum,immensus,immerens,immerito,immisceo,immissio,immistus,immixtus,immorior,immorsus,immundus,immunius,impartio,impastus,impendeo,impensus,imperito,imperium,impertio,impetigo,impetrio,impietas,impilium,impinguo,impirius,implanto,implexus,implumis,impolite,impostor,impostus,impotens,imprecor,imprimeo,imprimis,improbus,impudens,impulsio,impulsor,impulsus,impunite,inaestuo,inambulo,inanimis,inanimus,inaratus,inauguro,inbellis,incassum,incautus,incensio,incensor,incensum,incensus,incentio,incentor,inceptio,inceptum,incertus,incessus,incestus,incidens,incinero,incircum,inclinus,inclitus,inclutus,inclytus,incrasso,incresco,incrusto,incubito,incultus,incumbro,incursio,incursus,incurvus,indaudio,indecens,indecoro,indemnis,indicium,indictio,indictus,indigena,indigens,indignor,indignus,indiguus,indoctus,indormio,indubito,indubius,inductio,indulgeo,indultum,indultus,indusium,indutrix,ineditus,inemptus,inequito,inerrans,infandus,infantia,infectus,infelico,infensus,infercio,infernum,infernus,infestus,inficior,infirmis,infirmum,infirmus,infitior,inflammo,inflatio,inflatus,inflecto,infletus,inflexio,informis,infrendo,infrenis,infrenus,infringo,infulcio,infulgeo,ingemino,ingenero,ingenium,ingenuus,ingratis,ingratus,inhabito,inhaereo,inheredo,inhonoro,inhorreo,inhumane,inimicus,initiale,injurior,injurius,injurose,injussus,injustus,inlaboro,inlaesus,inlautus,inlectus,inlepide,inlumino,inlustre,inlustro,inmensum,inmensus,inmissio,inmundus,innascor,innocens,innocuus,innoxius,innuleus,innuptus,innutrio,inobedio,inobedus,inolesco,inopinus,inoratus,inornate,inpensus,inpetigo,inpinguo,inpotens,inprimis,inprobus,inpudens,inpulsio,inquieto,inreptio,inriguus,inruptus,inscendo,insciens,inscitia,inscitus,inscribo,insculpo,insector,insequor,inservio,insibilo,insicium,insidior,insignio,insignis,insimulo,insolens,insomnis,inspargo,inspecto,inspergo,inspicio,instauro,insterno,instillo,institio,institor,instituo,instrepo,insuavis,insuesco,insuetus,insufflo,insulsus,insultus,intactus,intantum,intectus,intensio,intensus,intentio,intentus,intercus,interdie,interdiu,interdum,interemo,interest,interfio,interfor,interibi,interimo,interior,interluo,intermeo,internus,interrex,intersum,interula,intinguo,intitulo,intonsus,intritus,introsum,introsus,intrusio,intrusor,inutilis,inventio,inventor,inventum,investio,invetero,invictus,invidens,invigilo,iogurtum,iracunde,irneacus,irneosus,irreptio,irriguus,irrotulo,irruptio,istimodi,italicus,iteratio,jactatio,jamdudum,janitrix,jasminum,jejunium,jemineus,jobeleus,jobileus,jocundus,jucundus,judaicus,judicium,juditium,jugulans,julianus,jumentum,junctura,juppiter,jurandum,jureiuro,jurgator,jusculum,justitia,juvencus,juventas,juventus,kalumnia,karthago,labecula,labellum,labiosus,labrusca,lacertus,lachrima,lachruma,lachryma,lacrimor,lactatio,lactesco,lacticus,laetatio,laetitia,laguenos,laicalis,laicatus,lamentor,lamentum,lanarius,lanciola,languide,lanitium,lanterna,lapathum,lapathus,lapicida,lapideus,lapillus,laqueare,largitas,largiter,largitio,largitor,larualis,larvalis,lascivia,lascivio,lascivus,lassesco,lassulus,latebros,latenter,latinizo,latitudo,latrator,latratus,latrinum,laudatio,laudator,laureata,laureola,lauretum,laurifer,lauriger,laurinus,lautitia,lautumia,lavabrum,lavacrum,lectulus,lecythos,lecythus,legirupa,legitimo,lemannus,lenitudo,lentesco,lentulus,leoninus,leprosus,lethaeus,levifico,libellus,libenter,libertas,libertus,libitina,libramen,libraria,libratio,librilis,libritor,liburnus,licenter,licentia,licentio,lichanos,licinius,lienosus,ligatura,lignatio,lignator,lignosus,ligurius,ligurrio,ligyrius,limatura,limonata,limpidus,limusina,lineatus,liquamen,liquatio,liquefio,liquesco,liquidus,literula,litoreus,liturgia,locarium,locellus,locuples,lomentum,londinum,longulus,loquella,lorarius,lubenter,lubricor,lubricus,lucellum,lucrifio,lucrosus,luctamen,luctator,ludicrum,ludicrus,ludifico,lugdunum,lugubris,luminare,lupatria,lupercal,lupercus,luscinia,lusorius,luteolus,luxatura,luxuries,luxurior,lycaonia,lychanos,lymphans,macellum,maceries,machaera,machinor,macresco,mactator,maeander,magister,magistra,magnatus,maharaia,maheleth,mahemium,majestas,maledice,maledico,malefica,malefice,malevola,malevole,malignor,malignus,malivola,malivole,malluvia,mamereus,mammonas,manasses,mancusus,mandatum,mandorla,manducor,manentia,manerium,manganum,maniacus,manicula,manicura,maniplus,mannulus,mansueto,mantelum,manualis,manuleus,manuplus,marcesco,marcidus,marculus,mariscus,marxista,masculus,massieum,masticha,mastiche,mastigia,mastruca,matellio,materies,materior,maternus,mathesis,matrimus,mavultis,mecastor,mediante,medianum,medianus,mediator,medicina,medidies,medietas,medimnum,medimnus,mehercle,mellifer,mellitus,melopepo,membrana,memorial,menapius,mendicor,mendicus,mendosus,mensalis,menstruo,mephitis,mercator,mercatus,mercuria,meretrix,mergulus,meridies,meschita,mespilum,messalla,metallum,meteorum,methados,methodos,methodus,metricus,migratio,milipeda,militans,millenus,milliare,minagium,minerale,miniator,miniatus,minister,ministra,ministro,minurrio,mirabile,miracula,miraculo,mirifico,misellus,misereor,misfacio,missilis,mitratus,moabites,moabitis,mobilito,modernum,modernus,modestia,modestus,modiatio,modifico,modulate,molecula,molestia,molestus,molinula,mollesco,mollipes,molliter,mollitia,molossus,momentum,monachus,monarcha,monaules,monaulos,monaulus,moneaeum,monedula,monialis,monogama,monstrum,montanus,montosus,morbidus,morbonia,morbosus,mordicus,morigero,mortalis,mortifer,motorius,mozzetta,mulciber,mulctrum,multatio,mundanus,munditia,municeps,munifice,murilega,murmillo,muscatum,muscidus,muscosus,musculus,muslimus,mustella,mutatrum,mutinium,mutuatio,mutunium,myoparon,myosotis,myrmillo,myrtetum,mystetum,mysticum,mysticus,mythicos,nardifer,nardinum,nardinus,narratio,narratus,nartatio,nartator,naturans,naufrago,naupegus,nauseola,nauticus,navanter,navicula,navigium,nazareth,nazareus,nazismus,nazoreus,necessis,necessum,necessus,necopmus,necrosis,nefandus,nefarium,nefarius,nefastus,negative,negidius,negotior,negotium,neofitus,neomenia,neonatus,neptunus,nequando,nequedum,nequiter,nequitia,nervosus,nervulus,neuronum,neutrubi,nichilum,nidifico,nigellus,nigresco,nigritia,nihildum,nimbifer,nimbosus,nimietas,ninevita,ninivita,nitedula,nitrosus,nobilito,noctesco,nominito,nongenti,nonnihil,notarius,novacula,novellus,november,novicius,novitius,noxiosus,nubecula,nubigena,nullitas,nulliter,numerius,numerose,nummatus,nummisma,nummulus,nundinor,nundinum,nutricor,nutrimen,nylonium,nylonius,obambulo,obdormio,obductio,obequito,obfringo,objectum,objectus,oblaqueo,oblatrix,obliquus,oblitero,oblivium,oblongus,obloquor,obluctor,obmolior,obnoxius,obnubilo,obnuntio,oboedens,obreptio,obrussus,obsaepio,obsaturo,obscaene,obscenum,obscenus,obscurus,obsequor,obsessio,obsessor,obsetrix,obsidium,obsonium,obsorbeo,obstipus,obstrepo,obtentus,obtestor,obtrecto,obtrunco,obtueeor,obversor,obversus,occidens,occiduus,occultum,occultus,occursus,ocreatus,octavius,octuplum,octuplus,octussis,oculatus,odibilis,odoramen,odoratio,odoratus,odorifer,oenanthe,offensio,offensum,offensus,offertio,officina,officium,offlecto,offringo,offulgeo,oggannio,olearius,oleaster,olefacio,olefacto,olivetum,olivifer,olivitas,olorinus,ominosus,omnimodo,onerosus,operaria,operatio,operosus,opinatus,opinitas,opiparus,opitulor,opopanax,oportune,oppansum,oppassum,opperior,opprobro,opreptio,opscaene,opscenum,opscenus,opulente,oraculum,oratorie,ordinate,organizo,oricilla,origanum,oriundus,ornatrix,ornithon,orphanus,oscillum,oscitans,ossarium,ostendeo,ostensio,ostentui,ostentum,ostentus,ostiolum,ostrifer,ostrinus,ovulatio,oxydatio,oxygarum,oxyporus,pabillus,paccator,paccatum,pacifico,pacifier,paciscor,paederos,paeniteo,paenitet,paeonius,paetulus,pagencis,pagensis,paginula,palatium,paleatus,palifico,paliurus,pallesco,pallidus,pallolum,palmaris,palmatus,palmetum,palmifer,palmosus,palpator,palpebra,palumbes,palumbus,paluster,pampinus,panarium,pancreas,panicium,panicula,panifica,pannosus,panoplia,pantheon,panthera,pantheum,papainum,parabola,parabole,parabula,paragoge,paralios,paramese,paranete,parapsis,parasita,paraster,parmatus,parochia,parochus,paroecia,paropsis,partitio,parturio,parumper,parvitas,parvolus,parvulus,passivus,paternus,pathicus,patrator,patrimus,patritus,patronus,paucitas,pauculus,paulatim,paullulo,paululum,paululus,pavicula,paxillus,peccamen,peccatio,peccator,peccatum,peccatus,pecuinus,peculium,pedarius,pedatura,pedester,pedicura,pediseca,pelagius,pelasgus,pellacia,pellicio,pellitus,peltasta,peltatus,penarius,pendulus,pennatus,penniger,pennipes,pensilis,penteris,pentrale,pepsinum,peractio,peraeque,peraequo,peragito,peramans,perbonus,percarus,perceler,percello,percipio,percitus,percomis,percoquo,percrepo,percupio,percurro,percurso,percusio,percutio,perdepso,perdisco,perditim,perditio,perditor,perditus,perdives,perdoceo,perdoleo,perducto,perdudum,perendie,perennis,perficio,perfidia,perfidus,perfinio,perfodio,perfremo,perfrico,perfruor,perfugio,perfundo,perhibeo,perhilum,periclum,periodus,perizoma,perjurus,perlabor,perlateo,perlevis,perlibet,perlibro,perlicio,perlonge,perluceo,permaneo,permirus,permitto,permoveo,permunio,pernocto,pernosco,perparum,perpello,perpendo,perperam,perpetim,perpetro,perpetue,perpetuo,perpolio,perprimo,perpurgo,perquiro,perrarus,perrepto,perrumpo,persaepe,persalse,persedeo,persicum,persicus,persigno,persolus,persolvo,persulto,pertendo,pertento,pertergo,pertinax,pertineo,pertingo,pertitus,pertraho,pertundo,perturbo,pertusus,pervagor,pervagus,pervasto,pervenio,pervenor,perverto,pervetus,pervicax,pervideo,pervigeo,pervigil,pervilis,pervinco,pervolgo,pervulgo,pessimus,pessulus,pessumdo,pessumum,pessumus,pessundo,pestifer,petaurum,petrosum,petrosus,petulans,petulcus,phaedrus,phalanga,pharetra,phaselus,philtrum,phoenica,phoenice,phrigius,phrygius,phthisis,phycitis,physicos,physicum,physicus,piaculum,pigneror,pignoror,pigresco,pigritia,piisimus,pilentum,pinaster,pincerna,pinguedo,pinguido,pinnatus,pinniger,pinnipes,piratica,piscator,piscatus,piscosus,pisticus,pistrina,pittaria,pituinus,placamen,placatus,placenta,placidus,placitum,placitus,plagalis,plagatus,plagosus,planctus,planipes,planitas,planitia,plantago,platalea,platanus,platinum,plebeius,plebesco,plectrum,plenarie,pleniter,plicatus,pliciter,ploratus,plostrum,ploxenum,plumatus,plumbata,plumbeus,plumesco,plumipes,plumosus,pluralis,plurimum,plurimus,plurumus,pocillum,poeniteo,poenitet,poenitor,poeticus,pollenis,pollingo,polluceo,pollulum,pollulus,pollutio,pomarium,pomarius,pomerium,pompatus,pompeius,pompilus,pomposus,pontifex,pontufex,popellus,poppysma,populeus,porcella,porcetra,porcinus,porculus,porricio,portatio,portendo,porticus,portitor,possideo,postalis,postatus,posterga,posterus,postfero,posthaec,posthanc,posthinc,posticum,posticus,postidea,postilla,postmodo,postpono,postputo,postquam,postremo,postumus,potenter,potentia,poterium,potestas,potorium,potorius,praebibo,praecedo,praeceps,praecido,praecino,praedico,praedium,praeduco,praeduro,praefero,praefigo,praefluo,praefoco,praegnas,praeicio,praejuvo,praelego,praeligo,praelior,praelium,praemico,praemium,praenato,praenoto,praeolit,praeopto,praeparo,praepono,praerodo,praerogo,praescio,praeseco,praesens,praesepe,praestat,praesumo,praetexo,praetura,praevius,praevolo,prandium,prasinus,pratulum,pravitas,precatio,precatus,prehendo,prehenso,premotio,presbyta,pressule,priapeus,primanus,primatus,primitia,primitus,primoris,primulus,princeps,privatim,privatus,privigna,probalis,probatio,probator,probitas,problema,procella,procerus,prociedo,procingo,proclamo,proclino,proculco,procumbo,procurro,procurso,prodigus,proditio,proditor,prodoceo,producto,proelior,proelium,profanus,profecto,proficio,profligo,profluus,profugio,profugus,profundo,profusio,profusus,progagus,progener,progigno,prohibeo,projicio,prolabor,prolatio,prolecto,prolicio,prolixus,prologus,prolongo,promereo,promineo,promitto,promotio,promotum,promoveo,promptus,promulgo,pronepos,pronitas,propalam,proparte,propello,properus,propexus,propheta,propheto,propitio,proporro,proprius,propugno,propulso,proripio,prorumpo,prosapia,proserpo,prosilio,prosocer,prosodia,prospero,protelum,protendo,protesto,protinam,protinus,protollo,protraho,protrudo,proturbo,provenio,provideo,providus,provinco,provisor,provolvo,provulgo,proximus,proxumus,pruritus,prytanis,psaltria,psithium,psithius,psylleum,psyllion,psyllium,psythium,psythius,ptochium,pubertas,publicus,pudendus,pudenter,puellula,puerilis,pueritia,puerpera,puerulus,pugillus,pugnator,pulegium,pullatus,pulpitum,pulsatio,pulvinar,pulvinus,pumiceus,punicans,puniceus,punnulis,pupillus,puplicus,purgamen,purgatio,purifico,purismus,pusillum,pusillus,putealis,putorius,putrefio,putresco,putridus,pygargos,pygargus,pyrrhica,pyrrhice,pyrricha,pyrriche,quadrans,quadriga,quaerito,quaestio,quaestor,quaestus,qualibet,qualitas,qualiter,qualubet,quamquam,quandius,quanquam,quarzeus,quatenus,quatinus,quattuor,quemquem,querceus,querella,queritor,querneus,querulus,quicquam,quicquid,quidquid,quilibet,quilubet,quinaria,quincunx,quinimmo,quintana,quippiam,quippini,quirites,quiritor,quispiam,quisquam,quisquis,quocirca,quodnisi,quodquod,quojatis,quolibet,quominus,quotenus,quotidie,quotiens,quotomus,quotquot,quousque,rabbinus,rabiosus,racialis,radicula,radiosus,ramentum,rancidus,rapacida,raphanus,rarenter,rasorium,rassista,ratifico,reactrum,realista,realitas,realiter,rebellio,rebellis,recenseo,recenter,receptio,receptor,receptum,receptus,recessus,recipero,reclinis,recogito,reconflo,recordor,recresco,recupero,recursus,recurvus,recussus,redactor,redarguo,redhibeo,redormio,reductor,reductus,reduncus,refactor,refectio,refector,refercio,refertus,referveo,reflatus,reflecto,refocilo,refragor,refringo,refugium,refulgeo,regnator,reiculus,rejectio,relapsus,relative,relevium,relictio,relictum,relictus,relicuum,relicuus,relinquo,reliquia,reliquum,reliquus,reluctor,remansio,remedium,remetior,remigium,remisceo,remissio,remissus,remolior,remollio,remordeo,remulceo,remulcum,remunero,renascor,renavigo,renidens,renumero,renuncio,renuntio,repandus,repatrio,repertor,repertum,replegio,repletus,repostor,repostus,repotium,repperio,reprobus,reptatus,reptilis,repudium,repulsus,resaevio,resaluto,resarcio,rescindo,rescisco,rescribo,resemino,resequor,residuus,resorbeo,respecto,respergo,respicio,responso,restagno,restauro,restituo,retempto,retentio,retinens,retracto,retrecto,retribuo,retroago,retunsus,reverens,revereor,reversio,revertor,revincio,rhagadis,rhetoria,rhodanus,rhypodes,rhythmos,rhythmus,ribesium,ricinium,ridicula,ridicule,ripensis,ritualis,roboreus,robureus,robustus,rorarius,rosaceus,rosacius,rosarium,rosarius,roscidum,roscidus,rotarium,rotensus,rotundus,rubecula,rubellus,rudectus,rudicula,ruinosus,rumifico,ruricola,rurigena,rusticor,rusticus,rutilius,rutundus,sabbaoth,sabbatum,saccatum,sacculus,sacellum,sacerdos,sacratus,sacrifer,sacrista,saeculum,saetiger,saetosus,saevitia,salarium,salarius,salgamum,saliaris,salictum,salignus,salillum,saliunca,saltatio,saltator,saltatus,salutare,salvatio,salvator,sambucus,sapineus,sappirus,sarabala,sarabara,sarculum,sardinia,sardinus,sardonyx,sarracum,sarritor,satelles,satietas,satisfio,satrapes,satureia,satyrion,saviatio,saviolum,scabinus,scalprum,scamonia,scandula,scaphium,scaripho,scatebra,sceptrum,schedula,schoenus,scholium,scibilis,scienter,scientia,scilicet,scinifes,scissura,scitulus,sclopeto,scolymos,scopulus,scorpion,scorpios,scorpius,scorteum,scorteus,screator,screatus,scribtus,scrinium,scriptor,scriptum,scriptus,scripula,scrupeda,scrupeus,scrupula,scrutino,sculptor,scutatus,scutella,scutulum,secessio,secessus,seclusus,secretio,secretum,secretus,sectilis,sectivus,seculizo,secundum,secundus,secuutus,sedecula,seductio,seductor,seductus,segestra,segestre,segnipes,segnitas,segniter,segnitia,seisitus,selectio,sellaria,sellaris,sementis,semermis,semermus,semideus,semiesus,semihomo,semihora,semilixa,semodius,semuncia,semustus,senarius,senectus,sensatus,sensifer,sensilis,sentisco,septicus,sepultus,sequanus,seraphim,seraphin,seriosus,serracum,serratus,servator,servilis,servitus,servolus,servulus,sescenti,sessilis,sesterti,sexennis,sextilis,sexualis,sicarius,siccitas,sidereus,sigillum,signator,signifer,siliceus,silphion,silphium,silvanus,silvesco,silvosus,similago,simiolus,simpulum,simulans,simultas,sinceris,sincerus,sinciput,singulto,singulus,sinister,sinistra,sinuosus,siparium,siquando,siquidem,sirempse,sireneus,sirenius,sitarcia,sittybus,situalis,sobrinus,socagium,socculus,socialis,societas,socolata,socordia,socrates,socratus,sofistes,solacium,solarium,solarius,solatium,solemnis,solifuga,solipuga,solitudo,sollemne,soloecus,somnifer,sonivius,sonticus,sophisma,sophista,sorbilis,sorbillo,sorbitio,sordidus,sororius,sortitus,sospitas,soterium,sparteus,sparulus,spathula,specimen,speciose,spectrum,speculor,speculum,spelaeum,spelunca,spherula,sphragis,spicifer,spiculum,spinetum,spinifer,spinosus,spintria,spiralis,spiramen,spiratio,spiritus,splendeo,splendor,splenium,spondeus,sportula,spumesco,spumifer,spumiger,spumosus,sputamen,squameus,squatina,stabilio,stabilis,stabulum,stagneus,statalis,stataria,staticus,stativum,stativus,statumen,stellans,stercoro,sterilis,sternuto,sterteia,stibinus,stimulus,stipatio,stipator,stipulor,stoechas,stolatus,stolidus,strenuus,strepito,strictim,strictus,stringor,strinuus,stroppus,structor,studiose,stupefio,stupesco,stupidus,stuppeus,suasoria,suavitas,suaviter,subactio,subalare,subarrho,subaudio,subcavus,subcumbo,subditus,subdolus,subfodio,subigito,subintro,subjaceo,subjecto,subjicio,subjugum,subjungo,sublabor,sublatus,sublecto,sublicis,subligar,sublimis,sublimus,subluceo,submergo,submitto,submoneo,submoveo,subnecto,subnervo,subniger,subnisus,subnixus,subodoro,suborior,subortus,subrideo,subripio,subrubeo,subrufus,subsanno,subsilio,subsisto,substruo,subsulto,subsutus,subtemen,subtendo,subtilis,subtimeo,subtraho,subtusus,subucula,subulcus,suburgeo,subvecto,subvenio,subvento,subverto,subvexus,subvolvo,succavus,succendo,succidia,succingo,succinum,succlamo,succollo,succumbo,succurro,succutio,sudarium,suffamen,sufficio,suffimen,suffodio,suffrago,suffrico,suffugio,suffundo,suffusus,suggillo,sugrunda,sulpicia,sultanus,sumbolum,sumbolus,summatim,summatus,summergo,summitas,summitto,summoneo,summonio,summotor,summoveo,supellex,superans,superbia,superbio,superbus,superfio,supernus,superruo,supersto,supersum,supparum,supparus,suppetia,suppingo,supplico,supplodo,supporto,supprimo,suppudet,supremum,suptilis,surculus,surditas,surripio,suscipio,suspecto,suspendo,suspicax,suspicio,suspicor,sussulto,sustento,sustineo,sustollo,susurrus,sutorius,sutrinus,symbolum,symbolus,symptoma,synagoga,syncopes,syncopis,syndroma,synedrus,syngrafa,synodita,synzygia,syphilis,syriacus,systylos,tabellio,tabitudo,tablinum,tactilis,taedifer,taeniola,talarius,talentum,taminius,tamisium,tantulus,tardesco,tardipes,tarditas,tartarum,tartarus,taurinus,taxillus,technica,teloneum,telonium,tenellus,tenesmos,tenetura,tentamen,tentatio,tenuitas,tenuiter,terginum,terminal,terminus,terrenus,tertiana,tessella,testatio,testator,testatus,tetricus,teutonus,textilis,thalamus,theatrum,therafin,therapia,theurgus,tholicus,thracius,thraecia,threcius,thymiama,tiberius,tibicina,tigillum,tinnitus,tinnulus,tintinno,tolerans,tomaclum,tomentum,tonellum,tonellus,tonitrus,tonsilis,tonsilla,tonstrix,toparcia,topazion,topazius,topiaria,torcular,torculum,torculus,tormento,torminum,tornator,tornatus,torpesco,torpidus,torresco,torridus,tortilis,torvitas,trabalis,trabucus,traditio,tragicus,trajanus,trajicio,tralatio,transigo,transmeo,tranveho,trapetum,trapetus,trecenti,tredecim,tremesco,tremisco,tremulus,trepidus,treutino,treverus,triarius,tribolus,tribulis,tribulus,tribunal,tribunus,tributim,tributio,tributor,tributum,tributus,trichila,triduana,triennis,trifarie,trifidus,trigesim,triginta,trigonum,trigonus,trimeter,trimodia,trimodus,trinepos,trinitas,trinodis,triplico,tripudio,triremis,tritavia,triticum,triumphe,triumpho,triumvir,trochlea,trochlia,trocilea,trojanus,tropaeum,trulleum,trychnos,trygonus,tubineus,tuccetum,tugurium,tulingus,tumultuo,tumultus,tunellus,turannis,turbatio,turbator,turbidus,turgesco,turgidus,turmalis,turmatim,turpiter,turriger,turritus,tusculum,tympanum,tyrannis,tyrannus,ulciscor,ulterior,ultimate,ululatus,umbrella,umbrifer,umbrosus,umidulus,unanimis,unanimus,unciatim,unicitas,unicolor,unificus,unimanus,universe,univirus,univocus,unoculus,unovirus,uranicus,urceolus,urinatio,urinator,usitatus,usquequo,usuarius,usucapio,utcumque,utcunque,utibilis,utilitas,utiliter,utrarius,utrimque,utrinque,utrumnam,uvidulus,uxorcula,uxorculo,vaccinum,vaccinus,vacuitas,valedico,valenter,valerius,valetudo,valitudo,vallaris,varianus,variatio,varietas,vasarium,vasculum,vastatio,vastator,vastitas,vatillum,vecordia,vectigal,vegetale,vehemens,velarium,velifico,venatrix,venditio,venditor,venefica,ventosus,ventulus,venucula,venustas,venustus,veratrum,verbatim,verbosus,verendum,verendus,veretrum,vergilia,verifico,veritate,vernilis,verrinus,verrunco,verrutum,versatio,versilis,versutia,versutus,vertebra,vesicuia,vesicula,vespillo,vestalis,vestitus,veternus,vetustas,vetustus,vexillum,viaticum,viaticus,vibramen,viburnum,vicarius,vicennal,vicensim,vicensum,vicesima,vicissim,victoria,victuale,viduatus,viduitas,vigensim,vigensum,vigenter,vigentia,vigerius,vigilans,villanus,villicus,villosus,vimineus,vinaceus,vinarium,vinarius,vinculum,vindemia,vindemio,vindicia,vindicta,viocurus,violatio,violator,vipereus,virectum,virgatus,virgetum,virginal,virginea,virginia,virtuose,viscatus,vischium,visnetum,visorius,vispilio,vispillo,vitellum,vitellus,viticula,vitilena,vitiosus,vitricus,vittatus,vitulina,vitupero,vivarium,vivifico,volaemum,volcanus,volsella,volucris,voluntas,voluptas,vulcanus,vulgaris,vulgator,vulgatus,wadiator,warantia,warantus,wormacia,xandicus,xeniolum,xviralis,xviratus,xysticus,zephyrus,zetarius,zimmarra,zingiber,zizanium,ziziphum,zodiacus,zonarius,zoologia,zoologus,abbatissa,abdicatio,abdicatus,abditivus,abellanus,aberratio,abhorride,abiegneus,abiegnius,abigeator,abigeatus,abinvicem,abjuratio,ablativus,ablegatio,abligurio,abnegatio,abnegator,abnutivum,abnutivus,abortivum,abortivus,abpatruus,abrogatio,abrotonum,abrotonus,abscessus,abscissio,abscondeo,absconsio,absconsus,absidatus,absidiale,absidiola,absimilis,absolutio,absolutus,absorptio,abstantia,abstemius,abstergeo,absterreo,abstinens,abstrusio,abstrusus,absumptio,abundatio,acanthion,acanthius,accendium,acceptrix,accessito,accinctus,accipiter,accipitro,acclivius,accommodo,accomodus,accongero,accordeon,accorporo,accubitio,accubitum,accubitus,accuratio,accuratus,accusatio,accusator,acediosus,acentetus,acephalos,acephalus,acerbitas,acervalis,acervatim,acervatio,acetarium,achariter,achilleos,achilleus,acinarius,acipenser,acipensis,aclouthia,acoluthus,acolythus,acquiesco,acriculus,acrimonia,acrobates,acrozymus,actinosus,activitas,actuarium,actuarius,aculeatus,aculeolus,acupedius,acupenser,acupensis,acusticus,adaequate,adaeratio,adagnitio,adalgidus,adamantis,adamussim,adapertio,adaugesco,adblatero,adcommodo,adcubitio,adcubitus,adcuratio,adcuratus,additivus,adessurio,adfabilis,adfectato,adfectrix,adfinitas,adfirmate,adflictio,adflictor,adflictus,adfluente,adformido,adfrictus,adgeratim,adgeratio,adglomero,adglutino,adgnascor,adgredior,adgressio,adgressor,adgressus,adguberno,adheresco,adhibitio,adhospito,adinquiro,adinvenio,adinvicem,adipiscor,aditialis,adjectius,adjunctio,adjunctor,adjunctum,adjunctus,adjuratio,adjurator,adlacrimo,adlectura,adlegatio,adlegatus,adlevatio,adlevator,adlibesco,adlicefio,adligatio,adligator,adlocutio,adloquium,adlubesco,admiralis,admiratio,admirator,admissura,admoderor,admodulor,admonefio,admonitio,admonitor,admonitum,admonitus,admurmuro,adnotatio,adnotator,adnotatus,adnuntius,adopertum,adopertus,adoptatio,adoptator,adoptatus,adoptivus,adorandus,adoriosus,adosculor,adparatio,adparator,adparatus,adparesco,adparitio,adparitor,adpectoro,adpellito,adpetisso,adpetitio,adpetitor,adpetitus,adplausor,adplausus,adpositio,adpositum,adpositus,adpostulo,adpropero,adproprio,adproximo,adquiesco,adreptius,adrogatio,adrogator,adrumetum,adrumetus,adscensio,adscensor,adscensus,adscessio,adsecutor,adsenesco,adsentior,adsequela,adsessura,adsestrix,adsimilis,adsistrix,adspectus,adstipulo,adstricte,adstrideo,adstringo,adsubrigo,adsudesco,adsuetudo,adsumptio,adsuscipo,adsuspiro,adtempero,adtenuate,adtermino,adtonitus,adtorqueo,adtractio,adtractus,adtrepido,adtribulo,adulatrix,adulterus,aduncitas,advectius,adveneror,adverbero,adverbium,advincula,advocatio,advocator,advocatus,advolatus,aecclesia,aedifacio,aedilitas,aeditimor,aeditimus,aedituens,aeditumor,aeditumus,aegisonus,aegoceras,aegoceros,aegritudo,aegyptius,aemulatio,aemulator,aemulatus,aeolipila,aequaevus,aequilanx,aequiparo,aequipero,aequoreus,aerinavis,aerisonus,aerosolum,aerumnula,aesculeus,aesnescia,aestimium,aestuosus,aethereus,aetherius,aethiopia,aethiopis,affabilis,affectato,affectrix,affinitas,affirmate,afflictio,afflictor,afflictus,affluente,afformido,affrictus,africanus,agellulus,aggeratim,aggeratio,agglomero,agglutino,aggredior,aggressio,aggressor,aggressus,agguberno,agitatrix,agminalis,agminatim,agniculus,agobardus,agonalium,agraticum,agravitas,agricolor,agrippina,agronomia,alabaster,alacritas,alacriter,alaternus,albicasco,albiceris,albicerus,albicolor,albicomus,alcalinus,alcmanius,alcoranus,alcyoneum,alcyoneus,alectoria,alembicum,alexander,algificus,alicacius,alicarius,alienatio,alienitas,alimentum,alimonium,aliquando,aliquanto,alisalius,aliusmodi,allacrimo,allectura,allegatio,allegatum,allegatus,allegoria,allevatio,allevator,allibesco,allicefio,alligatio,alligator,alligatus,allocutio,alloquium,allubesco,alsulegia,altarista,alteratio,alterplex,alteruter,alticomus,altifrons,altijugus,altisonus,altistria,altitonus,altivolus,altruista,alumentum,aluminium,alumnulus,alumonium,alutacius,alutarius,alvearium,amandatio,amarantus,amarities,amaritudo,amatorium,amatorius,ambecisus,ambestrix,ambienter,ambifarie,ambitiose,ambroscus,ambrosius,ambulatio,ambulator,amburbale,amburbium,amendator,amerimnon,amfractus,amicarius,amicities,ammanites,ammanitis,ammiratio,ammirator,ammissura,ammoderor,ammodulor,ammodytes,ammonefio,ammonitio,ammonitor,ammonitum,ammonitus,ammurmuro,amnicolus,amniculus,amnigenus,amoebaeus,amoenitas,amoeniter,ampelinus,ampelitis,amphibius,amphidane,amphitane,amplector,ampliatio,ampliator,amplifice,amplifico,amplitudo,amploctor,ampollata,amputatio,amycticus,amygdalum,amygdalus,anabolium,anachites,anagallis,anagnosis,analogium,anamnesis,anancaeum,anancites,anancitis,anarrinon,anastasis,anatarius,anaticula,anatomica,anatresis,ancillula,anclabris,ancoralis,andrachle,andrachne,androgyne,aneticula,anfractum,anfractus,angelicus,anguigena,anguineus,angularis,angulatim,angulatus,angulosus,angustior,anhelitio,anhelitor,anhelitus,animatrix,animismus,anniculus,annositas,annotatio,annotator,annotatus,annotinus,annuculus,annularis,annulatus,annuntius,anserinus,antapocha,anteactus,antebasis,antecapio,antecello,antecurro,antefixum,antefixus,antegerio,antehabeo,antelucio,anteluclo,antemitto,antenatus,antevenio,anteverto,anthalium,anthyllis,anthyllum,antibasis,antidoron,antidotos,antidotum,antidotus,antigerio,antinomia,antiochea,antiochia,antiphona,antiphone,antipodis,antiscius,antistita,antitheus,antverpia,anularium,anularius,anxietudo,aparctias,apathicus,apeliotes,aperculum,aphaerema,aphorisma,aphractum,aphractus,apiastrum,aplustrum,apochatus,apolectus,apophasis,apophysis,apoplexia,apoplexis,aporiatio,apostasia,apostolus,apothesis,apothysis,apparatio,apparator,apparatus,apparesco,apparitio,apparitor,appectoro,appellans,appellito,appetisso,appetitio,appetitor,appetitus,applausor,applausus,appositio,appositum,appositus,appostulo,appropero,approprio,approximo,apricatio,apricitas,apriculus,apscessio,apscessus,apscissio,apsconsio,apsconsus,apsimilis,apsolutio,apsolutus,apsorptio,apstantia,apstemius,apstergeo,apsterreo,apstinens,apstrusio,apstrusus,apsumptio,aquamanus,aquaticum,aquaticus,aquatilis,aquicelus,aquiducus,aquifolia,aquigenus,aquilegus,aquilifer,aquilinus,aquitania,aquitanus,arachidna,arachidne,aranciata,araneolus,araneosus,aratorius,arbitrium,arborator,arboresco,arboretum,arbuscula,arcelacus,arcellula,arceracus,archaicus,archebion,archiacus,archiater,archiatia,archimima,archivium,arcuarius,arcuatura,arculatum,ardifetus,arenaceus,arenarium,arenarius,arepennis,argemonia,argenteus,argimonia,argutatio,argutator,argutiola,argutulus,argyritis,arhythmus,arietatio,arietinus,arillator,aristatus,aristifer,aristiger,aristosus,armilausa,armisonus,armonicus,armoracea,armoracia,aromatizo,arquatura,arrenicum,arreptius,arrogatio,arrogator,arsenicon,arsenicum,artemisia,arteriace,arthritis,arthrosis,articulus,artocopus,artocreas,artocrias,ascendens,asceticus,asclepias,asclepion,ascriptio,ascriptor,asellulus,asepticus,asiaticus,asinarius,aspalatus,asparagus,aspendios,asperitas,asperiter,aspilates,aspiramen,aspiratio,aspirator,aspirinum,aspisatis,aspritudo,assecutio,assecutor,assenesco,assentior,assequela,assessura,assestrix,assiculus,assimilis,assimilor,assiratum,assisinas,assistrix,assubrigo,assudesco,assuetudo,assulatim,assumptio,assuspiro,asteismos,asteriace,astericum,asterites,astipulor,astrapaea,astrapias,astrictio,astrictus,astrifico,astriotes,astructio,astructor,astutulus,asymbolus,asymptota,asyndeton,asyndetus,atamussim,atheismus,athenaeum,athletica,athletice,atomismus,atriarius,atricolor,atriensis,atrocitas,atrociter,atropinum,attelebus,attempero,attenuate,attermino,atticisso,attonitus,attorqueo,attractio,attractus,attrepido,attribulo,atuatucus,auctarium,auctifico,auctionor,auctorita,auctorizo,auctumnum,auctumnus,aucupalis,aucupatio,aucupatus,audaciter,audaculus,audientia,augmentum,auguralis,auguratio,auguratus,auleticos,auleticus,aulopoios,auraculum,aurantium,aurantius,auratilis,auricolor,auricomus,aurifluus,aurigalis,aurigatio,aurigator,aurigenus,auritulus,auspicato,auspicium,austellus,australis,austrifer,austrinum,austrinus,authentus,authorita,authorizo,autocthon,automatio,automaton,automatum,automatus,autonomia,autonomus,autopyros,autopyrus,autoraeda,avarities,avellanus,avenaceus,avenarius,averrunco,aversatio,aversator,avocatrix,avonculus,avunculus,axamentum,axitiosus,azimuthum,babylonia,bacalusia,bacchanal,baccharis,bacchatim,bacchatio,baccillum,bacterium,bajulatio,bajulator,balanatus,balaninus,balanites,balanitis,balbuties,balbuttio,balineare,balistium,balnearis,balneator,balneolum,balsameus,balteolus,bananicus,bancarius,baptismum,baptismus,barathrum,barbarice,barbaries,barbitium,basanites,basilicon,basilicum,basilicus,basilisca,bassilica,bastardia,bastardus,batalaria,batrachus,battualia,baxearius,baxiarius,beatifico,beatitudo,bellarium,bellatrix,belliatus,belligero,bellitudo,bellualis,belluatus,belluilis,belluinus,belotocos,benedicus,benefacio,beneficus,benevolus,benivolus,bestialis,bethsames,biclinium,bicodulus,bicolorus,biennalis,bifidatus,bigeminus,bilinguis,bilocatio,bilustris,bilychnis,bimammiis,bimaritus,bimembris,bimestris,binoctium,binoculum,binominis,biometria,biotopium,bipartito,bipedalis,bipertito,bipunctum,bisaccium,bisacutus,bisbellio,biscentum,biscoctus,bisellium,bismuthum,bispellio,bissextum,bissextus,bistropha,blandiens,blanditer,blanditia,blanditim,blanditor,blanditus,blandulus,blasphemo,blattaria,blattifer,blepharon,boicotizo,boletatio,bombycias,bombycium,bombycius,bombylius,bonifatus,bossellus,botanicus,botruosus,botryitis,botryodes,bovinator,bracarius,bracchium,brachiale,braciator,bracteola,brasmatia,bratteola,bravialis,breviatio,breviator,britannia,britannus,bronchium,bubalinus,bubulcito,bubulinus,bucinator,bucolicos,bucolicum,bucolicus,bucranium,buglossos,bulbaceus,bulimosus,bullarium,bupleuron,buprestis,burgagium,burgarius,burgensis,burrhinon,burrichus,bursarius,buselinum,bustualis,buxiarius,byzantium,caballion,cacabatus,cacabulus,cacaturio,cachinnus,cacoethes,cacometer,cacozelia,cacozelos,cacozelus,caculatum,caculatus,caducifer,caducitas,caduciter,caecitudo,caecuttio,caelatura,caelestis,caelicola,caementum,caeparius,caereiale,caerulans,caeruleum,caeruleus,caesareus,caesaries,caesicius,caesitius,caesposus,caesullus,caetratus,cafearius,calamitas,calamites,calautica,calcaneum,calcaneus,calcarius,calcatori,calcatrix,calcatura,calceamen,calcearia,calceator,calceatus,calcendix,calceolus,calciamen,calciaria,calciator,calciatus,caldarium,caldarius,caledonia,calefacio,calefacto,calicatus,caliculus,calidaria,caligaris,caligatio,caligatus,caligosus,callainus,callarias,caloratus,calpurnia,calumnior,calumpnia,calvarium,calvities,calvitium,calyculus,camaratio,cambialis,camelinus,cameralis,cameratio,campaneus,campanile,campanius,campanula,campeador,campester,campestre,campismus,cancellus,canceroma,canchrema,candentia,candicens,candidans,candidata,candidule,candifico,canistrum,cannabius,cannulono,canonicum,canonicus,canonissa,canonista,cantabrum,cantatrix,canterius,cantharis,cantharus,cantilena,cantuaria,capabilis,capacitas,capaciter,capedulum,caperatus,capidulum,capillare,capistrum,capitalis,capitatio,capitatus,capitulum,caprarius,capreolus,caprifico,caprigena,capriolus,capsarius,captatrix,captiosum,captiosus,capularis,capulatio,capulator,capulatus,caputalis,caracalla,caragogos,caravanna,carbaseus,carbatina,carboneum,carbunica,carcereus,carcharus,carcinias,carcinoma,cardamina,cardiacus,cardimoma,cardineus,carduelis,carduetus,carenaria,carinatus,caristium,caritores,carnarium,carnarius,carnicula,carnifico,carnufico,carpathum,carpentum,carpineus,carpistes,carrarius,cartallus,cartilago,caruncula,casearius,casellula,casiarius,casignete,cassidile,castellum,castifico,castigate,castitudo,castoreum,castratio,castrator,castratus,catabasis,catabulum,catachana,catacumba,catadupum,catalexis,catalogus,catamenia,catanance,catapulta,cataracta,catarrhus,catasceua,catasceue,catastema,catatonus,catecheta,catechizo,categoria,catenatio,catenatus,catharmos,catholice,cathurnus,catinulus,catlaster,catomidio,catulinus,cauponium,cauponius,cauponula,causarius,causidica,causticum,causticus,cauterium,cauterizo,cauteroma,cavaedium,cavaticus,cavefacio,cavernula,cavositas,cedrelate,cedrostis,celebrans,celeratim,celeripes,celeritas,celeriter,celetizon,cellarium,cellarius,cellerius,cellulosa,celsitudo,cenaculum,cenaticum,cenaticus,cenaturio,cenodoxia,censoreus,censorius,censualis,centauria,centauris,centaurus,centensim,centensum,centesima,centiceps,centipeda,centiplex,centralis,centratus,centricus,centrosus,centumvir,centupeda,centuplex,centuplum,centuplus,centussis,cepaticus,cephalaea,cepuricus,ceramitis,cerasinus,ceratitis,ceraunium,ceraunius,cerbereus,ceremonia,cerevisia,cerimonia,cerinthus,ceriolare,cernentia,certative,certifico,certitudo,cervarius,cervicale,cervicula,cerviscus,cessatrix,cessicius,cessitius,cestrotus,ceteroqui,chalazias,chalazion,chalazius,chalbanum,chalcetum,chalcites,chalcitis,chaldaeus,chamelaea,chameunia,chamulcus,chananeus,characias,character,charistio,charmides,charybdis,chelonium,chelydrus,cheniscus,chenturio,chernites,chernitis,cherolaba,chersinus,chiliasta,chiridota,chirurgia,chirurgus,chloritis,choragium,choragius,choraules,choraulis,choreutes,chortalis,chortinus,chrismale,christias,chronicon,chronicum,chronicus,chronista,chrysites,chrysitis,chrysizon,chrysopis,chuthenus,chytropus,cicatrico,cicercula,cichoreum,cichorion,cichorium,cicilinus,cicindela,cicindele,cicuticen,cilicinus,cimbricus,cinaedias,cinaedium,cinaedius,cincinnus,cinctutus,cineresco,cinerosus,cingillum,cinnameus,circellus,circensis,circuitio,circuitor,circuitus,circumago,circumaro,circumflo,circumluo,circummeo,circumpes,circumsto,cirrhosis,cirsocele,cissybium,cistarius,citharizo,citiremis,citivolus,citrarius,cituvolus,civilitas,civiliter,clabulare,clacendix,clanculum,claricito,clarifico,clarigito,claritudo,classicum,classicus,clatratus,clauditas,claustrum,clavarium,clavicina,clavicula,clavulare,claxendix,clementer,clementia,cleopatra,clepsydra,cliduchus,clientela,climacter,climatias,clinodium,clinopale,clipeatus,clipeolum,cloacalis,clodoveus,clunaclum,cluniacum,clunicula,clupeatus,clupeolum,clusarius,clypeolum,cnephosus,coactilis,coactivus,coadjutor,coadjutus,coaegroto,coaestimo,coaetaneo,coagmento,coagulare,coangusto,coaptatio,coargutio,coartatio,coassatio,coccineus,cochleare,cochleola,cocibilis,cocinaris,cocitatio,cocleatim,cocleatus,cocolobis,cocolubis,coctorium,coelectus,coelestis,coeliacus,coelicola,coenobita,coenobium,coenomyia,coercitio,coercitor,coextendo,coffeinum,cogitamen,cogitatim,cogitatio,cogitatum,cogitatus,cognitura,cognomino,cohaerens,coheresco,cohibilis,cohibitio,cohibitus,cohonesto,coincideo,coinquino,cojectura,colaphizo,colephium,coliculus,coliphium,colisatum,collaboro,collacero,collactea,collactia,collaetor,collapsio,collarium,collatero,collectim,collectio,collector,collectum,collectus,collegium,collibuit,colligate,collimito,colliquia,colloquor,collubuit,colluctor,colludium,collumino,collustro,collutito,colluvies,colluvium,collyrida,collyrium,colobicus,colocasia,colonatus,colonicus,colorator,coloratus,colosseus,colostrum,colubrina,columbare,columbula,columella,colustrum,coluthium,colyphium,comarchus,combardus,combretum,combullio,combustio,combustum,comesatio,comesator,cometessa,cometissa,comisatio,comisator,comitatus,comitessa,comitissa,comitivus,commacero,commaculo,commalaxo,commalleo,commarceo,commartyr,commaterr,commeator,commeatus,commeleto,commemini,commemoro,commensus,commentor,commentum,commentus,commercor,commereor,commetior,commictus,commilito,commisceo,commisero,commissio,commissor,commissum,commistim,commistio,commitigo,commixtim,commixtio,commodate,commodule,commoenio,commolior,commollio,commordeo,commorior,commostro,commovens,commulceo,communico,comoedice,compactio,compactum,compactus,compagina,compagino,comparate,compascua,compassio,compastor,compatior,compauper,compectum,compendio,comperior,compernis,compertum,compertus,competens,complaceo,complanto,complaudo,complecto,complenda,completio,completor,completus,complexio,complexor,complexus,complutor,composite,composito,compostio,compostus,compotens,compotrix,comprecor,comprendo,compresse,compresso,comptulus,compulsio,compulsor,compulsus,conabilis,conarache,conaudito,conbullio,conbustio,conbustum,concaedes,concalfio,concamaro,concamero,concateno,concentio,concentor,concentus,conceptio,conceptum,conceptus,concertor,concessio,concessus,conchatus,conchicla,conchuela,concilium,concinens,concinnis,concinnus,concionor,concipilo,concisura,concitate,conclusio,conclusum,conclusus,concoctio,concolona,concopulo,concordia,concordis,concrasso,concreduo,concresco,concretio,concretum,concretus,concrispo,concrusio,concubina,concubium,concubius,conculium,concursio,concursus,concussio,concussor,concussus,condalium,condecens,condecoro,condenseo,condensum,condensus,condictio,condictor,condictum,condignus,conditrix,conditura,condoctor,condoctus,condormio,condrilla,condrille,condrylla,conductio,conductor,conductum,conductus,condurdum,condyloma,conective,conexivus,confabulo,confarreo,confectio,confector,confectus,confedero,confercio,confersus,confertim,confertus,confessio,confessor,confessum,confessus,confestim,confibula,confictio,confictor,confictus,confidens,configuro,confinium,confinius,confiteor,conflagro,conflammo,conflatio,conflator,conflexus,conflicto,confloreo,confluens,conforane,conformis,confortor,confossus,confragum,confragus,confraria,confrater,confringo,confronto,confugela,confugium,confulcio,confulgeo,confultus,confunero,congarrio,congaudeo,congemino,congenero,congeries,congestim,congestio,congestus,congialis,conglacio,conglisco,congradus,congraeco,congregus,congruens,conivolus,conjectio,conjector,conjectus,conjugium,conjuncte,conlacero,conlactea,conlactia,conlaetor,conlapsio,conlatero,conlectim,conlectio,conlector,conlectum,conlectus,conlegium,conlibuit,conligate,conlimito,conliquia,conloquor,conlubuit,conluctor,conludium,conlumino,conlustro,conlutito,conluvies,conluvium,conmemini,conmemoro,conmercor,conmereor,conmetior,conmitigo,conmolior,conmorior,conmostro,conmunico,connascor,connubium,connumero,conopaeum,conpactum,conpactus,conparate,conpascua,conpastor,conpectum,conperior,conpetens,conplaceo,conplecto,conpletus,conplexio,conplexus,conposite,conposito,conpostus,conpotens,conpotrix,conprecor,conprendo,conpresse,conpulsio,conquadro,conquaero,conquasso,conqueror,conrectio,conrector,conrectus,conreptio,conreptor,conreptus,conrivium,conroboro,conruptio,conruptor,conruptum,conruptus,conruspor,consaepio,consaepto,consaluto,consarrio,consaucio,consavior,conscendo,conscindo,conscisco,conscreor,conscribo,consectio,consector,consenior,consensio,consensus,consentio,conseptum,conseptus,consequia,consequor,consequus,consertio,conservus,consessor,consessus,considero,considium,consiligo,consilior,consilium,consimile,consimilo,consiptum,consistio,consitura,consocius,consocrus,consolida,consolido,consoltum,consomnio,consonans,consortio,conspargo,conspelio,conspergo,conspicio,conspicor,conspisso,conspolio,conspurco,constagno,consterno,constitio,constituo,constrepo,constupeo,constupro,consuadeo,consuasor,consuavio,consubigo,consuefio,consuesco,consuetio,consuetus,consulans,consultor,consultum,consultus,consusrro,contabulo,contactus,contagium,contamino,contarius,contemero,contemplo,contempno,contempte,contemtim,contemtio,contemtor,contemtus,contentio,contentor,contentus,conterito,conterreo,contestis,contestor,contextim,contextio,contextor,contextus,conticium,contignum,contiguus,continens,continuor,continuum,continuus,contionor,contorreo,contortio,contortor,contortus,contracte,contracto,contrarie,contrecto,contribuo,contristo,contritio,contritus,contrunco,contuitus,contumulo,contuolus,convalido,convallis,convectio,convector,conventio,conventum,conventus,conversio,conversom,conversor,conversus,convertor,convescor,convestio,convicior,convicium,convictio,convictor,convictus,convisero,convitior,convitium,convivans,convivium,convolsio,convolsum,convolsus,convoluto,convulsio,convulsum,convulsus,cooperior,coopertus,cooptatio,copiarius,coppadium,copulatim,copulatio,copulator,copulatum,copulatus,coracesia,coracicum,coracicus,coracinus,corallium,corallius,corbicula,corchoros,corchorum,corchorus,corcillum,cordolium,coriaceus,coriarius,corinthos,corinthus,corneolus,cornicula,cornigera,cornualis,cornupeta,coronalis,coronamen,coronaria,coronatio,coronator,coronatus,coroneola,coroniola,coronopus,corporale,corporeus,correctio,corrector,correctus,correptio,correptor,correptus,corrivium,corroboro,corruptio,corruptor,corruptum,corruptus,corruspor,corsoides,corticeus,corticius,cortinale,cortinula,corydalus,coryletum,corymbias,corymbion,coryphion,cosmetica,cotangens,cothurnus,cotidiano,cottatium,cotyledon,crabattus,crassamen,crassator,crassendo,crassesco,crassitas,crastinum,crastinus,crataegis,crataegon,crataegos,crataegum,craterite,craticius,craticula,cratitius,creabilis,creativus,crebratus,crebresco,crebritas,crebriter,credencia,credentia,creditrix,cremaster,crementum,cremialis,crepatura,crepidula,cretaceus,cretarius,cribarius,cribellum,criminose,crispcans,crispulus,cristatus,criterion,criterium,crocallis,crocotula,crotaphos,croysidia,cruciamen,cruciatio,cruciator,cruciatus,crucifigo,crudarius,crustatum,crustosus,crustulum,crypticus,cubiculum,cubitalis,cucubalus,cucumella,cucurbita,cujusmodi,culcitula,culculare,culibonia,culiculus,cullearis,culliolum,culpandum,cultellus,cultratus,cultualis,cumatilis,cuminatus,cumininus,cummaxime,cumprimis,cumulatim,cumulatio,cumulatus,cunabulum,cunctalis,cunctamen,cunctatio,cunctator,cunctatus,cuncumque,cuniculum,cuniculus,cupiditas,cupienter,cuppedium,cupressus,curabilis,curatoria,curiatius,curionius,curriculo,cursorium,cursorius,cursualis,curvatura,cuscolium,cusculium,cuscussum,custodela,custodite,cyathisso,cybiarius,cychramus,cycnarium,cylindrus,cymatilis,cyminatum,cyminatus,cymininus,cynocauma,cynomazon,cynorroda,cynosurus,cynozolon,cypressus,cyprianus,cyrenaeus,daemonion,daemonium,dalmatica,damiurgus,damnifico,dampnatio,dampnatus,dapaticus,daphnitis,dealbatio,dealbator,dealbatus,deargento,deaurator,deauratus,debacchor,debilitas,debiliter,deblatero,debuccino,decalesco,decalifio,decalogus,decanatus,decanicum,decarmino,decempeda,decemplex,decendium,decennium,decennius,deceptrix,decerptor,decimalis,decimanus,decimatio,decipulum,decircino,decisivus,declamito,deconctor,decoramen,decoratio,decoriter,decortico,decretale,decrimino,decumanus,decunctor,decusatim,decusatio,dedecorus,dedicatio,dedicator,dedicatus,defaenero,defamatus,defanatus,defecatio,defectrix,defendito,definitio,definitor,definitus,defluvium,defricate,defulguro,defunctio,defunctum,defunctus,defututus,deglutino,degluttio,degrassor,degredior,degrunnio,degulator,dehonesto,deiformis,deinsuper,deintegro,dejeratio,dejunctus,dejuratio,delacrimo,delacrumo,delargior,delebilis,delegatio,delegator,delegatus,delenitor,deleritas,deletilis,delibatio,delibutus,delicatus,deliciola,deliculus,delimator,delimitus,delinitor,delinquio,deliquium,deliratio,deliritas,delitesco,delitisco,delocatio,delphinus,demadesco,demagogus,demarchia,demarchus,demeritum,deminutio,deminutus,demiurgus,democrata,demolitio,demolitor,demonstro,demoratio,demorsico,demorsito,demortuus,demugitus,demuneror,demurmuro,demutatio,demutator,dendritis,denecalis,denegatio,denicalis,denotatio,denotatus,dentaneus,dentarius,denudatio,denudator,deosculor,depalator,depaupero,depavitus,depeculor,depilatio,depilatus,deplector,depletura,depolitio,depondero,deponefio,depopulor,depositio,depositor,depositum,depositus,depostulo,depraedor,deprandis,depravate,depressio,depressus,depropero,depudesco,depuratio,depuratus,deputatio,deputatus,dequestus,derationo,derbiosus,derectura,derepente,derigesco,derivatio,derogatio,derogator,deruncino,descensio,descensus,descobino,descripte,desecatio,desenesco,desertrix,desidiose,designate,desipiens,desolatio,desolator,desolatus,despectio,despector,despectus,despeculo,desperate,despicans,despolior,despondeo,desponsio,desponsor,despotice,dessidens,destimulo,destinata,destinate,destinato,destricte,destringo,desudasco,desudatio,desuetudo,desultrix,desultura,desuperne,detepesco,deterioro,determino,detorqueo,detractio,detractor,detractus,detrectio,detrector,detumesco,deuterius,deveneror,devenusto,deverbero,deverbium,deversito,devexitas,devigesco,devinctio,devinctus,devirgino,devocator,devoratio,devorator,devortium,devotatio,dextralis,dextrator,dextratus,dextrinum,diacheton,diachylon,diachyton,diacisson,diacodion,diaconium,diadochos,diadochus,diaeresis,diagnosis,diagonios,diagonium,diagramma,dialectos,dialectus,dialeucos,diametros,diametrum,diamisyos,dianarius,diapanton,diaphonia,diapsalma,diarrhoea,diasostes,diastylos,diasyrmos,diathesis,diathyrum,diatretum,diatretus,diatritus,diazeuxis,dicabulum,dicacitas,dicaculus,dichalcum,dichoreus,dichronus,dicibulum,dictamnos,dictamnum,dictamnus,dictatrix,dictatura,dicterium,dictiosus,didasculo,didrachma,didragmon,dierectus,diezeuxis,diffensus,differens,differtus,diffibulo,difficile,diffidens,diffissio,diffiteor,difflatus,diffletus,diffluvio,diffluxio,diffringo,diffugium,diffumigo,digitalis,digitatus,digitulus,digladior,diglossos,dignanter,dignitoss,digrassor,digredior,digressio,digressus,digrunnio,dijugatio,dijunctim,dijunctio,dijunctus,dilabidus,dilargior,dilatatio,dilatator,dilatatus,dilibutus,diliculum,dilitatio,dilucesco,dilucidus,diluculat,diluculum,dimadesco,dimeterus,dimetiens,dimicatio,diminutio,dinummium,dinuptila,dioecesis,dioecetes,dionysius,dioptrica,diphryges,diplasius,dipondius,diptychon,diptychum,dirationo,directura,diremptio,diremptus,diribitio,diribitor,dirivatio,discantus,disceptio,discessio,discessus,discidium,discipula,discissus,discordia,discrepat,discretio,discretor,discretus,discrimen,discrucio,disculcio,discursus,disgratia,disjugata,disjuncte,dismembro,dispartio,dispector,dispersim,dispersio,dispertio,displiceo,displodeo,displosio,dissaepio,dissectio,disseisio,dissemino,dissensio,dissensus,dissentio,dissidium,dissimulo,dissuadeo,dissuasio,dissuasor,dissuesco,distantia,distentio,distentus,distichon,distichos,distichus,distimulo,distinguo,distortus,distribuo,districte,distringo,disyllaba,ditonicus,diurnitas,diuturnus,diverbero,diverbium,divinatio,divinitas,divinitus,divortium,docibilis,docilitas,dociliter,dogmatice,dogmatizo,dolabella,dollarium,dolorosus,dolositas,domabilis,dominatio,dominator,dominatus,dominicum,dominicus,domiporta,domuncula,donatista,donativum,dorsualis,dorycnion,doxologia,dracontia,dubitatio,duellator,duellicus,dulcidine,dulciolum,dulcitudo,dupleitas,dupondius,dupundius,dynamicus,dyspepsia,eblandior,eborarius,eburarius,ecflictim,ecliptica,ecnephias,edentulus,edictalis,edisserto,editicius,edormisco,educatrix,effective,effectrix,efferatus,efficacia,efficiens,efflagito,efflictim,effluvium,effugatio,elanguens,elasticus,elaterium,electivus,electriso,electroda,elefantus,eleganter,elegantia,elementum,elemosina,elemosyna,elitismus,elleborum,elleborus,ellipsois,elucubror,elutorius,emarcesco,emendatio,eminenter,eminentia,emphatice,empiricus,emplastra,emplastro,empticius,emundatio,enarratio,encaenium,encolpium,encomboma,enerviter,enormiter,enthymema,ephemeris,ephippium,ephoebias,ephphatha,epicinium,epiclesis,epicrocus,epicureus,epicurius,epicyclus,epidipnis,epigramma,epilepsia,epimedion,epimenium,epinicion,epinicium,epinikion,epiphania,epiphonus,epiredium,episcopus,epitheton,epitonium,epitritos,epitritus,epizootia,equitatio,equitatus,erinaceus,erinacius,erithacus,erraticus,erythinus,essential,essuriens,estimatio,estuanter,ethologia,ethologus,etiamnunc,eugenicus,euoeeuhoe,euphrates,eurocrata,euronotus,evidenter,evidentia,evitandus,evulgatio,exaltatio,examussim,exanthema,exardesco,exauctoro,exaudiens,exauditio,exauditor,excalceor,excambium,excardino,excellens,excerebro,excerptio,excerptum,excipulum,exclusive,excubitor,excuratus,excusatio,execratio,executrix,exemplare,exequiale,exercitor,exercitus,exhaustus,exhibitio,exigentia,exiguitas,eximietas,exitialis,exitiosus,exorcista,exornatio,exornatus,exosculor,expavesco,expectoro,expeditio,expeditus,experiens,expetesso,expletium,explicite,expolitor,exporrigo,expositio,expostulo,expressim,expressio,expressus,expultrix,exsanguis,exscidium,exsecutio,exsecutor,exsequior,exspatior,exstimulo,exstinguo,exsuscito,extaticus,extentero,exteritio,extermino,extimesco,extinctio,extorqueo,extractio,extractum,extraneus,extrarius,extructio,exultatio,fabulatio,fabulator,fabulosus,facilitas,faciliter,facilumed,facticius,factiosus,factitius,faenebris,faeniseca,faetutina,falcarius,fallacies,falsarius,falsifico,famelicus,familicus,famulamen,famularis,famulatus,fanaticus,farinatus,fascismus,fastidium,fastigium,fastuosus,fataliter,faticanus,faticinus,fatidicus,fatigatio,fatigatus,fatiloqua,febricito,febricula,felicitas,feliciter,fellatrix,feminalum,femininus,femoralum,feniculum,fenisicia,feoffator,feoffatus,feraliter,fericulum,fermentum,ferocitas,ferociter,ferraiola,ferrarius,ferrumino,festinato,festucula,fetificus,feturatus,fibiculaa,ficticius,fictitius,ficulneus,fideiubeo,fidelitas,fideliter,fidicinus,figmentum,figuratio,filicatus,finalitas,finaliter,finitimus,finitumus,firmarius,firmitudo,flabellum,flaccesco,flaccidus,flagellum,flagitium,flaminica,flaminium,flaminius,flammidus,flammifer,flammiger,flexuosus,flosculus,fluvialis,foetifico,foetuosus,foetutina,forabilis,formatrix,formonsus,fornacula,fortassis,fortifico,fortitudo,fortuitum,fortuitus,fortunate,fortunium,fossicius,fraternus,fraxineus,frequento,friguttio,fringulio,frisiatus,fritillus,frixeolum,frixorium,frondator,frondesco,frondifer,frondosus,fructifer,frucuosus,frumentor,frumentum,fruniscor,frustatim,frutectum,fugitivus,fulgenter,fullonica,fullonium,fulmentum,fulmineus,fumaculum,fumificus,fundaitor,fundatrix,funiculus,fuscinula,galanthus,galaticus,galaxicus,galbeolus,galeritus,galilaeus,gallicula,gallienus,gargarizo,gastritis,gaunacuma,gausapina,gelasinus,geminatio,gemmarius,generalis,generasco,generatim,generatio,generator,generosus,geneticus,genetivus,genitalis,geochemia,geometres,geometria,georgicus,geriatria,gerundium,giganteus,glacialis,gladiator,gladiolus,glandifer,glareosus,gleucinus,gloriatio,glorifico,gloriosus,glosarium,glyconius,gordianus,gossypium,grabattus,gradarius,gradualis,graecatus,graecisso,graecitas,graeculus,grallator,gramineus,granarium,grandesco,grandifer,grandinat,granditas,granditer,graphicus,grassator,gratanter,gratifico,gratiosus,gratitudo,gratuitas,gratuitus,graulatio,gravanter,gregarius,gremialis,grunnitus,gubernium,gubernius,gulielmus,gymnasium,gynaeceum,gynaecium,gynaecius,habilitas,habitatio,habitator,haceldama,hadrianus,haedillus,halucinor,hamadryas,harenaria,harenatio,harenatum,harenatus,harenosum,harenosus,haricolor,harmonica,harmonice,harmonium,harpastum,hasdrubal,hebdomada,hebraicis,hebraicus,hecatombe,hedychrum,helluatio,helvetius,hemiolios,hepatitis,herbaceus,herbagium,hereditas,hereticus,hermetice,herniosus,hesitatio,hesperius,hesternus,hexagonon,hexagonum,hexameter,hierarcha,hieremias,hieronica,hierurgia,hilaresco,hilarisco,hilaritas,hilarulus,hinnuleus,hirneacus,hirneosus,hirniacus,hirniosus,histricus,histronia,hodiernus,hoedillus,holoverus,homologus,honoratus,horrendus,horripilo,hortensis,hospitale,hospitium,hostiaria,humanista,humanitas,humaniter,humanitus,humerulus,humiditas,humilitas,humiliter,hundredum,hydraulus,hydromeli,hymenaeos,hymenaeus,hymnarium,hypallage,hyperbola,hyperbole,hypnotizo,hypocrita,hypogaeum,hypomnema,hypotheca,hypotheco,ichneumon,iconismus,ictericus,idealista,identicus,identidem,identitas,ideologia,idolatres,idolatria,igniculus,ignobilis,ignominia,ignoratio,illacrimo,illatebro,illatinus,illecebra,illegalis,illepidus,illibatus,illicitus,illucesco,illustris,illyricum,imitatrix,immadesco,immanitas,immaturus,immeritus,immetatus,immistrum,immobilis,immodeste,immodicus,immolitus,immulatio,immunitas,immunitus,immurmuro,immusulus,immutatio,impacatus,imparatus,impatiens,impavidus,impeditus,impendium,imperator,imperatum,imperitia,imperitus,impervius,impetitio,implacito,implicite,impluvium,impolitus,impositio,impraegno,impransus,impressio,imprisono,impropero,improsper,imprudens,impudicus,impunitas,impunitus,impuratus,impuritas,impuritia,imputatio,imputatus,inadustus,inalbesco,inamarico,inamoenus,inanimale,inanimans,inaquosum,inaquosus,inardesco,inargento,inauditus,incaeduus,incalesco,incanesco,incarcero,incardino,inceleber,incenatus,incendium,incipisso,incitatio,incitatus,incivilis,inclamito,inclemens,incolatus,incolomis,incolumis,incommode,incommodo,incomptus,incongrue,increpito,incubatio,incumbero,incuratus,incuriose,incursito,indagatio,indagator,indagatus,indebitus,indecoris,indecorus,indevotio,indigenus,indiserte,indivisio,indivisus,indocilis,indolesco,indomitus,indotatus,indulgens,induresco,industria,industrie,inefficax,inelegans,inemorior,inerudite,inexcitus,infacetia,infacetus,infantula,infarctus,infaustus,infernale,inficetia,infidelis,infinitas,infinitio,infinitus,inflectus,infractus,infrigido,infrunite,infucatus,ingemesco,ingemisco,ingeniose,inglorius,ingluvies,ingratiis,ingredior,ingressus,ingurgito,inhabilis,inhaeredo,inhalatio,inhianter,inhoneste,inhonesto,inhonoris,inhonorus,inhumanus,inhumatus,inidoneus,iniquitas,initialis,injuratus,inlacrimo,inlagatio,inlagatus,inlecebra,inlepidus,inlibatus,inlicitus,inlustris,inmanitas,inmeritus,inmobilis,inmodicus,inmutatio,innabilis,innotesco,innovatio,innovatus,innubilus,innumerus,inobaudio,inoblitus,inoboedio,inoboedus,inobrutus,inopinans,inornatus,inpeditus,inpendium,inpressio,inpropero,inprudens,inpuritas,inpuritia,inquantum,inquietus,inquilina,inrigatio,inritator,inrogatio,inrumator,insaniter,insenesco,insidiose,insipidus,insipiens,insitivus,insolesco,insolidus,insolitus,insomnium,insopitus,inspectio,insperans,insquequo,instanter,instantia,instimulo,insubidus,insulanus,insulinum,insusurro,intabesco,integrale,intellego,intelligo,intepesco,intercalo,intercedo,intercido,intercino,interdico,interdius,interfluo,intericio,interitus,interlino,interludo,intermico,interpolo,interpono,interpres,interrado,interrete,interrogo,interseco,intersero,interulus,interviso,intervolo,intestina,intorqueo,introcedo,introduco,introitus,introrsum,introrsus,intuitive,intumesco,inundatio,inurbanus,inusitate,invalesco,invalidus,inventrix,investigo,invidiose,inviolate,inviolaus,invisibil,invitatio,iracundia,iracundus,irenaceus,irenarcha,irenismus,irrimator,irritamen,irritatio,irritator,irrumator,ischiacus,islamicus,isocheles,isosceles,israelita,israheles,itinerans,jactanter,jactantia,jaculator,jamjamque,jampridem,janthinus,januarius,jejunitas,jerusalem,jocularis,jubelaeus,jubilaeus,jubilatio,jubilatus,judaismus,judicalis,judicatio,juniperus,jurgatrix,juridicus,juscellum,justifico,justitium,juvenalis,juvenesco,juvenilis,kadamitas,labefacio,labefacto,laborator,laborifer,laboriose,labruscum,laceratio,lacrimula,lactarium,laculatus,lacunosus,laetifico,laetrosum,laevorsum,laevorsus,laguncula,languesco,languidus,lanificus,laophoron,lapathium,lapidaris,lapidesco,lapidosus,laqueatus,lassitudo,lateralis,lateramen,latibulum,latinista,latinitas,laureatio,laureator,laureatus,lavabilis,lavandula,lavatrina,laxativum,lazulinus,lebnitica,lecticula,legalitas,legirupio,legitimus,leguleius,lemniscus,lenocinor,lenticula,lentiscum,lentiscus,lentitudo,leopardus,leporinus,lethargus,leucaemia,leucaspis,leunculus,leviathan,leviculus,levigatus,leviticus,lexicalis,libamenum,libanotis,liberalis,liberatio,liberator,libertina,libidinor,librarium,librarius,libricola,libripens,liburnica,licitatio,lignarius,ligneolus,ligustrum,lilacinus,limatulus,limitaris,limitatio,linguista,lingulaca,linguosus,linteamen,linteatus,linteolum,litigator,litoralis,litterate,litterula,lividulus,localiter,loculatus,loculosus,locupleto,loliarius,londinium,londonium,longaevus,longinque,longinquo,longitudo,longurium,longurius,loricatus,lubidinor,lucifluus,lucifugus,lucinaria,lucrifuga,luctuosus,luculenus,ludibrium,ludificor,lumbarium,luminosus,lusciosus,lustralis,lycaonice,lycaonius,lymphatus,lyncurion,lyncurium,lyncurius,maccarono,macedonia,maculosus,madefacio,maenianum,maestiter,maestitia,magdalena,magnesium,magnetizo,magnifice,magnifico,magnitudo,magnopere,magnufice,magnufico,magonicum,majoritas,majuscula,majuscule,malachita,maledicax,maledicus,malefacio,maleficio,maleficus,malefidus,malevolus,malificus,malignans,malitiose,malivolus,malleator,malleatus,malleolus,malluvium,malvaceus,mancipium,mancupium,mancussus,mandibula,mangifera,manicatus,manifesto,manipulus,mansuefio,mansuesco,mansuetus,mantelium,mantellum,mantilium,manubrium,manucapio,manufesto,manumitto,maranatha,margarita,maritimus,maritumus,marmelata,marmoreus,marsupium,martialis,martyrium,marxianus,marxismus,massarius,masterbio,masterbor,mastichum,masturbor,matercula,matertera,matricida,matricula,maturatio,maturesco,maturitas,matutinus,mauricius,mausoleum,maximitas,mazonomon,mazonomus,mazzerius,mediatrix,medicamen,medicinus,mediocris,meditatio,mehercule,melanurus,melilotos,melilotum,melimelum,melodrama,melongena,membratim,mementote,memoriale,memoriola,memoriter,mendacium,mensarius,menstruum,menstruus,mercantia,mercatura,mercedula,mercurius,mestitius,mesuagium,metaphora,methodice,methodium,metonymia,microbium,miliardum,miliarium,miliarius,milifolia,militarie,militaris,militiola,millensim,millensum,millepeda,minaciter,minaretum,mineralis,miniatura,ministral,minoritas,minuscula,minutalis,minutatim,mirabilis,miraculum,miraculus,mirificus,miscellus,miseratio,miserator,miseresco,missicius,misterium,misticius,mistitius,mixticius,mixtitius,mixtorius,mobilitas,mobiliter,modalitas,moderamen,moderatio,moderator,moderatus,modulamen,modulatio,modulator,moestiter,moestitia,mollities,mollitudo,molluscum,momentana,monarchia,monetalis,monoceros,monodicus,monogamia,monometer,monosolis,monticola,montuosus,moralitas,moraliter,morbillus,morchella,morigeror,morigerus,morologus,morositas,mortarium,mortifico,mucinnium,mulctatio,mulctrale,mulgarium,muliebris,multicium,multicius,multiplex,multitudo,mundialis,mundities,munificus,murelegus,murilegus,muscarium,muscipula,mustaceum,mustaceus,mutabilis,muttonium,myristica,myropoeus,myrtillus,mysterion,mysterium,mysterius,nanciscor,narcissus,natatilis,nativitas,naturalis,nauarchia,nauarchus,naucleria,nauclerus,naufragus,navigatio,navmachia,nazaraeus,nazarenus,nebulosus,necessest,neclectus,neclegens,necopinus,nectareus,negativus,neglectim,neglectus,neglegens,nemoralis,nemorosus,neophytus,neoplasia,nequaquam,nequiquam,nequities,neutiquam,nichelium,nicomedia,nicotinum,nidificus,nigrities,nihilitas,ninevitus,ninivitus,nivomagus,nobilitas,noctiluca,nocturnus,nominatim,nominatio,nominatus,nonaginta,nonnullus,nosocomus,nostalgia,notabilis,notaculum,novissime,novomagus,nubilosus,nuclearis,nugacitas,numeratio,numerator,numerosus,nummarius,nunciatio,nunciator,nunnullus,nuntiatio,nuntiator,nuptialis,nutabilis,nutricius,nutricula,nutrimens,obaeratus,obductico,obduresco,obeliscus,objrascor,obligatio,obliterus,oblitesco,oblittero,oblocutor,obmurmuro,obmutesco,oboediens,obrigesco,obryzatus,obscaenum,obscaenus,obsecundo,obsequens,obsequium,observito,obsetrico,obsolefio,obsolesco,obsoletus,obsonator,obsonatus,obstantia,obstetrix,obstinate,obstitrix,obstringo,obtempero,obtenebro,obticesco,obtorqueo,occecatio,occlamito,occupatio,occupatus,ochraceus,ocissimus,octaedros,octodecim,octoginta,octoiugis,odollames,oecologia,oeconomia,oeconomus,oleaginus,oligarcha,olitorius,olivaceus,omnigenus,omnimodis,omnimodus,omnituens,omnivagus,omnivolus,omphacius,onerarius,ontologia,onychinus,operarius,operculum,opiniosus,oportunus,oppidanus,oppidulum,opportune,oppositus,oppressio,oppressus,opscaenum,opscaenus,opsecundo,opsequium,opstetrix,opstitrix,optabilis,optempero,optimista,opulentia,opulentus,opusculum,oratorius,orbiculus,orbitosus,orchestra,ordeaceus,ordeacius,ordearius,ordiaceus,ordiacius,ordiarius,ordinatim,ordinator,ordinatus,organalis,organicos,organicus,organista,orgetorix,oricalcum,orificium,orthodoxe,oscitatio,osculatio,ossiculum,ossifraga,ossuarium,ossuarius,ossuculum,ostiarium,ostiarius,ostreosus,oxygenium,pabulatio,pabulator,paciencia,pacienter,pacificus,paediater,paenitudo,paganicus,paganitas,palaestra,palatinus,palinodia,palliatus,palliolum,palmarium,paludatus,paludosus,pampineus,pandectes,panegyris,pannuceus,pannucius,pantomima,papyrifer,parabilis,paradisus,paradoxum,paragauda,paralysis,parasceve,parasitus,parentale,parhypate,parietina,parilitas,parisumus,parricida,parthicus,particeps,participo,particula,partitura,paschalis,pastillus,pastinaca,pastorius,patefacio,patibilis,patibulum,patienter,patientia,patinatio,patinator,patricida,patricius,patruelis,paulisper,paullatim,paullulum,paullulus,paulumper,pauperies,paupertas,pauxillum,pauxillus,pavefacio,peccatrix,pecorosus,pecuarium,pecuarius,peculator,peculatus,pedicator,pediculus,pedisecus,pedisequa,peditatus,pejeratus,pejerosus,pejuratus,pejurosus,pelicatus,pellectio,pelliceus,pellicius,pellicula,pelluceeo,peltastes,penatiger,penecoste,penetrale,peniculus,penitrale,penuarius,peraccedo,peracesco,peracutus,perambulo,peramplus,perarduus,peraridus,perbeatus,percautus,percenseo,percontor,percursio,percussio,percussor,percussus,perdignus,perditrix,perductor,peremnium,perequito,perfacete,perfacile,perfectio,perfectus,perfossor,perfringo,perfugium,perfungor,pergaudeo,pergratus,pergravis,perhorreo,peribolus,periculum,peripsema,peripsima,periratus,periurium,perlaetus,perlibens,perlongus,perlustro,permagnus,permaturo,permetior,permisceo,permissio,permissus,permistus,permities,permixtio,permixtus,permulceo,permultus,pernicies,pernimius,pernumero,peronatus,peroptato,peroratio,perparvus,perpastus,perpaucum,perpaucus,perpaulum,perpauper,perpetior,perpetuus,perplexor,perplexus,perpotior,perpugnax,persalsus,persaluto,perscindo,perscitus,perscribo,persector,persegnis,persequor,persevero,persolata,perspecto,perspergo,perspicax,perspicio,perspicue,persterno,persuadeo,pertaedet,pertempto,pertenuis,perterreo,pertolero,perturpis,perurgueo,perversus,pervigilo,pervolito,pervoluto,pervorsus,pestilens,petasatus,petiturio,petoritum,petroleum,phalarica,phantasia,phantasio,phantasma,pharmacus,phiditium,philautia,philippus,philitium,philomela,phloginos,phonascus,phonetica,phrenesis,phthongus,physiculo,piamentum,pigmentum,pigrities,pilleolum,pilleolus,pinguesco,pinoteres,piperatum,piperatus,piperitus,piraticus,piscarius,pistaceus,pistacium,pistillum,pistolium,pistrilla,pistrinum,pithecium,pittacium,planities,plantaris,plantatio,plascisco,plasmatio,plasmator,plasticus,plaustrum,plebecula,plebicola,plenarius,plenitudo,plerumque,plicatrix,plumarius,plusculus,pluvialis,pluviosus,pneumonia,poenicans,poeniceus,poenitudo,polemonia,politicus,pollentia,polliceor,polluctum,polluctus,polybolum,polymitum,polymitus,polysemus,polytheus,pomoerium,pompatice,pompelmus,pompholyx,poppysmus,popularis,populatio,populator,populatus,populifer,populista,porcellio,porcellus,porcillus,porphirio,porphyrio,porrectio,porrectus,portarius,portentum,porthmeus,porticula,portorium,portulaca,portuosus,positivus,possessio,possessor,postergum,posterius,postestas,posthabeo,posthumus,postilena,postmodum,postnatus,postremum,postremus,postridie,potissime,potissume,practicus,praealtus,praebitor,praecanus,praecaveo,praecello,praecento,praecerpo,praecingo,praecipio,praecipue,praecisus,praecludo,praecluis,praeconor,praecurro,praecutio,praedator,praedisco,praeditus,praedives,praedurus,praefatio,praefecus,praeferox,praeficio,praefinio,praefloro,praefodio,praegnans,praegravo,praegusto,praehendo,praejaceo,praejacio,praejicio,praelabor,praelambo,praeluceo,praelusio,praemando,praemetuo,praemineo,praemitto,praemoneo,praemoveo,praemunio,praenarro,praeniteo,praenomen,praenosco,praenotio,praepando,praepedio,praeporto,praeputio,praeradio,praeripio,praerumpo,praesaepe,praesagio,praesagus,praescius,praesente,praesento,praesepes,praesepio,praesideo,praestans,praestino,praestruo,praesulto,praesutus,praetendo,praetento,praetepeo,praeterea,praetereo,praetexta,praetimeo,praeustus,praevaleo,praevehor,praevenio,praeverro,praeverto,praevideo,praevitio,praevorto,pratensis,pravicors,preambula,precarius,predictus,predignus,preexisto,preludium,premagnus,prensatio,presbyter,presbytia,presignio,pressulus,pretiosus,pridianum,pridianus,primaevus,primarius,principio,principor,prioratus,priorissa,prioritas,pristinus,priusquam,privignus,proagorus,proavitus,proboscis,probrosus,processus,proclivis,proclivus,procoeton,proconsul,procresco,procursus,procurvus,prodigium,proditrix,prodromus,productio,productum,profectio,profectus,professio,profestus,proficuus,profisceo,profiteor,profundum,profundus,progenero,progenies,prognatus,programma,programmo,prohemium,projectio,projectus,prolapsio,proloquor,proluvier,proluvies,promachus,promereor,prominens,promiscus,promissio,promissor,promissum,promissus,promulsis,promutuus,proneptis,pronuntio,prooemior,prooemium,propediem,propemodo,propendeo,propensio,propensus,prophetes,prophetia,propinquo,propitius,proportio,propritim,propterea,propudium,propulluo,prosaicus,proscindo,proscribo,prosectum,proselyta,prosentio,prosequor,proseucha,prospecto,prosperus,prospicio,prosterno,prostituo,prosubigo,protectio,protector,proteinum,proterreo,protervus,protestor,prothesis,prothyrum,protritus,protropum,protubero,provectio,provectus,proventus,provincia,provolvor,proxeneta,proximior,prudenter,prudentia,pruinosus,prunitius,prytaneum,psalmista,psalmodia,psephisma,psittacus,psychicus,psychosis,pterygium,pudicitia,puellaris,puerperus,pugilatio,pugilator,pugilatus,pugillare,pulicaris,pullarius,pulmentum,pulmoneus,pulvereus,pulverizo,pulvillus,pumicosus,punctatus,punicanus,puritanus,purpureus,purulente,pyrenaeus,pyrethrum,pyrobolum,pyrobolus,pyrrhicha,pyrrhiche,pyrricius,quacumque,quacunque,quadratum,quadratus,quadrimus,quadrupes,quaesitio,quaesitum,quaesitus,quaestura,qualifico,quamlibet,quamobrem,quandoque,quantitas,quantulum,quantulus,quantuvis,quartanus,quarzicus,quasillum,quasillus,quassatio,quaternio,quercetum,quicumque,quicunque,quidditas,quinarius,quinctius,quindecim,quingenti,quintanus,quintilis,quirinale,quocumque,quocunque,quotannis,quotlibet,racemifer,radaricus,radiatrum,radicalis,radicatus,radicitus,raedarius,rapacitas,rapiditas,rarefacio,rassismus,rastellus,ratihabeo,realismus,reatitudo,receptrix,recidivus,reciproco,recitator,reclamito,recolligo,recreatio,rectifico,rectitudo,recubitus,recusatio,redambulo,redemptio,redemptor,redivivus,redomitus,reflagito,refocillo,reformido,refractio,refrigero,refutatio,refutatus,regificus,reglutino,regnatrix,regredior,regressus,regularis,regulatim,relativus,relegatio,religatio,religiose,relucesco,remigatio,remoramen,remuneror,remurmuro,renidesco,renovamen,renovatio,repagulum,reparatio,repetitio,repetitor,repetitus,repetunda,repositus,reppertum,reprensio,repressor,reputatio,requiesco,requietio,requietus,requirito,resanesco,reseantia,resinaria,resinosus,resipisco,resolutio,respectus,respersio,respondeo,responsor,responsum,resticula,restinguo,restringo,resupinus,resuscito,retiarius,reticulum,reticulus,retorqueo,retractus,retrorsum,retrorsus,revalesco,revelatio,reverbero,reviresco,revivisco,revocamen,revolutio,rhagadium,rhetorice,rhomphaea,ridiculum,ridiculus,rinoceros,risibilis,rivalitas,romanicus,rostratus,rubefacio,rudiarius,ruminalis,rutabulum,rythmicus,sabbatizo,saccharum,sacrarium,sacricola,sacrifico,sacristia,saeclaris,saepicule,saffranum,sagacitas,sagapenon,saligneus,salmoneus,saltatrix,saltuosus,salutaris,salutatio,salutator,salutifer,salvifico,samarites,samaritis,sampsucum,sanabilis,sanctitas,sandalium,sandapila,sanqualis,sapiencia,sapienter,sapientia,saponatum,sapphirus,sapphyrus,saraballa,sarabalum,sarabarum,sarcasmos,sarcinula,sardonius,sarmentum,satanicus,satureium,saturitas,saturnali,sauciatio,saxificus,scabellum,scabillum,scabiosus,scabritia,scabrosus,scaenalis,scaenicus,scamillus,scammonea,scammonia,scandalum,scapulare,scarabeus,scaturigo,scaturrio,scelestus,scellinus,scepticus,scholaris,scholarus,scholicus,sciniphes,scintilla,scintillo,sciscitor,scissilis,sclopetum,scorbutus,scortator,scriblita,scriptito,scriptura,scripulum,scrofinus,scruposus,scrupulum,scrupulus,scrutamen,scrutaria,scrutatio,scrutinor,sculponea,scutarior,scutarius,scutellum,scutulata,sebboleth,secespita,sectarius,secubitus,secularus,securifer,securiger,securitas,sedulitas,segmentum,segnities,seiugatus,seiunctim,seiunctio,sellarium,sellarius,semeitica,semestris,semetipse,semicaper,semicolon,semicorda,semiermis,semiermus,semihians,semilacer,semiliber,seminator,seminudus,semirasus,semirutus,semitalis,semitonus,semiustus,semivivus,senaculum,sensifico,sensorius,sensualis,sententia,sententio,separatim,separatio,sepiaceus,seplasium,sepositus,september,septuplum,septuplus,sepulcrum,sepultura,sequentia,sequester,sequestra,sequestre,sequestro,serenitas,sericaria,sericatus,seriosius,serjantia,sermonizo,serotinus,serpyllum,servatrix,servianus,servitium,servitudo,sescuncia,sessorium,severitas,seviratus,sexaginta,sexprimus,sextarium,sextarius,sexticeps,siccaneum,siccaneus,sicilicus,sicumquam,signanter,significo,silentium,silvester,silvestre,silvicola,similiter,simphonia,simpuvium,simulamen,simulatio,simulator,singultim,singultio,singultus,sitarchia,smaragdos,smaragdus,sobrietas,sociennus,sodalitas,sofistice,soldurius,solearius,solidesco,soliditas,solitaria,solivagus,sollemnis,sollerter,sollertia,sollicite,sollicito,solummodo,solutilis,sonabilis,sonoritas,sophistes,sophocles,soporifer,sorbitium,sordesceo,soricinus,sotularis,spacellus,spartanus,spasticus,spatialis,spatiosus,specialis,specillum,speciosus,spectamen,spectatio,spectator,sphaerion,sphaerois,sphaerula,sphincter,spinacium,spissesco,spoliatio,spoliator,spondulus,spondylus,sponsalis,spontalis,spontanee,sportella,spurcamen,spurcitia,squalidus,squamiger,squamosus,stagnosus,stamineus,statarius,statuaria,stelephur,stellatus,stellifer,stelliger,stercolum,sterilizo,stibadium,stimuleus,stomachor,stomachus,stomatice,stragulum,stragulus,strangulo,strategus,strepitus,strictura,stridulus,strigilis,strigosus,strophium,structura,strumosus,strutheus,struthion,studiosus,stultitia,stylobata,suasorius,suaviatio,suaviolum,subaccuso,subacidus,subadjuva,subalaris,subamarus,subcrudus,subdialis,subductio,subgestio,subinanis,subinvito,subiratus,subitatio,subjectio,subjector,subjectus,sublestus,sublicius,sublingio,subllabor,subluvies,submedius,submissus,subnascor,subnervio,subolesco,subraucus,subremigo,subreptio,subreptum,subringor,subscribo,subsentio,subsequor,subsidium,substerno,substituo,subsultim,subtegmen,subturpis,suburbium,subvectio,subvectus,subvereor,subversio,succeptor,successio,successor,successus,succiduus,succresco,succussus,succustos,sufflamen,sufflatum,sufflavus,suffragor,suffringo,suffugium,suffulcio,suffumigo,suffuscus,suggestio,suggestus,suicidium,sulpicius,sulpureus,summissio,summissus,summopere,superaddo,superator,superdico,superduco,superfluo,superingo,superlino,supernato,superpono,supersido,superstes,supertego,supervado,supervivo,supervolo,suppalpor,suppedito,suppetior,supplanto,supplosio,suppromus,supradico,surdaster,surreptio,surreptum,suscenseo,susceptio,susceptor,susicivus,suspectus,suspensus,suspirium,susurrium,sycaminon,sycaminos,sycaminus,sycomoros,sycomorus,syllepsis,syllogizo,symbiosis,symbolizo,symmetria,sympathia,symphonia,synaliphe,syngrafus,syngrapha,synonymum,synthesis,syracuses,tabacinus,tabefacio,tabidulus,tabificus,tabulatio,tabulatum,tabulatus,tabulinum,taetricus,tantillus,tantisper,tantopere,tantundem,tarandrus,taraxacum,tarpezita,tartareus,taxiraeda,tebboleth,technicus,tectorium,tectorius,tegmentum,telecopia,telephono,televisio,televisor,telonicus,temeritas,temperans,temperies,tempestas,temptamen,temptatio,temptator,tenacitas,tenaculum,tendicula,tenerasco,teneritas,tenorista,tentorium,tepefacio,ternarius,terrester,terrifico,terrigena,terrigina,tertianus,tertiatus,testaceus,testacius,testatrix,tetanicus,tetrardos,tetrardus,textrinus,thecuites,theologia,theologus,theorices,theoricus,thesaurus,thraecius,thymelaea,tiberinus,tignarius,tigurinus,timoratus,tinctilis,titubatio,togatulus,toleratio,tolutilis,tomaculum,tonitruum,tonsorius,tonstrina,tonstrlna,toparchia,topiarius,toreutice,tormentum,tornatura,torquatus,tortitudo,tortuosus,totalitas,totaliter,trabeatus,tractalis,tractatio,tractatus,traductio,traductor,tragoedia,tragoedus,trajectio,trajectum,trajectus,transabeo,transcido,transduco,transenna,transfero,transfigo,transfluo,transforo,transfuga,transicio,transilio,transitio,transitus,translato,transmuto,transnato,transpito,transtrum,transulto,transveho,transvolo,transvoro,travectio,traversus,tremendus,trepidans,trestorno,tribrevis,tribualis,tricensim,tricensum,tricorpor,tricuspis,triduanus,triennium,trientius,trieteris,trifariam,trifarius,trifaucis,triformis,trigensim,trigonium,trilibris,trimestre,trimetrus,trinarius,trineptis,triobolus,triplaris,tripudium,tristegum,tristitia,trisulcus,triticeus,triticius,triumphal,triumphus,trochaeus,trochilea,troiugena,trophaeum,tuissatio,tullianum,tullianus,tumbarius,tumefacio,tumulosus,tumultuor,tunicatus,turbineus,turibulum,turilegus,turpitudo,turricula,tussicula,tussilago,typologia,typotheta,tyrannice,ubicumque,ubiquaque,ulcerosus,ullatenus,ultimatim,ultroneus,umbilicus,umbraclum,unanimans,unciarius,uncinatus,uncinulus,undisonus,undulatio,unguentum,unianimis,unianimus,unicaulis,unicornis,unicuique,uniformis,universim,universus,univirius,urbanitas,urbicapus,urticaria,usurarius,usurpatio,utensilis,utillimus,utlagaria,utlagatio,utlagatus,utquomque,utralibet,utriculus,utrobique,utrolibet,utrubique,vaccinium,valefacio,valeriana,valorosus,vanidicus,vanilleus,vapulatio,vapulator,variantia,varicosus,vassallus,vastities,vaticinor,vaticinus,vectorius,vegetalis,vegetatio,vegrandis,vehiculum,velificor,velitaris,velitatio,velivolum,velivolus,velocitas,velociter,venabulum,venalitas,venaticus,venditrix,veneficus,venenatus,venenifer,veneratio,venerator,vennucula,ventagium,veprecula,veraciter,verbeneca,veretilla,vergilius,vericulum,veridicus,versifico,vesaniens,vestigium,veteranus,veterasco,veterator,veteresco,veterinus,vexillium,viatorius,vicecomes,vicinalis,vicinitas,victualis,videlicet,vigoratio,vigoratus,vigorosus,vilicatio,vilipendo,violaceus,violacium,violarium,violenter,violentia,violentus,viperinus,virgineus,virginius,virgultum,viriditas,viriliter,virtualis,visibilis,visificus,visitatio,visitator,vispellio,vitabilis,vitaliter,vitaminum,vitellius,vitigenus,vitisator,vitriolum,vitulamen,vitulinus,vivificus,viviradix,vocabulum,vocativus,vociferor,volaticus,volatilis,volturius,volubilis,voracitas,vulpecula,vultuosus,vulturius,vulturnus,wadiarius,warantizo,zelotypia,zelotypus,zeroticus,zmaragdos,zmaragdus,zoophorus,zothecula,abagmentum,abascantus,abbatialis,abdicative,abdicatrix,abecedaria,abhorresco,abhorridus,abietarius,ablactatio,abligurrio,abnormalis,abolefacio,abominatio,abominatus,abominosus,abrelictus,abrenuntio,abscondite,absenthium,absentivus,absinthium,absinthius,abstractio,abstractus,absurditas,absynthium,abundanter,abundantia,academicus,acalanthis,acanthicos,acanthinus,acatholice,accedenter,accedentia,acceptator,acceptatus,accersitio,accersitor,accersitus,accessorie,accidentia,acclamatio,acclivitas,accognosco,accommodus,accomodate,accumulate,accusatrix,acerbitudo,acescentia,acetabulum,achaemenis,acharistos,acharistum,acistarium,acquisitio,acquisitus,acrifolium,acroaticus,acrocorium,acrolithos,acrolithus,acropodium,acroterium,acrufolius,actiuncula,actualitas,actualiter,actuariola,actuositas,acuminatus,acupictura,acyrologia,acysterium,adaequatio,adaequatus,adamanteus,adaptertus,adariarius,adcedenter,adcedentia,adcessitio,adcessitor,adcessitus,adclamatio,adclivitas,adcognosco,adcommodus,adcumulate,additicius,addititius,addormisco,adfectatio,adfectator,adfectatus,adfectiose,adfectuose,adfirmatio,adfirmator,adflagrans,adflictrix,adfluenter,adfluentia,adfricatio,adgratulor,adgravesco,adgressura,adhaeresco,adhaesiona,adhaesivus,adhortamen,adhortatio,adhortator,adhortatus,adiaphoros,adimpletio,adimpletor,adincresco,adinspecto,adinventio,adinventor,adinventum,adiumentum,adjectivus,adjutorium,adlectatio,adlectator,adlibentia,adligatura,adlubentia,adminiculo,administer,administra,administro,admiralius,admirandus,admiranter,admissivus,admoderate,admonitrix,admurmuror,adnomentum,adolescens,adominatio,adorabilis,adparatrix,adparentia,adparitura,adpellatio,adpellator,adpendicum,adpertineo,adpetenter,adpetentia,adpiciscor,adplicatio,adplicatus,adportatio,adprehendo,adprobatio,adprobator,adpromitto,adquisitio,adquisitus,adreptivus,adroganter,adrogantia,adscendens,adscriptio,adscriptor,adsectatio,adsectator,adsentatio,adsentator,adseverate,adsiccesco,adsiduitas,adsifornus,adsignatio,adsignator,adsociatio,adspiramen,adspiratio,adspirator,adstipulor,adstrictio,adstrictus,adstructio,adstructor,adsuefacio,adsumentum,adtentatio,adtenuatio,adtenuatus,adtestatio,adtestatus,adtributio,adtributum,adtributus,adulatorie,adulescens,adulterium,adumbratim,adumbratio,adumbratus,adventoria,adversaria,adversatio,adversator,adversipes,adversitas,adversitor,advolitans,advorsaria,advorsator,advorsitas,advorsitor,aedificans,aedificium,aedilicius,aeditualis,aegilopium,aegonychos,aegrimonia,aegritiudo,aegrotatio,aegrotitas,aegyptilla,aemulanter,aemulatrix,aequabilis,aequalitas,aequaliter,aequanimis,aequanimus,aequidiale,aequidicus,aequidisto,aequilatus,aequilibro,aequiliter,aequimanus,aequipedus,aequivaleo,aequivocus,aeramentum,aeriportus,aerodromus,aerolithus,aeromantia,aerophobus,aeroplanum,aerostatum,aerugineus,aerumnosus,aeruscator,aesculanus,aesculetum,aesculinus,aesculnius,aesthetica,aestimatio,aestimator,aestimatus,aestivalis,aestuarium,aestuarius,aestumatio,aeternalis,aeternitas,aethanolum,aethylicus,aetiologia,aeviternus,affectatio,affectator,affectatus,affectiose,affectivus,affectuose,affirmatio,affirmator,afflagrans,afflictrix,affluenter,affluentia,affricatio,aggratulor,aggravesco,aggregatio,aggregatus,aggressura,agitabilis,agnaticius,agnicellus,agnomentum,agonotheta,agoranomus,agricultio,agricultor,agrimensor,alabastrum,albamentum,albogilvus,albumentum,alcedonium,alchimista,alcyonidus,aleatorium,aleatorius,alectorios,alectorius,alexandrea,alexandria,alicastrum,alienigena,aliovorsum,aliovorsus,aliquamdiu,aliquandiu,aliquantum,aliquantus,aliquomodo,aliquoties,allectatio,allectator,allegorice,allegorizo,allergicus,alleviatio,allibentia,alligatura,allophylus,allubentia,alopecuros,alphabetum,altaragium,altercatio,altercator,alterculum,alternatim,alternatio,alternatus,alterorsus,alterutrum,altilaneus,altipotens,altitonans,altiuscule,altivolans,alucinatio,alucinator,aluminatus,aluminosum,aluminosus,alveolatus,alytharcha,amabilitas,amabiliter,amalechita,amanuensis,amaracinum,amaracinus,ambagiosus,ambarvalis,ambifariam,ambifarius,ambiguitas,ambitiosus,ambolagium,ambufariam,ambulacrum,ambulatrix,ambulatura,americanus,amethystus,amicabilis,amictorium,amictorius,amminiculo,amminister,amministra,amministro,ammirandus,ammiranter,ammissivus,ammoderate,ammoniacum,ammoniacus,ammonitrix,ammonitrum,ammurmuror,amorificus,amotibilis,amovibilis,ampeloeuce,amphibalus,amphibolia,amphibolus,amphicomos,amphitapos,amphoralis,amplificus,amurcarius,amydalinus,amygdaleus,anabathrum,anachoreta,anachorita,anactorium,anaglyphum,anaglyphus,anaglyptum,anaglyptus,anagnostes,anagolaium,analectris,analeptris,analogicus,anapaestum,anapaestus,anaphysema,anarchista,anatomicus,ancillaris,ancillatus,ancorarius,androdamas,androgynus,andronitis,androsaces,angarialis,angiportum,angiportus,anglicanus,angolarius,anguicomus,anguiculus,anguimanus,angularius,angustitas,anicularis,animabilis,animaequus,animalitas,animaliter,animositas,annonarius,annularius,anquisitio,anserculus,antachates,anteambulo,antecedens,antecenium,antecessio,antecessor,antecessus,antecursor,antelogium,anteludium,antemoenio,antemurale,anteoccupo,antepartum,antepassio,antepertum,antepolleo,antepotens,anteridion,anteversio,anthericos,anthericus,anthracias,anthriscum,anthriscus,anthyllion,anthyllium,antiaerius,antiboreum,antiboreus,anticessus,anticipale,anticorpus,antictonis,antigradus,antipascha,antipastus,antipathes,antipathia,antiphonum,antiphonus,antiptosis,antiquatio,antiquatus,antiquitas,antiquitus,antirrinum,antisagoge,antispodon,antistatus,antistigma,antistitor,antithesis,antitheton,antuuerpia,aperibilis,aphaeresis,apheliotes,aphorismus,aphrodisas,apirocalus,apocryphum,apocryphus,apographon,apographum,apolactizo,apoliticus,apologatio,apopempeus,apopompeus,aposplenos,apostatrix,apostrapha,apostropha,apostrophe,apotelesma,apotheosis,apparatrix,apparentia,apparitura,appellatio,appellator,appellatus,appendicum,appertineo,appetenter,appetentia,appiciscor,applicatio,applicatus,apportatio,apprehendo,approbatio,approbator,appromitto,aprugineus,apscondite,apsenthium,apsentivus,apsinthium,apsinthius,apstractio,aquaductus,aquamanile,aquariolus,aquifolium,aquifolius,aquilentus,aquilicium,aquilifera,aquilonium,aquilonius,aquiminale,aquimolina,arabarches,arabarchia,arbavalium,arbiterium,arbitralis,arbitrario,arbitratio,arbitrator,arbitratus,arborarius,arbustivus,arbustulum,arcellacus,arcessitio,arcessitor,arcessitus,archetypon,archetypum,archetypus,archiatrus,archiereus,archigeron,archimimus,archipater,architecta,architecto,archontium,arcifinius,arcipotens,arcitectus,arcitenens,arcosolium,arcuatilis,arcularius,ardesiacus,arenivagus,aretalogus,argemonion,argentaria,argentatus,argentosus,argillosus,argumentor,argumentum,argutatrix,argyranche,argyraspis,arianismus,arietarius,arietillus,ariovistus,aristereon,armamentum,armariolum,armellinum,armeniacum,armeniacus,armentalis,armentivus,armentosus,armiclausa,armicustos,armidoctor,armillatus,armipotens,armoniacus,armoracium,arnoglossa,aromaticum,aromaticus,aromatites,aromatitis,arreptivus,arrhenicum,arroganter,arrogantia,arteriacos,arteriacus,articulate,artificium,artilleria,arundineus,asbestinon,asceterium,ascyroides,asinastrus,aspalathos,aspalathus,aspectamen,aspernatio,aspernator,asphaltion,aspharagus,asphodelum,asphodelus,aspiratrum,asportatio,aspratilis,assectatio,assectator,assefolium,assentatio,assentator,asserculum,asserculus,asservatio,asseverate,assiccesco,assiduitas,assifornus,assignatio,assignator,associatio,assuefacio,assumentum,assuscipio,asteriscus,astragalus,astrangulo,astrificus,astrilucus,astrobolos,astrologia,astrologus,astronauta,astronomia,astronomus,athleticus,atractylis,atramentum,atriplexum,attattatae,attentatio,attentatum,attenuatio,attenuatus,attestatio,attestatus,attornatus,attributio,attributum,attributus,auctificus,auctionale,auctoralis,auctoratus,auctoritas,auditorium,auditorius,auguratrix,augurialis,augustalis,augustatus,augustinus,aulicocius,aulicoctus,aureficina,auricalcum,auricoctor,auricomans,aurificina,aurifodina,aurigarius,aurigineus,aurugineus,aurulentus,ausculatio,ausculator,auspicalis,auspicatus,austeralia,austeralis,austeritas,austerulus,autenticus,authemerum,authemerus,authentice,authentico,authoratus,authoritas,autobirota,autocarrum,autochthon,autocratus,automatizo,autumnalis,autumnasct,autumnesct,autumnitas,auxiliaris,auxiliarus,auxiliatio,auxiliator,auxiliatus,aversatrix,avertarius,babaecalus,babylonius,bacalarius,bacchiacus,baeticatus,balaustium,balenaceus,balinearis,balineator,balineolum,ballistium,balnearium,balnearius,balneatrix,balsaminus,baltearius,baptizatio,baptizator,barbaricum,barbaricus,barbatoria,barbatulus,barometrum,baroptenus,barypicron,barythonos,basilicola,basiliscus,batrachion,batrachium,battuarium,beatificus,bellatorus,bellatulus,bellicosus,belligeror,bellisonus,bellonaria,bellovacus,benedictio,benedictus,benefactio,benefactor,benefactum,beneficium,benemerens,benemorius,beneplaceo,benesonans,benesuadus,benevolens,benificium,benignitas,benigniter,benivolens,bestiarius,biberarius,bibliopola,bicorniger,bidentalis,bidentatio,bifaciatus,biformatus,biformitas,bikinianus,bilibralis,bimenstris,bimotorius,biographia,biologicus,biosphaera,bipartitio,bipartitus,bipedalium,bipedaneus,bipennifer,bipensilis,bipertitio,bipertitus,biprosopum,biprosopus,birotarius,bisextilis,bisyllabus,bitumineus,blandicule,blandities,blasphemia,blasphemus,blateratio,blateratus,blattarius,bombacinum,bombinator,bombizatio,bombycinum,bombycinus,bonusculum,bostrychus,botanismos,botanismus,botronatus,botularius,botulismus,bovicidium,bracchiale,brachialis,brachiatus,brachiolum,brachypota,bractealis,bracteator,bracteatus,bractiaria,bratteator,bratteatus,brattiaria,breviarium,breviarius,breviculus,brocchitas,bromaticus,bronchitis,brutalitas,brutaliter,bubonocele,bubulcitor,buccellare,buccinator,buconiates,bulapathum,burdubasta,burranicum,burranicus,busticetum,bustirapus,bustuarius,butubattum,caballatio,caballinus,cacabaceus,caccabatus,caccabulus,cacometrus,cacophaton,cacophonia,cadaverina,caducarius,caduceator,caduceatus,caecigenus,caelibalis,caelibaris,caelibatus,caelifluus,caeligenus,caenaculum,caenaticus,caenaturio,caenositas,caepaticus,caeremonia,caerimonia,caesuratim,calamarius,calamellus,calamentum,calaminthe,calamister,calamitose,calatorius,calcabilis,calcearium,calcearius,calcestrum,calciarium,calciarius,calcifraga,calcinatio,calculatio,calculator,calculosus,caldariola,calefactio,calefactus,caliandrum,calicellus,calidarium,calidarius,caliendrum,caligarius,caligineus,calliditas,callidulus,calligonon,callithrix,callositas,calumniose,calvariola,calvinista,camararius,camelarius,camelottum,camerarius,campanicum,campestris,campigenus,campsanema,camptaules,canabarius,canabensis,canachenus,canalicius,canalicola,canalicula,cancerasco,canceratus,candefacio,candelaber,candidatio,candidatus,candidulus,candificus,canditatio,caniformis,cannabinus,cantabilis,cantenatus,canterinus,cantharias,cantherius,canticulum,capellania,capellanus,caperratus,capillaris,capillatio,capillatus,capillosus,capillulus,capitagium,capitaneus,capitarius,capitellum,capitolium,capitulare,capragenus,caprificus,caprigenus,caprugenus,capruginus,captensula,captibilis,captivatio,captivator,captivitas,capuccinus,caracallis,carbasinum,carbasinus,carbatinus,carboarius,carbonaria,carbonesco,carbonicus,carbunculo,carceralis,carchesium,carcinodes,cardamomum,cardinalas,cardinalis,cardinatus,carfiathum,carinarius,carmelitus,carminatio,carminator,carnalitas,carnaliter,carnificor,carnificus,carnivorus,carnuficor,carnuficus,carpasinus,carpathium,carpatinus,carpentura,cartibulum,casabundus,cassiculus,cassiterum,castanetum,castificus,castigatio,castigator,castigatus,castimonia,castorinus,castratura,castrensis,castrmetor,casualiter,catachanna,catadromus,catafracta,catalectus,catalepsis,cataphagas,cataphasis,cataplasma,cataplasmo,cataplexis,catapotium,cataractes,catarracta,catascopus,catechesis,catechisso,catechista,catenarius,catervatim,catholicum,catholicus,catillamen,catillatio,catoblepas,catochitis,catoecicus,catularius,catulaster,cauculator,caudicalis,cauliculus,cauponaria,causalitas,causaliter,causativus,causidicus,causificor,cavernatim,cavernosus,cavillatio,cavillator,cavillatus,cavillosus,celamentum,celebratio,celebrator,celebratus,celebresco,celebritas,celebriter,celeranter,celeritudo,cellararia,celleraria,cellerissa,cellulanus,cellularis,cenatorium,cenatorius,cenchritis,censuratus,centaureum,centaurion,centaurium,centensimo,centifidus,centimalis,centimanus,centimeter,centoculus,centralizo,centumpeda,centumplex,centuplico,centuriato,cephalaeum,cephalicus,cephalotos,cepotafium,cepotafius,cerachates,ceratoides,ceratorium,cercolopis,cerebellum,cerebralis,cerebrosus,ceremonial,ceremonior,ceremonium,cerimonial,cerimonior,cerimonium,ceromatita,ceroturium,cerritulus,cerussatus,cervicatas,cervicatus,cervicosus,cervisiola,ceteroquin,chalazosis,chalcaspis,chalcidice,chaldaicus,chalybeius,chamaeacte,chamaedrys,chamaeleon,chamaerops,chamaesyce,chamomilla,characatus,characites,charadrion,charadrius,charistium,chartaceus,chartarius,chartellum,chartopola,chasmatias,chelidonia,chelonitis,chenalopex,chenamyche,chenomyche,chersydros,chimerinus,chiridotus,chirodytos,chironomia,chironomon,chironomos,chirotheca,chirurgius,choaspites,choaspitis,cholericus,choliambus,chondrille,chondrylla,chondrylle,chordapsus,choriambus,chorioides,chorobates,chrismalis,chrismatio,christiane,christifer,chromatice,chromosoma,chrysallis,chrysocome,cicatricor,cicerculum,cilibantum,cilicarius,ciliciolum,cimiterium,cinaedicus,cinaedulus,cinctorium,cinefactus,cinemateum,cineraceus,cineracius,cinerarium,cinerarius,cinericius,cingetorix,cinisculus,cinnabaris,cinnaminum,cinnamomum,circiensis,circinatio,circinatus,circularis,circulatim,circulatio,circulator,circumcido,circumcise,circumcola,circumcolo,circumdolo,circumduco,circumerro,circumfero,circumfigo,circumfluo,circumforo,circumgelo,circumgemo,circumicio,circumitio,circumitor,circumitus,circumlavo,circumlego,circumlevo,circumligo,circumlino,circumnoto,circumpono,circumputo,circumrado,circumrodo,circumroro,circumroto,circumseco,circumsero,circumsido,circumsono,circumsudo,circumtego,circumtero,circumtono,circumvado,circumvelo,circumviso,circumvolo,cisalpinus,cistellula,citatorium,citatorius,citharicen,citharista,citharoeda,citocacium,citriarius,civitatula,clabularis,clagalopes,clamitatio,clamorosus,clarigatio,clarisonus,clarividus,classicula,claudaster,clavularis,clematitis,cleonicion,clericalis,clericatus,clientulus,climaticus,cloacarium,cloacarius,cloccarium,clonizatio,clostellum,clunaculum,cluniculus,clysterium,clysterizo,coactarius,coadulesco,coadunatio,coaedifico,coaegresco,coaequalis,coaetaneus,coaeternus,coagmentum,coagulatio,coagulatus,coarctatio,coarticulo,coccinatus,coccinella,coccymelum,cochlearis,cochleatim,cochleatus,cocinarius,coclearium,cocodrilus,coculeatus,codicarius,codicellus,codicillus,coelibatus,coelifluus,coeligenus,coeligerus,coenaculum,coenaticus,coenaturio,coenositas,coepulonus,cognobilis,cognominis,cognominor,cognoscens,cohaerente,cohaeresco,cohercitio,cohorresco,cohortalis,cohortatio,collabasco,collabefio,collabello,collacrimo,collacrumo,collacteus,collactius,collatatus,collativum,collativus,collective,collegiata,colleticus,colliberta,colliculus,colligatio,collimitor,collimitum,collineate,colliphium,collocatio,collocutio,collocutor,colloquium,collumnela,collusorie,collybista,collyricus,colocasium,colocyntha,colocyntis,coloephium,colonarius,colonellus,coloniaria,colosiaeus,colossaeus,colossicon,colossicus,colubrifer,colubrinus,colubrosus,columbatim,columbinus,columbulus,columnaris,columnatio,columnatus,columnella,columnifer,comatorius,combinatio,combustura,comessatio,comessator,cometerium,comissatio,comissator,comitialis,comitianus,comitiatus,commacesco,commanduco,commargino,commaritus,commasculo,commastico,commaticus,commeditor,commensuro,commentior,commercari,commercior,commercium,comminatio,comminator,comminatus,comminisco,comminutus,commisatio,commiscuus,commiseret,commiseror,commissura,commistura,commixtura,commobilis,commodatio,commodator,commodatum,commoditas,commodulum,commonefio,commonitio,commonitor,commonstro,commoratio,commorsico,commorsito,communalis,communicor,communista,communitas,communiter,communitio,communitus,commurmuro,commutatio,commutatus,comoedicus,compactura,compaganus,comparatio,comparator,comparatus,comparilis,compartior,compascuum,compascuus,compatiens,compavesco,compavitus,compeditus,compendium,compensato,compestror,competalis,competitio,competitor,compilatio,compilator,compitalis,complector,complectus,compluvium,composcens,compositio,compositor,compositum,compositus,compostura,compotatio,compotator,compransor,comprensio,compressio,compressor,compressus,comprimens,compunctio,compunctus,computatio,computator,computesco,conamentum,conangusto,conbustura,concalefio,concalesco,concastigo,concavatus,concavitas,concelebro,concellita,concenatio,conchylium,concitatio,concitator,concitatus,conciucula,conclamans,conclamito,conclusive,conclusura,concolorus,concomitor,concorporo,concreatus,concrispus,conctanter,concubatio,concubinus,concubitio,concubitor,concubitus,concupiens,concupisco,concupitor,concurator,concussura,condecerno,condecurio,condelecto,conditione,conditivum,conditivus,condocefio,condolesco,condominus,condonatio,condonatus,conductrix,conductrum,conduplico,confabulor,confamulus,confatalis,confectrix,confectura,conficiens,confidelis,confirmate,confixilis,conflatile,conflatura,conflictio,conflictor,conflictus,confligium,conflorens,confluctuo,confluvium,confoedero,confornico,confractio,confractus,confragose,confratria,confutatio,congelasco,congelatio,congelatus,congemisco,congenatus,congeniclo,congenitus,congenuclo,congermino,congiarium,congiarius,conglacior,conglomero,conglutino,congredior,congressio,congressor,congressus,conibentia,coniludium,coninquino,coniventia,conjectrix,conjectura,conjugalis,conjugalus,conjugatio,conjugator,conjugatum,conjugatus,conjugulus,conjunctim,conjunctio,conjunctum,conjunctus,conjuratio,conjuratus,conlabasco,conlabefio,conlabello,conlacrimo,conlacrumo,conlacteus,conlactius,conlativum,conlativus,conliberta,conliculus,conligatio,conlimitor,conlimitum,conlineate,conlocatio,conlocutio,conlocutor,conloquium,conlusorie,conmercium,conmonefio,conmonstro,conmunicor,conmunitus,connexivus,connotatio,connudatus,conpaganus,conparatio,conparator,conparatus,conpartior,conpascuum,conpascuus,conpavesco,conpavitus,conpeditus,conpendium,conpetalis,conpetitio,conpetitor,conphretor,conpilatio,conpilator,conpitalis,conplector,conpluvium,conpositio,conpositor,conpositum,conpositus,conpostura,conpotatio,conpransor,conprensio,conpressio,conpressor,conpressus,conprimens,conputatio,conputator,conputesco,conquestio,conquestus,conquiesco,conquietus,conquisite,conquistor,conrectura,conrivalis,conrivatio,conrogatio,conrotundo,conruptela,conruptive,conruptrix,conrutundo,consaeptum,consaeptus,consanesco,consarcino,conscelero,conscensio,conscensus,conscissio,consecutio,conseminia,consenesco,consentium,consepelio,consequens,consequius,consequtio,conservans,conservula,consignate,consilatio,consilesco,consimilis,consistens,consobrina,consolamen,consolatio,consolator,consolutus,consonatio,consortium,consparsio,conspectio,conspector,conspectus,conspersio,conspicuus,conspirate,conspolium,conspondeo,consponsor,consponsus,conspultus,constanter,constantia,constratum,constratus,constricte,constricto,constringo,consualium,consuavior,consucidus,consudasco,consudesco,consuetudo,consularis,consulatus,consultrix,consumptio,consumptor,consutilis,contabesco,contactrum,contechnor,contempero,contemplor,contemplum,contemporo,contemptim,contemptio,contemptor,contemptus,contemtrix,contenebro,conterebro,contermino,conternans,conterrito,contessero,contestato,conticesco,conticisco,contificis,continator,continuate,contogatus,contorqueo,contrabium,contractio,contractor,contractus,contradico,contrafaco,contrapono,contrarior,contrarium,contrarius,contrectus,contribulo,contrucido,contumacia,contumelia,contumelio,contumesco,conubialis,convalesco,conveniens,convenusto,converbero,convexitas,convicanus,convicinus,convinctio,conviresco,convivalis,convivator,convocatio,convolnero,convulnero,coodibilis,cooperatio,cooperator,coperculum,copiositas,copulative,copulatrix,coquibilis,coquinaris,coquitatio,corallinus,corcodilus,cordetenus,cordicitus,cordipugus,coriandron,coriandrum,coriandrus,corintheus,corinthium,corinthius,corniculum,corniculus,cornifrons,cornipetus,cornuarius,cornucopia,cornuculum,cornupetus,corocottas,corollaria,coronarius,corporalis,corporasco,corporatio,corporatus,corporosus,correctura,corrivalis,corrivatio,corrixatio,corrogatio,corrotundo,corruptela,corruptive,corruptrix,corrutundo,corticatus,corticosus,corticulus,coruscamen,coruscatio,coruscifer,corymbifer,corymbites,coryphaeus,cosmeticus,cosmogonia,cosmologia,cosmonauta,costabilis,costamomum,cothurnate,cotidianus,cottidiano,coturnatus,cramaculus,crapulanus,crapulatio,crapulatus,crapulosus,crassicula,crassities,crassitudo,crateritis,craticulus,crebritudo,credibilis,credulitas,cremabilis,crepiculum,crepidatus,crepidulum,crepitulum,crepundium,crescentia,cribrarius,criminalis,criminatio,criminator,criminosus,crinomenon,criobolium,crispitudo,crocodilea,crocodilos,crocodilus,crocomagma,crotalisso,cruciarius,crucifixio,crucifixor,crucifixum,crucifixus,crudelitas,crudeliter,cruditatio,cruentatio,cruentatus,cruentifer,crustallos,crustallum,crustallus,crustarius,cryptarius,crystallos,crystallum,crystallus,cubiculata,cubitissim,cubitorius,cucullatus,cuicuimodi,culcitelia,culicellus,culinarius,cullearius,culminalis,culminatio,culpabilis,cultrarius,culturalis,cumminosus,cunctanter,cunctatrix,cupresseus,curatorius,curialitas,curionatus,curiositas,curiosulus,curriculum,cursilitas,cursitatio,curtisanus,curvabilis,cuspidatim,cussiliris,custodiola,custoditio,cyathiscus,cycladatus,cyclaminon,cyclaminos,cyclaminum,cymbalaris,cymbalicus,cymbalisso,cymbalista,cynacantha,cynapanxis,cynegiolum,cynomorium,cynophanis,cynorrhoda,cynorrodon,cynorrodum,cynosbatos,cynosdexia,cyparissos,cyparissus,cytoplasma,dactylicus,daemonicus,damascenus,damasonion,damasonium,damnabilis,damnaustra,damnificus,danisticus,dannaustra,daphnoides,dapsiliter,dathiathum,deacinatus,debellator,decachinno,decacordum,decacordus,decacumino,decalvatio,decalvatus,decametrum,decantatio,decaprotia,decaprotus,decargyrum,decastylos,decaulesco,decemjugis,decemmodia,decemplico,decemremis,decennalis,decerminum,decertatio,decertator,decharmido,decimarius,decimatrus,decimetrum,decisorius,declamatio,declamator,declaratio,declarator,declinatio,declinatus,declivitas,decliviter,decollatio,decolorate,deconcilio,decrepitus,decretalis,decretista,deculpatus,decuplatus,decurialis,decuriatim,decuriatio,decurionus,decurtatio,decurtatus,decurvatus,decussatim,decussatio,dedecorose,dedicative,dedignatio,dediscalus,dediticius,dedititius,dedolentia,deductivus,deebriatus,defaecatio,defaecatus,defatiscor,defectivus,defensator,defenstrix,defervefio,defervesco,defetiscor,definitive,defioculus,defloratio,defloresco,deforcians,deformatio,deformitas,deformiter,defrenatus,defricatio,defrigesco,defrustror,degradatio,degustatio,dehonestus,dehortatio,dehortator,delamentor,delatorius,delectatio,deleticius,deletitius,deliciaris,deliciatus,deliciolum,deliciosus,delineatio,deliquesco,deludifico,demandatio,demarcesco,demeaculum,demigratio,deminutive,democratia,demoscopia,demulcatus,demussatus,denarismus,dendroides,denigratio,densabilis,densativus,dentarpaga,dentefaber,denticulus,dentiducum,dentilegus,depaciscor,depeciscor,dependulus,depennatus,deperditio,deperditum,deperditus,depinnatus,deploratio,deploratus,depompatio,depontanus,deportatio,depraedico,depravatio,deprecatio,deprecator,deprehendo,deproelior,deproperus,depsticius,depstitius,depugnatio,depurgatio,derectivum,derectivus,derelictio,derelictor,derelictum,derelictus,derelinquo,derisorius,descendens,descriptio,descriptor,descriptum,descriptus,desiderans,desiderium,desidiosus,designatio,designator,designatus,desinentia,desioculus,desparatus,despectrix,desperatio,desperatus,despicatio,despicatus,despolator,despumatio,destinatio,destinator,destinatum,destinatus,destitutio,destitutor,destitutus,destrictus,destructio,destructor,desuefacio,detentatio,detentator,detestatio,detestator,detriumpho,deturbator,devastatio,devastator,deversitor,devestivus,devoratrix,dexteratio,dexteratus,dexteritas,dextrorsum,dextrorsus,diabathrum,diabeticus,diabolicus,diaconalis,diaconatus,diaconicon,diaconicum,diaconicus,diaconissa,diademalis,diadematus,diadumenos,diadumenus,diaetarcha,diaetarius,diaetetica,diaetetice,diagonalis,diagrydium,dialectica,dialectice,dialepidos,dialibanum,dialogista,diameliton,diaphragma,diaporesis,diastoleus,diasyrtice,diatoichum,diatonicon,diatonicus,diatyposis,dicanicium,dicentetum,dichotomia,dichotomos,dicimonium,didacticus,didascalus,didascolus,didasculus,didrachmon,diffamatio,diffamatus,differitas,difficilis,diffinitio,difflagito,diffulguro,diffulmino,diffundito,diffusilis,diffututus,digestilis,digestivus,digitellum,digitellus,digitillum,digitillus,dignabilis,dilargitor,dilatorius,dilectator,diligenter,diligentia,diluvialis,dimidiatio,dimidiatus,dimidietas,dinosaurus,diobolaris,diocesanus,diplangium,diptherias,dipthongus,directivum,directivus,dirigismus,discinctus,disciplina,discipulus,discoperio,discordium,discotheca,discrimino,discriptio,discubitus,discumbens,diselianus,disjunctim,disjunctio,disjunctus,disparitas,disparitio,dispartior,dispendium,dispertior,dispositio,dispositor,dispunctio,disputatio,disrationo,disseisina,disserenat,dissimilis,dissipatio,dissolutio,dissolutus,distabesco,distermino,distinctio,distinctus,distorqueo,distractio,distractus,districtim,districtio,districtus,disturbium,disyllabum,disyllabus,diurnarius,diversitas,diviciacus,dividendus,divulgamen,divulgatio,doctoralis,doctoratus,documentum,dogmaticus,domesticus,domicilium,dominatrix,dominicale,domitianus,donatarius,dormitatio,dotalicium,dracontium,dramaticus,dromedaria,dubitanter,ducenarius,duciloquus,ductilitas,dulciarius,dulcicanus,dulciculus,dulcisonus,dulcoratus,duplicatio,duplicatum,duplicatus,duplicitas,dupliciter,duricordia,dynamismus,dysenteria,dysfunctio,dysinteria,dyspnoicus,eburneolus,ecclesiola,ecfloresco,eclipticus,eclogarius,ecstaticus,educativus,effectivus,effervesco,effloresco,efformatio,effractura,effrenatus,egoisticus,elaboratio,elanguesco,electarium,electricus,electrinus,electrodus,eleemosyna,elephantus,eligibilis,eliminator,ellipticus,elonginquo,eloquenter,eloquentia,emboliaria,embolismus,embryulcia,embryulcus,emissarium,emissarius,emissicius,emissorium,emissorius,emortualis,emphaticus,emphyteuta,empirismus,emplastrum,enarmonion,enarmonius,encyclicus,enucleatio,enucleatus,enumeratio,enuntiatio,ephebiceus,ephratheus,epibaticus,epichirema,epigraphia,epilempsia,epilempsis,epimanikon,episcopium,epistolium,epistylium,epitaphium,epitropous,eporedorix,eremiticus,ergastilus,ergastulum,errabundus,esculentus,esotericus,essedarius,essoniator,estoverium,esurienter,ethnologia,ethnologus,etymologia,euchelaion,eukaristia,euroaquilo,euronummus,euthanasia,evangelium,evangelizo,evaporatio,evigilatio,evitabilis,evolutivus,exactitudo,exadversum,exadversus,exaedifico,examinator,examinatus,exanimalis,excandesco,excelsitas,excessivus,exclamatio,exclusivus,excursatio,exemplaris,exemplator,exempoator,exequialis,exercitium,exercitrix,exheredito,exhorresco,exhortatio,exinanitio,existentia,exorabilis,exorcismus,exorcistus,exotericus,expallesco,expallidus,expectatio,expergefio,explanatio,expletivus,explicatio,explicitus,exploratio,explorator,explosivus,exportator,expugnatio,expugnator,expurgatio,expurgatus,exquisitio,exquisitor,exsecratio,exsecutrix,exsequiale,exstaticus,exstinctio,exstinctor,exstructio,exsuffatio,exsultatio,extispicio,extispicum,extispicus,extraculum,extremista,extremitas,fabrefacio,fabricatio,fabricator,fabriliter,facinarose,facinorose,factitator,facunditas,faeneratio,faenerator,faeniculum,faenisicia,fallacitas,fallaciter,falsidicus,familialis,familiaris,famulitium,fanatismus,farciatura,fasciculus,fascinatio,fascinator,fastigatus,fatalismus,favillesco,februarius,feculentia,fecundatio,fecunditas,feminismus,fenestella,fenisecium,fenisicium,feramentus,ferratilis,ferruginus,fertilitas,fervefacio,festinatim,festinatio,festivitas,festiviter,fetulentus,fideiussor,filiformis,fimbriatus,firmaculum,fistulator,fixatorium,flagitatio,flagitator,flagranter,flagrantia,flammeolum,flammigero,flexanimus,flexibilis,fluctuatio,fluctuosus,foederalis,foederatus,foetificus,foeturatus,folliculus,fonticulus,fordeaceus,fordearius,fordiaceus,fordiarius,forinsecum,forinsecus,forisfacio,formabilis,formaliter,formosulus,fornicaria,fornicatim,fornicatio,fornicator,fornicatus,forticulus,fortunatus,fragilitas,fraglanter,fraglantia,fragmentum,fragrantia,fratricida,frequenter,frequentia,frigidulus,fringultio,fructifero,fructifico,fructuosus,frugalitas,frugaliter,frugilegus,frugiparus,frustramen,frustratio,fruticetum,fruticosus,fulgurator,fulguratus,fulguritus,fumisugium,funambulus,fundibalum,fundibalus,fundibulum,furibundus,furtificus,furunculus,fusiformis,fustibalus,fustuarium,futurismus,galactinus,galliambus,galliarium,galliarius,gargarisso,garrulitas,gasometrum,gausapatus,gausapinus,gehennalis,gelatorius,gelicidium,gemebundus,genealogia,genealogus,generatrum,genocidium,gentilitas,genuflecto,geographia,geologicus,germanicus,germanitas,germinalis,germinatio,gerundivum,gesticulor,gibberosus,glaciarium,gladiatura,glomeramen,glorianter,glorificus,glutinator,glutinosus,gracilitas,grammatica,grammatice,grandaevus,gratificor,gratulatio,graveolens,grossitudo,gubernatio,gubernator,gumminosus,gurgustium,gutturalis,gymnastica,habitatrix,habitualis,habrotonum,hadrumetum,hadrumetus,haereditas,haereticus,haesitatio,haliaeetos,halicacius,hallelujah,halmaturus,harenaceus,harenarium,harenarius,harmonicus,harpagatus,harundifer,hauquaquam,hederacius,hedonismus,helleborum,helleborus,hemicillus,hemicrania,heminarium,hemitonion,hemitonium,herbicidum,herinacius,hermeticus,heroicitas,hexametrus,hexaphorum,hibernalis,hierarchia,hieraticus,hieronymus,hierotheca,hierusalem,hinduismus,hippagogus,hippomanes,historicus,histriatus,holisatrum,holitorius,holocautom,holusculum,homicidium,homogeneus,homoousius,homunculus,honorarium,honorarius,honorifice,honorifico,hordeaceus,hordeacius,hordearius,hordiaceus,hordiacius,hordiarius,hornotinus,horologion,horologium,horribilis,horridulus,horrificus,horrisonus,hortativus,hortensium,hortensius,hortulanus,hospitalis,hostilitas,hostiliter,humanismus,humiliatio,hyacinthos,hyacinthus,hybernalis,hydolatria,hydropicus,hydropisis,hygienicus,hygrologia,hyoscyamus,hypaethros,hypaethrum,hypaethrus,hyperbaton,hyperdulia,hypnotista,hypocrisis,hypocrites,hypodorius,hypolydius,hypostasis,hypotenusa,hypothesis,hypozonium,hystericus,iacinthina,icosaedron,idealismus,identifico,idolotitum,ignipotens,ignoranter,ignorantia,ignorantio,illacrimor,illegitima,illusorius,imaginatio,imaguncula,imbecillis,imbecillus,imitabilis,immediatus,immigratio,immodestia,immodestus,immortalis,immunditia,imperditus,imperialis,imperiosus,implacatus,implacidus,implicatio,implicatus,implicitus,impollutus,importunus,impotentia,imprecatio,improbitas,improbulus,improcerus,impromptus,improprius,improspere,improvidus,improvisus,impudenter,impudentia,impulsivus,inabruptus,inaccensus,inaccessus,inadsuetus,inadunatus,inaedifico,inaequalis,inamabilis,inamaresco,inanimatus,inaspectus,inassuetus,inbecillis,inbecillus,incallidus,incandesco,incantamen,incantatio,incantator,incapacito,incarnatio,incarnatus,incensatio,incensitus,incentivus,incestuose,incidenter,incidentia,incitatrum,inclinatio,incogitans,incognitus,incommatio,incommodum,incommodus,inconcilio,inconditus,inconfusus,incongruus,inconrupte,inconstans,inconsulte,incorrupte,incredulus,increpatio,incruentus,inculpatus,incuriosus,incurvesco,indagatrix,indamnatus,indecenter,indefectus,indefensus,indefessus,indefletus,indeflexus,indejectus,indemnatas,indemnatus,indentitas,indesertus,indetonsus,indigestus,indignatio,indignitas,indigniter,indiligens,indipiscor,indirectus,indireptus,indiscrete,indisertus,individuum,individuus,indolentia,indubitate,indulgitas,indultivus,indumentum,indusiatus,industrius,ineruditio,ineruditus,inevolutus,inexpertus,inexpletus,infacundus,infantaria,infantilis,infantulus,infecundus,infelicito,infernalis,infervesco,infirmitas,infirmiter,infitialis,infitiatio,influentia,informatio,informitas,infrenatus,infrequens,infricatus,infrunitus,ingeniatus,ingeniculo,ingeniosus,ingenuitas,ingerentia,ingravesco,ingustatus,inhabilito,inhaeresco,inheredito,inhonestas,inhonestus,inhonorate,inhorresco,inhospitus,inimicitia,ininnascor,injucundus,injuriosus,injustitia,inlacrimor,inlaudatus,inlusorius,inmediatus,inmortalis,inmunditia,innocentia,innutritus,inobediens,inocciduus,inoffensus,inominatus,inopinatus,inordinate,inperialis,inpotentia,inprecatio,inprobitas,inprobulus,inprovisus,inpudenter,inpudentia,inquietudo,inquilinus,inquinatio,inquisicio,inquisitio,inquisitor,inradiatio,inrational,inraucesco,inrepertus,inresectus,inretortus,inreverens,inridicule,inritatrix,insalubris,insatiatus,inscientia,inscriptio,insectatio,insensatus,insepultus,insidiator,insidiosus,insincerus,insinuatio,insiticius,insolenter,insolentia,insordesco,insperatus,inspiratio,instabilis,instinctor,instinctus,institoria,institutio,institutor,institutum,instrenuus,instructor,instructus,insulsitas,insultatio,integellus,integralis,integrasco,integratio,integrista,integritas,intemperia,intensitas,intensivus,interactio,interaneum,interaneus,intercipio,intercludo,intercurso,interficio,interfusus,interjaceo,interjacio,interjicio,interlabor,interluceo,interminor,intermitto,internatus,internecio,internicio,internista,interniteo,internosco,interpello,interpreto,interritus,interrumpo,intersitus,interspiro,intertrigo,intervallo,intervenio,interverto,intestatus,intestinus,inthronizo,intolerans,intremisco,intrepidus,intributio,intricatus,intromitto,introrumpo,intuitivus,inturbidus,inusitatus,inutiliter,invaletudo,invenditus,invenustus,invidendus,invidentia,invidiosus,inviolatus,involucrum,involuntas,iotacismus,ipsissimus,irradiatio,irrational,irrepertus,irreverens,irridicule,irritatrix,islamismus,isochronus,isoscelles,israelitis,israhelita,istiusmodi,jecusculum,jentaculum,jesuiticus,jocabundus,jocunditas,jocusculum,jubilarius,jucunditas,juramentum,jurgatorus,jurulentus,kalatorius,laboriosus,laceratrix,lacertosus,lachrimula,laciniatim,laciniosus,lacrimalis,lacrimifer,lacrimosus,lactantius,laetabilis,laetificus,lamentatio,lancinatio,lapidarius,lapidicina,largificus,largifluus,latebrosus,laterculus,latericium,latericius,latifolius,latrocinor,latusculum,laudabilis,laurentius,lauricomus,lavatorium,lavendulus,laxamentum,legatarius,legislatio,legislator,legiuncula,legumlator,lenimentum,lenocinium,lenunculus,leporarium,leporarius,lepusculus,levamentum,levidensis,levisomnus,libamentum,libertinus,liburnicus,ligamentum,limitaneus,linamentum,linostimus,liquefacio,litaviccus,lithocolla,litigiosus,litteralis,litteratio,litterator,litteratus,litterosus,liturarium,liturgicus,locatorius,longaminus,longanimis,longinquom,longinquus,loquacitas,loquaculus,loramentum,lubricosus,lucrativus,lucrifacio,luctificus,luctisonus,lucubratio,luculentus,ludibundus,lutheranus,lutulentus,luxuriosus,lychnuchus,macedonius,machinalis,machinatio,machinator,macilentus,macrologus,macronosia,macronozia,madefactus,madianitis,maestifico,maestitudo,magnanimus,magneticus,magnificus,magnuficus,majusculus,maledicens,maledictio,maledictum,malefactor,maleficium,malesuadus,malevolens,malicorium,malignitas,malitiosus,malivolens,mandarinum,mandibulum,manifestus,manometrum,mansuetudo,manufactus,manufestus,manuleatus,manumissio,manzerinus,margarinum,marginalis,maritagium,marmoratus,marsuppium,martipanis,masculinus,masochista,massuelius,massuerius,mastigatus,materialis,materiatio,matricalis,matriculus,matronalis,maturrimus,maxillaris,maximopere,mechanicus,medioximus,medullitus,megestanis,megestanus,megistanis,megistanus,mehercules,melioratio,meliuscule,mellificus,memorandum,memorialis,mendaciter,mendicatio,mendicitas,mentastrum,meridianus,meridiatio,meritorium,meritorius,metastasis,meteoricus,methanolum,methodicus,methylicus,metropolis,milifolium,militarium,militarius,millennium,milliarium,milliarius,miniatulus,minurritio,minusculus,mirabundus,miraculose,miserandus,misericors,missibilis,mitridates,mnemosynum,moderatrix,modernista,modernitas,moestifico,moestitudo,molendinum,molimentum,molinarius,molliculus,moltaticus,momentosus,monasticus,monetarius,monimentum,monitorius,monomachia,monopodium,monopolium,monstratio,monstrator,monstrifer,monstriger,monticolus,montivagus,monumentum,mordacitas,mordaciter,mordicatio,moribundus,mortalitas,morticinus,muliercula,mulierosus,multaticus,multesimus,multicanus,multicavus,multicolor,multifidus,multifluus,multigener,multigenus,multijugis,multijugus,multimodis,multimodus,multiplico,multiscius,municipium,munificens,munimentum,munusculum,murilegius,murmuratio,muscipulum,musculosus,musulmanus,myocardium,myrepsicus,myriadalis,mystagogus,mythologia,narcomania,narcoticus,narrabilis,narthecium,nasciturus,nassiterna,nasturcium,natalicium,natalicius,nationalis,nauclerius,naufragium,navifragus,necessaria,necessarie,necessario,necessitas,necopinans,necrologia,negotiatio,negotiator,negotiolum,negotiosus,nehalannia,nemorensis,nequicquam,neutralizo,neutronium,niceterium,nidificium,nihilismus,noctivagus,nocumentum,nonagensim,nonagensum,nonnumquam,nonnunquam,normativus,nosocomium,notariatus,notionalis,novenarius,novendecim,noviciatus,novilunium,noviomagus,novissimum,novissimus,novitiatus,nugatorius,nugivendus,nullatenus,numerarius,nunciatrix,nuncupatio,nuntiatrix,nuntiatura,nutabundus,nycticorax,obdormisco,obedienter,obedientia,obedientio,obhaeresco,objectatio,objectivum,objectivus,objurgator,oblectamen,oblectatio,oblitterus,obliviosus,obliviscor,obnoxietas,obnoxiosus,obprobrium,obscenitas,obscuratio,obscuritas,obsecratio,obsequella,observatio,obsidialis,obsignator,obstaculum,obstetrico,obstinatio,obstinatus,obstipesco,obstructio,obstupefio,obstupesco,obstupidus,obsurdesco,obtestatio,obtorpesco,obturgesco,obvallatus,occallesco,occipitium,occultatio,octingenti,octogensim,octogensum,octonarius,octophoron,octophoros,odontalgia,oenococtus,oenophorum,offertoria,officialis,officiosus,offirmatus,offrenatus,offuscatio,oleiductus,oligarchia,omnifariam,omniparens,omnipotens,opertorium,ophthalmia,oporotheca,oporothece,oppidaneus,opportunus,opprobrium,oppugnatio,oppugnator,opscenitas,opservatio,opstetrico,optimismus,ordinarius,ordinatrum,organarius,organismus,orichalcum,orientalis,originalis,ornamentum,orthodoxus,orthopaeda,orthopnoea,ostentatio,ostentator,paedagogia,paedagogus,paediatria,paedicator,paeninsula,paenulatus,paganismus,palaeopola,pallidulus,paludester,paludismus,pampinatio,pancration,pancratium,pandiculor,panificium,panniculus,pantomimus,papavereus,papyraceus,parabolois,paracletus,paraclitus,parallaxis,parallelus,paramentum,parametrum,parasceves,parentalis,parissumus,paritarius,paroxysmus,parsimonia,parthenice,parthenium,partibilis,participio,partisanus,parvipendo,passibilis,pastinatum,pastoralis,paternitas,pathologia,patriarcha,patrocinor,patronatus,paullisper,paullumper,paululatim,pavimentum,peccabilis,peccatulum,peculiaris,peculiatus,peculiosus,pecuniosus,pedamentum,pedeplanum,pedetemtim,pedifollis,pedisequus,pedissequa,peioresceo,penecostas,penetralis,penetratio,penicillus,pensitatio,pentameter,pentecoste,penuriosus,peracerbus,peragratio,peramoenus,perargutus,perattente,perbacchor,perblandus,percalesco,percelebro,percupidus,perdecorus,perdelirus,perdolesco,perduellio,perduellis,peregrinor,peregrinus,perelegans,perendinus,perexiguus,perfacetus,perfacilis,perfluctuo,perfrictio,pergamenum,pergraecor,pergrandis,perhumanus,periclitor,peridoneus,periegesis,periegetes,perimetros,periniquus,perinvitus,periodicum,peripheria,peripteros,periscelis,peristasis,peristeron,peristroma,peristylon,peristylum,perlucidus,permadesco,permanasco,permarinus,permoleste,permutatio,pernicitas,pernobilis,pernotesco,pernotesct,perodiosus,perornatus,perpaullum,perpensius,perpopulor,perpotatio,perrogatio,persapiens,perscrutor,persecutio,persecutor,perseverus,persicinus,persimilis,persimplex,personalis,personatus,perspectus,perspicace,perspicuus,perstimulo,perstringo,perterebro,perterrito,pertimesco,pertinacia,pertorqueo,pertranseo,pertristis,perurbanus,pervesperi,pervestigo,pervicacia,pessimista,pestilitas,petaurista,petorritum,petulanter,petulantia,peucedanum,phalaecius,phalangion,phalangita,phalangius,phaleratus,phantasior,pharetraus,philatelia,philologia,philologus,phitonissa,phlebotomo,phoneticus,photocopia,photocopio,phylarches,phylarchia,phylarchus,phyleticus,piacularis,picturatus,pimpinella,pindaricus,pinguitudo,pinnaculum,pinniculum,pinnirapus,pisciculus,pisselaeon,pissoceros,pituitosus,pityocampa,pityocampe,placabilis,plagiarius,plantarium,platonicus,plostellum,plumbarius,pluralitas,pluraliter,plurativum,plurativus,pocillator,poculentus,podagrosus,pollicaris,pollicitor,pollicitum,pollinctor,polyphagia,polyphonia,pompaticus,pompeianus,ponderatio,ponderosus,ponticulus,pontificus,porcellana,porcillaca,possibilis,posteaquam,posteritas,postfactus,posticulum,postpartor,postscribo,postulatio,postulatum,potentatus,potionatus,potissimum,potissimus,potissumum,potulentum,potulentus,praeacutus,praeambula,praeambulo,praecelero,praecelsus,praecentio,praeceptio,praeceptor,praeceptum,praecipito,praecipuus,praeclarus,praeconium,praeconius,praecoquis,praecordia,praecursor,praediator,praedictum,praedictus,praediolum,praedivino,praedulcis,praefectus,praefringo,praefulcio,praefulgeo,praegestio,praegnatio,praegravis,praegustus,praejudico,praelargus,praelongus,praeloquor,praememoro,praemolior,praemordeo,praemorior,praenavigo,praenuntio,praeoccupo,praependeo,praepolleo,praepossum,praepotens,praeputium,praequeror,praeruptus,praesaepes,praesaepio,praesagium,praescisco,praescitio,praescitum,praescribo,praesegmen,praesenter,praesentia,praesentio,praesepium,praesertim,praeservio,praesidium,praesignis,praespargo,praestatio,praesterno,praestigia,praestituo,praestolor,praesulsus,praetempto,praetenuis,praeterago,praetextum,praetextus,praetorium,praetorius,praetrunco,praevarico,prandiolum,preambulum,preambulus,primigenus,primipilus,primitivus,primopilus,primordium,principium,probabilis,procacitas,proceritas,procinctus,procuratio,procurator,proditiose,proegmenon,proeliator,profluvium,progenitor,progredior,progressio,progressus,prohibitio,prohoemium,prolixitas,proloquium,promercium,promiscuus,propaganda,propagatio,propatruus,propatulum,propatulus,propemodum,properatio,properipes,prophetizo,propinatio,propinquus,propositio,propositum,propraetor,proprietas,prorogatio,proselytus,prospectus,prostituta,protestans,protogenes,proturbero,proverbium,provocatio,proximitas,psalterium,psychiater,publicanus,publicatio,publicitus,pudibundus,pueriliter,puerperium,pugillaris,pugnacitas,pugnaculum,pulchellus,pulchresco,pulcritudo,pulicarius,pulsabulum,pulsatilis,pulvinatus,pupillaris,purpuratus,purulentus,pusillitas,pusillulus,puteulanus,putrefacio,pythonicus,pythonissa,quadrantal,quadriduum,quadrigula,quadriplex,quadrivium,quadruplex,quadruplor,quadruplum,quadruplus,quadruvium,quamprimum,quamtocius,quamtotius,quantillus,quantocius,quantopere,quantumvis,quantusvis,quapropter,quartarius,quatefacio,quatriduum,querimonia,querquerus,quinctilis,quinquevir,quintarius,quinticeps,quintilius,quiritatio,quiritatus,quisquilia,quoadusque,quodammodo,quomodonam,quoquomodo,quoquomque,quotcumque,quotcunque,rabiosulus,radiatilis,radiologia,radiologus,raffinatio,ramusculus,rancidulus,ranunculus,raphaninus,raphanitis,rarefactio,ratiocinor,rationalis,ratiuncula,raucisonus,ravacaulis,rebellatio,recalcitro,recalesceo,recalfacio,recandesco,recapitulo,reciprocus,reclamatio,recognitio,recognitor,recognosco,recompenso,reconcilio,reconcinno,reconditus,recordatio,recrudesco,redardesco,redauspico,redintegro,redipiscor,redormisco,reductivus,reformatio,refragatio,refrigesco,reimprimeo,relentesco,religiosus,reliquator,reminiscor,remollesco,renunculus,repentinus,repercutio,replebilis,reprehendo,reprehenso,reprobatio,repromitto,republicae,repudiatio,repuerasco,repulsivus,reseantisa,residentia,resignatio,resinaceus,respiramen,respiratio,respiratus,resplendeo,responsito,restinctio,restipulor,restitutio,restitutor,restrictus,retardatio,retiaculum,retinentia,retorridus,retributio,retrotraho,reverendus,reverenter,reverentia,rhetoricus,rhinoceros,rhythmicus,ridibundus,ridiculare,ritualiter,romanticus,rorulentus,rosmarinus,rotundatio,rotunditas,rubicundus,rubricatus,rudimentum,rumusculus,rusticanus,rusticatio,rusticitas,rusticulus,rutunditas,sabacthani,sabbataria,sacopenium,sacrificum,sacrificus,sacrilegus,saecularis,saevidicus,sagittatio,sagittatus,sagittifer,salaputium,salebrosus,salubritas,salubriter,salutatrix,salvificus,sampsuchum,sanatorium,sanctifico,sanctitudo,sanguinans,sanguineus,sanguisuga,sanitarius,saraballum,sarcinator,sardonicus,sarmaticus,satanismus,satisdatio,satisfacio,saturninus,satyriscus,saxophonum,scabrities,scaccarium,scaenarium,scalpellum,scalpellus,scalpratus,scandalizo,scandalose,sceleratus,scelerosus,scenopegia,sceptrifer,sceptuchus,scolastica,scopulosus,scortillum,scriptulum,scrutarius,scrutillus,scrutinium,sculptilis,scutulatum,scutulatus,secamentum,secundanus,securicula,seditiosus,selectivus,semanticus,semaphorum,semenstris,sementifer,sementivum,sementivus,semianimis,semianimus,semicoctus,semicremus,semidoctus,semifactus,semigravis,semilautus,seminarium,semiologia,semiplenus,semisomnus,semitarius,semitonium,sempiterne,sempiterno,sempronius,senariolus,senatorius,sensibilis,sensiculus,sensitivus,senticetum,septemplex,septemtrio,septentrio,septiforis,septimanus,septimplex,septuennis,sepulchrum,seraphicus,seraphinus,sericarius,sermocinor,servabilis,sescenaris,sesqualter,sesquihora,sesquiopus,sesquiplex,sessibulum,sestertium,sestertius,severitudo,sexagensim,sexagensum,sexangulus,sexqualter,sexualitas,sibyllinus,siccoculus,sicilicula,sifonarius,sifunculus,sigarellum,sigarillum,sigillatim,sigillatus,signaculum,silentiose,siligineus,silvestris,silvicolus,similitudo,simplifico,simulacrum,simulatque,simulatrum,sinceritas,singularis,singulatim,siponarius,sipunculus,sirpiculus,sisinbrium,sisymbrium,socialista,sociologia,sociologus,socolateus,socorditer,socraticus,sodalicium,sodalicius,sodalitius,sofisticus,sokemannus,solaciolum,solemnitas,solemniter,solemnitus,solistimus,solitarius,sollemnizo,sollempnis,sollicitus,solstitium,somnificus,sophistice,sordidatus,sordidulus,sororicida,sortilegus,spectaclum,spectatrix,speculamen,speculatio,speculator,sperabilis,sphaeratus,sphondylus,spirabilis,spiraculum,spiritalis,spissitudo,splendesco,splendidus,spoliatrix,spondalium,sponsalius,spontaneus,spurcalium,spurcities,stabilitas,statistica,statuarius,statuliber,stercoreus,sterilitas,stigmatias,stimulatio,stipendium,stipulatio,stlatarius,stoliditas,stramentum,stramineus,stranguria,strategema,strategica,stratorium,strenuitas,structilis,stupefacio,stupiditas,stylobates,styracinus,suaveolens,suavidicus,subabsurde,subalbidus,subaquilus,subarmalis,subblandio,subcaesius,subdiffido,subditivus,subfocatio,subintroeo,subinvideo,subinvisus,subirascor,subitaneus,subitarius,subjugalis,subjugatio,sublevatio,sublimatio,sublimitas,sublividus,sublucidus,sublustris,submoleste,submorosus,submurmuro,subnotatio,subnotator,subnubilus,subodiosus,suboffendo,subpuratio,subpuratus,subregulus,subsecivus,subsellium,subsericus,subsicivus,subsortior,substantia,substramen,substringo,subtalaris,subterduco,subtilitas,subtiliter,subtractio,subtristis,subtularis,suburbanus,subviridis,succrispus,succurator,suculentus,sudatorium,sudatorius,suffarcino,sufficiens,suffocatio,suffragium,suggrandis,suggredior,sugillatio,sugitorium,suicidalis,sulfuratus,sullaturio,sulphureus,summoleste,summonitio,summonitor,summorosus,summurmuro,sumptuosus,superfixus,superfluum,superfluus,superfundo,superjaceo,superjacio,superlatus,superlinen,supermitto,superobruo,superonero,supersedeo,superstruo,supervehor,supervenio,suppalidus,supplicium,suppositio,suppressio,suppullulo,suppuratio,suppuratus,supputatio,suptilitas,suptiliter,surculosus,surpiculus,surregulus,surrutilus,suscriptor,suspendium,suspiratus,suspiritus,susurratim,susurratio,susurrator,sycophanta,symbolicus,synagrapha,synaloephe,syncopatus,synderesis,syndicalis,syndicatus,synemmenon,syngraphus,tabacarius,tabacismus,tabefactus,tabellanio,tabularium,taciturnus,tangibilis,tantummodo,tapetarius,tarquinius,tegimentum,tegumentum,teleferica,telegrapho,telepathia,telephonum,telonarius,telonialis,temerarius,temperatio,temperator,temperatus,temporalis,temporivus,temulentia,temulentus,tenebrasco,tenebresco,tenebrosus,tenellulus,tenementum,teneritudo,teniludium,teniludius,tenuiculus,tepidarium,tergeminus,terminalis,terminatio,terratenus,terrestris,terribilis,terriculum,terrificus,terrigenus,terriginus,terrorista,tertiarius,testiculus,testificor,tetrachmum,tetragonum,tetragonus,tetrameter,tetrarches,tetrarchia,theatralis,theatricus,thematicus,thensaurus,theocratia,theodosius,theolonium,theosophia,therapeuta,theristrum,thermopoto,thesaurius,thesaurizo,thrombosis,thymelicus,thynnarius,timefactus,tirocinium,tirunculus,titillatio,togatarius,toleranter,tolerantia,torminosus,tornatilis,tranquillo,transactio,transactor,transadigo,transcendo,transcribo,transcurro,transfodio,transformo,transfreto,transfugio,transfundo,transfusio,transjicio,translatio,translator,transluceo,transmigro,transmitto,transmoveo,transpicio,transporto,transtineo,transvenio,transverse,transverso,transverto,tremefacio,trepidatio,trepiditas,triangulum,triangulus,tribrachus,tribuarius,tribulatio,tribunatus,tributoria,tricennium,triclinium,trigeminus,triglyphus,trilinguis,trimenstre,trimestris,trinoctium,tripedalis,tripertito,triquetrus,tristifico,tristities,tristitudo,triumfator,tropologia,trucidatio,tuberculum,tuburcinor,turgidulus,turicremus,turmalinus,turpiculus,turpissime,tutamentum,typhonicus,tyrannicus,ubiquomque,uliginosum,uliginosus,umbraculum,umbraliter,umbraticus,unanimitas,unanimiter,uncinatrum,undecumque,unguiculus,unicornuus,unigenitus,univiratus,urbicarius,urceolaris,utrumlibet,vaccinatio,vacerrosus,vacuefacio,vadimonium,vagabundus,valentulus,valerianus,vaniloquor,vaniloquus,vaporarium,vaporarius,variabilis,vastificus,vaticinium,vaticinius,vectigalis,vehementer,velamentum,velivolans,velleianus,vellicatim,venalicius,venatorius,vendibilis,venditatio,veneficium,veneranter,ventriosus,venustulus,vepallidus,verberatus,verecundia,verecundor,verecundus,veredictum,vermiculus,verminatio,vernaculus,verniliter,verrucosus,versatilis,versicolor,versiculus,vertibilis,verticitas,verticosus,verumtamen,vesperasco,vesperasct,vestiarium,vestiarius,vestibulum,vestiplica,vestispica,veternosus,vexillatio,viaeductus,vicariatus,vicissatim,victoriola,vigilanter,vigilantia,vigintivir,vilipensio,villaticus,villicatio,vincentius,vindemiola,vindicatio,vinolentia,vinolentus,vinulentus,violabilis,violinista,virdiarium,virginalis,virginitas,virguncula,viridarium,viripotens,viritualis,virulentus,visceratio,visocaseta,vitabundus,vitilitigo,volnificus,voluntarie,volutabrum,vomitorius,vulgivagus,vulnificus,vulticulus,xenodochus,xenophobia,xerophagia,xylophonum,zoologicus,abalienatio,abalienatus,abavunculus,abbreviatio,abbreviator,abbreviatus,abdicativus,abdominalis,abecedarium,abecedarius,ablaqueatio,abmatertera,abnegativus,abominandus,abominanter,aborigineus,abrodiaetus,abrotonites,absconditum,absconditus,absinthites,abstinenter,abstinentia,acanthillis,acanthyllis,acatalectus,acatalepsia,acatholicus,acaunumarga,acceleratio,acceptarius,acceptorius,accessorius,accipitrina,accommodate,accomodatio,accomodatus,accubitalia,accubitatio,accumulatio,accumulator,accusabilis,accusativus,accusatorie,acersecomes,acinaticius,acoenonetus,acquisitrix,acrobaticus,acrochordon,actionarius,actuariolum,acuminarius,acupunctura,adamantinus,adapertilis,adapertivus,adbreviatio,adbreviator,adbreviatus,adceleratio,adcommodate,adcumulatio,adcumulator,adfabilitas,adfabiliter,adfectiosus,adfectualis,adfectuosus,adficticius,adfirmanter,adflictatio,adflictator,adgeniculor,adgnaticius,adgnomentum,adipsatheon,adipsatheos,adjaculatus,adjecticius,adjudicatio,adjunctivus,adjutabilis,adlicefacio,adminiculor,adminiculum,admirabilis,admissarius,admonefacio,adnominatio,adnumeratio,adnuntialis,adnuntiatio,adnuntiator,adolefactus,adoptaticia,adpendicula,adpetibilis,adplumbator,adporrectus,adprehensio,adpromissor,adpropinquo,adquisitrix,adrectarium,adrectarius,adrenalinum,adrepticius,adreptitius,adrumetinus,adsentatrix,adsertorius,adsessorium,adsessorius,adseveratio,adsignifico,adsimilatio,adsimilatus,adsimiliter,adsimulatio,adsimulatus,adsimuliter,adspectamen,adstrangulo,adsumptivus,adterraneus,adtertiatus,adtrectatio,adtrectatus,adulatorius,adulteratio,adulterator,adulteratus,adulterinus,adulteritas,advecticius,advelitatio,advenientia,advententia,adventicius,adventitius,adventorius,adverbialis,adversarium,adversarius,adversatrix,advertentia,advorsarium,advorsarius,advorsatrix,aedificatio,aedificator,aedificatus,aegolethron,aegyptiacus,aequamentum,aequationum,aequidianus,aequiformis,aequilavium,aequilibris,aequiternus,aequivalens,aeroplaniga,aeruginosus,aethiopicus,aethiopissa,affabilitas,affabiliter,affectiosus,affectualis,affectuosus,afficticius,affirmanter,afflictatio,afflictator,aggeniculor,aglaophotis,agnominatio,agonosticus,agonothetes,agricolaris,agricolatio,agricultura,albicantius,albiceratus,albogalerus,alcoholicus,algorithmus,alienigenus,aliquantulo,aliquatenus,aliquotiens,aliturgicus,allegoricus,allicefacio,allucinator,alticinctus,altiliarius,altithronus,altiusculus,altrimsecus,altrinsecus,altrovorsum,aluminatius,alumnaticum,alytharches,alytharchia,amarulentia,amarulentus,amasiuncula,amatorculus,ambligonius,amblygonius,ambrosiacus,ambrosialis,ambulatilis,ambulativum,amissibilis,amminiculor,amminiculum,ammirabilis,ammissarius,ammochrysus,ammonefacio,amorabundus,amortizatio,amphibrevis,amphimacros,amphimacrus,amphimallum,amphisbaena,amphisporum,amphistomus,amphorarius,ampliuscule,ampullaceus,ampullarius,amygdalinus,amygdalites,anabaptista,anaboladium,anabolagium,anabolarium,anachoresis,anadiplosis,anaesthesia,analemptris,anapauomene,anaphoricus,anatocismus,androgeosos,androsaemon,anemometrum,anglicismus,anguitenens,angulariter,animadverto,animalculum,anisocyclum,annumeratio,annunciator,annuntialis,annuntiatio,annuntiator,anonomastos,antagonista,antapodosis,antarcticus,antecedente,antegredior,anteloquium,antelucanum,antelucanus,antemuranus,antependium,antepilanus,anteurbanum,anteurbanus,anthophoros,anthracinum,anthracinus,anthracites,anthracitis,antibacchus,anticipalis,anticipatio,anticthonis,antigelidum,antilucanus,antimensium,antiochenus,antiochinus,antipendium,antiphernum,antiphrasis,antiquarius,antirabicus,antirrhinon,antistitium,antistrophe,antoecumene,antomasivus,antonomasia,apertibilis,aphronitrum,apiastellum,apocalypsis,apodicticus,apodyterium,apollinaria,apollinaris,apophoretum,apophoretus,apopompaeus,aposcopeuon,aposiopesis,apostaticus,apostolatus,apostolicus,apostrophos,apostrophus,apoxyomenos,appendicula,appenditium,appensorius,appetibilis,appetivitus,applumbator,apporrectus,appreciatio,appreciatum,apprehensio,appretiatio,appretiatum,appromissor,appropinquo,apsconditum,apsconditus,apsinthites,apstinenter,apstinentia,aquaeductus,aquaelicium,aqualiculus,aquilonalis,aquilonaris,aquiminalis,aquisgranum,aquivergium,arachnoides,aracostylos,aratiuncula,arbitrarius,arbitratrix,arceuthinus,archangelus,archezostis,archibugius,archicantor,archidictus,archigallus,archipirata,archipresul,architecton,architector,architectus,arcifinalis,arcisellium,arcubalista,arenifodina,argentarium,argentarius,argenteolus,argentiolus,argillaceus,argyrodamas,arhythmatus,aristolocia,aristotoles,arithmetica,arithmetice,armentarius,armenticius,aromatarius,aromatizans,aromatopola,arquipotens,arquitenens,arrectarium,arrectarius,arrepticius,arreptitius,arsenogonon,arthriticus,articularis,articulatim,articulatio,articulatus,articulosus,artificiale,artificiose,artolaganus,artophorion,artopticius,arundinetum,ascalabotes,ascriptivus,aspergillum,aspernanter,assecuratio,assentatrix,assertorius,assessorium,assessorius,asseveratio,assignifico,assimilatio,assimilatus,assimiliter,assimulatio,assimulatus,assimuliter,assipondium,assistentia,assumptivus,asthmaticus,astipulatio,astipulator,astipulatus,astriloquus,astrolabium,atheniensis,athletismus,atmosphaera,atricapilla,attemperate,atterraneus,attertiatus,attractivus,attrectatio,attrectatus,auctionalis,auctumnalis,auctumnasct,auctumnesct,auctumnitas,augmentatio,auguraculum,aulicoquius,aurantiacus,aurichalcum,auricularis,aurifrisius,auriginosus,aurilegulus,auruginosus,auscultatio,auscultator,auscultatus,austrinalis,authenticum,authenticus,autocinetum,autocthonus,autographum,autographus,automaticus,autonomatia,auxiliarius,auxiliatrix,aversabilis,avicularius,avocamentum,axinomantia,baccalarius,bacchanalis,bacciballum,bacterialis,balaenaceus,baldachinum,baldachinus,balinearium,balinearius,balineatrix,balistarium,balistarius,balneaticum,baptismalis,barbarismus,barbasculus,barbitonsor,barytonista,basilicanus,basioballum,bastagarius,batrachites,bellatorius,belliatulus,bellicrepus,bellipotens,benemeritus,beneolentia,beneplacens,benzinarium,benzinarius,bestialitas,bibliopegus,bibliotheca,bibliothece,bicameratum,bicameratus,bicubitalis,bicubitalus,bilateralis,bimenstruus,biometricus,biothanatus,biselliatus,bisextialis,bissextilis,bithalassus,bituminatus,bituminosus,blandidicus,blandificus,blandifluus,blapsigonia,blattiarius,borborygmus,bracchialis,bracchiatus,bracchiolum,bractiarius,brattiarius,breviloquis,breviloquus,britannicus,bronchocele,bubulcarius,buccellaris,buccellatum,bucconiatis,buccularius,bucculentus,buleuterion,buleuterium,buphthalmos,buphthalmus,burdonarius,burdunculus,buttubattum,bybliotheca,caballarius,caccabaceus,cacemphaton,cachecticus,cachinnatio,cachinnosus,cadaverinus,cadaverosus,caelipotens,caementatio,caemeterium,caenatorium,caenatorius,caencenatio,caenulentus,caerefolium,caeremonial,caerimonior,caerimonium,caeruleatus,caesarianum,caesariatus,caespitator,calamistrum,calamitosus,calamochnus,calathiscus,calcarensis,calcedonius,calcitratus,calcitrosus,calcularius,calculatura,calculensis,caldamentum,calendarium,caliclarium,caliginosus,callionymus,calorificus,calumniator,calumniosus,calventinus,calvinianus,calvinismus,camelaucium,camelelasia,camescopium,campanarium,campanarius,campicursio,campidoctor,campionatus,canaliculus,canaliensis,cancellaria,cancellatim,cancellatio,cancellatus,cancellosus,cancrigenus,candelabrum,candelabrus,candidarius,candosoccus,canicularis,canistellum,canistraria,cannophorus,canonicalis,canonicatus,canonisatio,canonizatio,cantabrigia,cantabundus,cantatorium,canteriatus,canteriolus,cantharella,cantharites,cantharulus,cantherinus,cantiuncula,canusinatus,capeduncula,capellianus,capillaceus,capillatura,capillitium,capisterium,capitalista,capitaliter,capitolinus,capitularis,capitulatim,capitulatus,cappellania,cappellanus,capreaginus,capreolatim,capricornus,caprimulgus,caprinarius,caprunculum,captatorius,captiuncula,captivncula,carbasineus,carbonarius,carbunculor,carbunculus,carcedonius,carcerarius,cardiologia,cardiologus,caritativus,carnificina,carnificius,carnuficina,carnuficius,carnulentus,carpheothum,carphologia,carpisculus,carpusculus,carracutium,carrucarius,cassabundus,cassidarius,castanietum,castellanus,castellatim,castimonium,castrametor,catachresis,cataclistus,cataclysmos,cataclysmus,catafractes,catafractus,catagraphum,catagraphus,cataphracta,catapirates,cataplasmus,cataractria,catarhactes,catarractes,catascopium,catastatice,catastropha,catechismus,catechumena,categoricus,catervarius,catharticum,cathedralis,cathedratus,catoptritis,catorchites,catuloticus,caudatarius,caudicarius,cauliflorus,cauponarius,cauricrepus,causabundus,caussidicus,cautionalis,cavillatrix,celeberrime,cellariolum,cellerarius,cementarius,cementerium,cementicium,cementicius,cenacularia,cenaculatus,cenotaphium,centenarium,centenarius,centifolius,centimetrum,centinodius,centipellio,centonarius,centrifugus,centripetus,centunculus,centurialis,centuriatim,centuriatio,centuriatus,centurionus,cephalaeota,cephalalgia,cephalargia,cepolendrum,cepotaphium,cepotaphius,cercholopis,cerebellare,ceriolarium,ceriolarius,cernophorus,ceromaticus,certabundus,cervesarius,cervisiaria,cesticillus,chaerepolum,chalasticus,chalatorius,chalcanthon,chalcanthum,chalcidicum,chamaedrops,chamaeleuce,chamaemelon,chamaemilla,chamaepeuce,chamaepitys,chamaezelon,charisticum,charitative,charmidatus,chelidonias,chelidonium,chelidonius,cheragricus,chiliarches,chiliarchus,chimaerifer,chiragricus,chiramaxium,chiromantis,chirurgicus,chlamydatus,chondrillon,choraulicus,chordacista,chortalinus,chrematista,chrismarium,christiadum,christianus,christicola,chromaticos,chromaticus,chronologia,chrysanthes,chrysanthus,chrysocalis,chrysocolla,chrysolitus,chrysomelum,cicatricula,cicilendrum,cicimandrum,cimeliarcha,cimenterium,ciminterium,cimintorium,cincinnalis,cincinnatus,cincticulus,cinematicus,cinnamolgos,circitorium,circitorius,circulatrix,circumactio,circumactus,circumcaedo,circumcalco,circumcingo,circumcirca,circumcirco,circumcisio,circumcisus,circumclamo,circumcludo,circumctipo,circumculco,circumcurro,circumcurso,circumdatio,circumdatus,circumdoleo,circumfingo,circumfinio,circumfirmo,circumflexe,circumfluus,circumfodio,circumfremo,circumfrico,circumfundo,circumfusio,circumfusus,circumgesto,circumglobo,circumhisco,circumjaceo,circumjicio,circumlambo,circumlatio,circumlator,circumlatro,circumlinio,circumlitio,circumluceo,circumluvio,circummetio,circummingo,circummitto,circummugio,circummunio,circumnecto,circumobruo,circumpavio,circumplico,circumpulso,circumpungo,circumpurgo,circumrasio,circumretio,circumsalto,circumsecus,circumsedeo,circumsepio,circumsideo,circumsigno,circumsilio,circumsisto,circumsitus,circumsonus,circumstans,circumstruo,circumsurgo,circumsutus,circumtendo,circumteneo,circumtollo,circumtremo,circumtueor,circumustus,circumvagor,circumvagus,circumvallo,circumvecto,circumvehor,circumvenio,circumverro,circumverto,circumvolvo,circumvorto,cismontanus,cisterninus,cistophorus,citharoedus,civilizatio,clabularius,clamatorius,clandestino,classiarius,claudicatio,claustellum,claustralis,clavicarius,clavularius,clementinum,cleopiceton,clibanarius,clibanicius,clinopodium,clostrarius,coacervatim,coacervatio,coadjutoria,coapostolus,coassistens,cochlearium,cocilendrum,cocionatura,cocodrillus,coctilicius,cocturarius,codificatus,coelioticus,coelipotens,coemendatus,coemeterium,coenatorius,coencenatio,coenulentus,coepiscopus,coercitivus,cogitabilis,cognitorius,cognomentum,cohabitatio,cohabitator,cohaerenter,cohaerentia,cohibiliter,cohorticula,cohospitans,cointelligo,coleopteron,coleopterum,collactanea,collacticia,collaticius,collatitius,collaudatio,collaudator,collectivus,collegialis,collegiatus,colleprosus,collibertus,collicellus,colliciaris,colliquefio,colliquesco,colluctatio,collustrium,collutlento,colluviaris,collyriolum,colocynthis,coloniarius,coloniensus,colorabilis,coloratilis,colostratio,colostratus,columbarium,columbarius,columnarium,columnarius,comesaliter,comitabilis,commagister,commalliolo,commanducor,commaniplus,commanipulo,commeabilis,commeatalis,commendatio,commendator,commendatus,commensalis,commentatio,commentator,commercator,commictilis,commigratio,commilitium,comminiscor,comminister,commisarius,commisereor,commissatio,commissoria,commortalis,communiceps,communismus,commurmuror,compaciscor,compactilis,compactivus,comparative,comparturio,compatriota,compatronus,compeccator,compeciscor,compellatio,compendiose,compensatio,compertusio,competenter,competentia,competitrix,compitalium,compitensis,complacitus,complanator,completivus,complexitas,complexivus,complicatio,complicitas,comploratio,comploratus,compluriens,complurimus,comportatio,compositura,compraecido,comprecatio,comprehendo,comprehenso,comprobatio,comprobator,compromitto,compulsatio,compurgatio,computatrum,computresco,concalfacio,concallesco,concandefio,concandesco,concaptivus,concarnatio,concenturio,conceptivus,concertatio,concertator,concessatio,concessivus,conciliaris,conciliatio,conciliator,conciliatus,concinentia,concinnatio,concinnator,concinnatus,concinnitas,concinniter,concionalis,concionator,concisorius,concitatrix,conclamatio,conclamatus,conclavista,conclericus,concolorans,concordatio,concordatum,concorditas,concorditer,concrematio,concrepatio,concriminor,conculcatio,concurialis,concursatio,concursator,concustodio,condemnatio,condemnator,condensatio,condensatus,condescendo,condesertor,condimentum,conditaneus,conditarius,conditicius,condititius,conditorium,conditorius,condormisco,conducenter,confabricor,confamulans,conferentia,confermento,conferumino,confervefio,confervesco,confidenter,confidentia,confirmanda,confirmatio,confirmator,confirmatus,confirmitas,confiscatio,confiscator,conflabello,conflatilis,confligatus,confluentia,conformalis,conformatio,conformator,conformitas,confortatio,confracesco,confractura,confragosum,confragosus,confricatio,confusaneus,confusicius,congentilis,congermanus,conglobatim,conglobatio,conglutinor,congratulor,congregalis,congregatim,congregatio,congregator,congregatus,congruenter,congruentia,conisterium,conjectatio,conjectator,conjucundor,conjugialis,conjunctrix,conlactanea,conlacticia,conlaticius,conlatitius,conlaudatio,conlaudator,conlectivus,conlegialis,conlegiatus,conleprosus,conlibertus,conliciaris,conliquefio,conliquesco,conluctatio,conlustrium,conlutlento,conluviaris,conmensalis,connubialis,conpaciscor,conparative,conpatriota,conpatronus,conpeciscor,conpellatio,conpendiose,conpensatio,conpertusio,conpetenter,conpetentia,conpetitrix,conpitalium,conpitensis,conplanator,conplexivus,conploratio,conploratus,conpluriens,conplurimus,conportatio,conpositura,conpraecido,conprecatio,conprehendo,conprehenso,conprobatio,conprobator,conpromitto,conputresco,conquaestor,conquaterno,conquinisco,conquisitio,conquisitor,conquisitus,conrumptela,conruptivus,consacerdos,consacratio,consacrator,consacratus,conscientia,conscribtor,conscriptio,conscriptor,conscriptus,consecratio,consecrator,consecratus,consectatio,consectator,consemineus,consentiens,consermonor,conservatio,conservator,considerate,consignatio,consiliaris,consiliator,consiliosus,consobrinus,consociatim,consociatio,consociatus,consonanter,consonantia,consortalis,conspatians,conspiciens,conspiratio,conspiratus,consponsata,constabilio,constantius,constibilis,constipatio,constitutio,constitutor,constitutum,constitutus,constrictio,constrictus,constructio,consuefacio,consularius,consultatio,consultator,consultatum,consultivus,consummatio,consummator,consummatus,consumptrix,contagiosus,contemptrix,contentiose,conterminum,conterminus,conternatio,conterritus,contestatio,contestatus,conticinium,conticinnum,contignatio,continenter,continentia,continnatus,continuatim,continuatio,continuator,continuatus,continuitas,contionalis,contionator,contiuncula,contortulus,contractura,contrafacio,contrajuris,contraretus,contravenio,contremesco,contremisco,contremulus,contribulis,contributio,contributum,controverto,conturbatio,conturbator,conturbatus,conturmalis,convallaria,converritor,conversatio,conversator,conviciator,conviciolum,convitiator,conviventia,convivifico,convolvolus,cooperatrix,cooperculum,coordinatio,coordinatus,copertorium,copulabilis,copulativus,coquinarius,corcholopis,corcodillus,corcotarius,corniculans,cornucopium,coroliticus,corollarium,corporatura,corporicida,corpulentia,corpulentus,corpusculum,corrumptela,corruptivus,corymbiatus,cothurnatio,cothurnatus,cottidianus,covinnarius,crapularius,crapulentus,crassundium,crataegonon,crataegonos,crebrinodus,crebrisurus,crepidarius,crepusculum,cretifodina,cretulentum,criminatrix,crithologia,crocidismus,crocodileon,crocodilion,crocodillos,crocodillus,crocofantia,crocotarius,crocotillus,crocufantia,cruciabilis,crucigramma,crusmaticus,crystallion,crystallizo,cubicularis,cubiculatus,cucurbitula,cujuscemodi,culcitarius,cultellatus,cultellulus,cumulativus,cuncticinus,cuneiformis,cunicularis,cuniculatim,cuniculator,cuniculatus,cuniculosus,cunnilingus,cupressetum,cupressifer,cupressinus,curotrophoe,curvilineus,cylindratus,cylindricus,cylisterium,cymbalistes,cynoglossos,cynorrhodon,cynorrhodum,cynosorchis,cynospastos,cyparissias,cyparittias,cypressinus,daemoniacus,daemonicola,damnaticius,damnatitius,damnatorius,dardanarius,deambulatio,debacchatio,debellatrix,debilitatio,decachordum,decachordus,decalautico,decalicator,decalicatum,decalicatus,decalifacio,decatressis,decemmodius,decemprimus,deceptorius,decidiculum,decineratus,decineresco,declarative,decoloratio,decorativus,decrementum,decretarius,decretorius,dedecoratio,dedecorator,dedecorosus,dedicativus,deducibilis,deducticius,deductorium,deductorius,defarinatus,defatigatio,defecabilis,defeneratus,defensatrix,defensorius,defetigatio,deficientia,defigurstus,definienter,definitivus,deflagratio,defloccatus,defraudatio,defraudator,defrutarium,defrutarius,defunctorie,degeneratio,degrandinat,delenificus,deliberatio,deliberator,deliberatus,delimitatio,delinificus,deludificor,demagogicus,demarchisas,deminoratio,deminutivus,demissicius,demissitius,demographia,demographus,demosthenes,demutabilis,denariarius,dendroforus,denominatio,denominator,dentharpaga,denumeratio,denunciatio,denuntiatio,denuntiator,deontologia,deordinatio,depeculator,dependentia,deponefacio,depopulatio,depopulator,depositivus,depraedatio,depraedator,deprecaneus,deprecatrix,depreciator,deprehensio,depressivus,depretiator,deproelians,depulsorium,depulsorius,derectarius,derectitudo,derectorium,derectorius,deridiculum,deridiculus,derivativum,derivativus,desacrifico,desideratio,desideratus,desipientia,despectatio,despectator,desperanter,despoliatio,despoliator,desponsatio,desponsatus,desquamatum,dessignatio,dessignator,dessignatus,destillatio,destrangulo,desultorium,desultorius,desurrectio,detractatio,detractator,detractatus,detrectatio,detrectator,detrimentum,detruncatio,deversorium,deversorius,devolutivus,devorabilis,devorsorium,diacatochia,diacatochus,diaconandus,diadiapason,diaetarchus,diaeteticus,diaglaucion,diaglaucium,dialecticos,dialecticum,dialecticus,dialogismos,dialutensis,diametralis,diaphoresis,diapsoricum,diartymaton,diasyrticus,diatessaron,diatritaeus,dibrachysos,dicaeologia,dicasterium,dichomenion,dichoneutus,dictatorius,dictophonum,differenter,differentia,difficultas,difficulter,diffidenter,diffidentia,difformatas,digestorius,digitabulum,digressivus,dijudicatio,dijunctivus,dilaceratio,dilapidatio,dilapidator,diligibilis,dilucidatio,dimachaerus,diminutivum,dimissorius,dinumeratio,dinumerator,dioecesanus,diphthongus,diplinthius,diplomarius,diplomatice,directarius,directitudo,directorium,directorius,discapedino,disceptatio,disceptator,discomputus,disconvenio,discooperio,discophonum,discorditer,disdiapason,disertitudo,disgregatio,dispendiose,dispensatio,dispensator,disponsatio,disquisitio,disseisitor,dissignatio,dissignator,dissociatus,dissonanter,dissonantia,distillatio,distributio,distributor,distrinctio,dithalassus,dithyrambus,ditrochaeus,diuturnitas,divergentia,diversifico,diversorium,diversorius,divisibilis,doctiloquus,doctrinalis,dodecaedrum,dominativus,dominicalis,dominicanus,dormitorius,draconarius,draconigena,dracunculus,dragagantum,dromadarius,dromedarius,ducentensim,ducentensum,duellatorus,duellicosus,duodenarius,duplicarius,duriusculus,duumviralis,duumviratus,ecclesialis,echographia,eclectismus,effeminatus,efficacitas,efficaciter,efficienter,efficientia,ejulabundus,elasticitas,electrifico,electronica,electuarium,elementaris,elopsellops,emancipatio,emancipatus,embryotomia,emolumentum,emphyteusis,emphyteutis,emporeticus,emunctorium,enarmonicon,enarmonicus,enarrabilis,enchiridion,encolpismus,energumenus,enharmonios,enharmonius,entitativus,entomologia,entomologus,ephippiatus,epidicticus,epigonation,epilepticus,episcopalis,episcopatus,epistolaris,epistolella,epistularis,ermellineus,essentialis,essentifico,essurienter,ethologicus,eucharistia,euchologion,euresilogus,eurisilogus,evangelicus,evangelista,eventilatus,everriculum,evestigatus,exacerbatio,exacerbesco,exasperatio,exasperator,exaudibilis,exauguratio,excalceatus,excarnifico,excellenter,excellentia,excentricus,exceptorium,excogitatio,excommunico,excrementum,excusabilis,execrabilis,execribilis,executorius,exercitatio,exercitatus,exheredatio,exhonoratio,existimatio,existimator,existumatio,existumator,exitiabilis,exornatulus,expergiscor,experientia,expiatorius,explementum,exploratior,exprobratio,expurigatio,exquaesitio,exquaesitor,exsaturatus,exsecrandus,exsecutivus,exsequialis,exsistentia,exspectatio,exsufflator,exsuflatora,exsurrectio,exterminium,extollentia,extremismus,extrinsecus,exulceratio,fabricensis,fabulositas,facinarosus,facinerosus,facinorosus,faeculentia,faeculentus,faenisecium,faenisicium,faetulentus,fallaciosus,falsiloquus,famigerator,fascisticus,fastidiosus,favorabilis,feclinditas,feministria,ferentarius,fermentatus,ferramentum,ferrugineus,festinanter,festinantia,fiduciarius,figuraliter,firmamentum,flagitiosus,flagritriba,flandrensis,flexiloquus,floccifacio,floccipendo,fluctivagus,fluorescens,fluviaticus,fluviatilis,foedifragus,foetulentus,formalismus,formamentum,formidilose,formidolose,formidulose,formonsulus,fornicarius,fornicatrix,fraterculus,fraternitas,fraudulosus,fremebundus,fribusculum,frigidarium,frigidarius,frigusculum,fructuarius,frugiferens,frumentatio,frumentator,frutectosus,fulcimentum,fundamentum,fundimentum,futurologus,fylacterium,galericulum,gallicinium,gallinaceus,gallinacius,gallinarius,gaudimonium,gemellipara,generalitas,generaliter,generativus,generilitas,generositas,geniculatus,gentilicius,gentilismus,gentilitius,geochemista,geodaeticus,geometricus,glottologia,glycyrrhiza,gradualitas,gradualiter,graecanicus,grammaticus,grammatista,grandiculus,gratulanter,gregorianus,gubernatrix,gymnasticus,habitabilis,habitaculum,haemorrhois,haemorroida,halicastrum,halucinatio,hammonitrum,harenivagus,harundineus,haruspicina,haudquaquam,hellenismus,hemicyclium,hemiditonus,heortologia,herbipotens,heremiticus,hermitonium,heterogenia,heuristicus,hiacinthina,hierosolyma,hippocampus,hippodromos,hipponensis,hippotoxota,historialis,holocaustum,holosericum,holosericus,homileticum,homileticus,homiliarium,homographus,homoiousius,honorabilis,honorificus,hoplomachus,hortalitium,hortamentum,hortatorius,hostimentum,humicubatio,hyacinthina,hydrargyrus,hydraulicus,hydrogenium,hydromellum,hydroplanum,hygrometrum,hymnologion,hyperboleus,hyperbolice,hypnotismus,hypocauston,hypocaustum,iacinthinus,iconastasis,iconoclasta,iconomachus,ideologicus,idololatres,idololatria,idololatrio,idololatris,idololatrix,idolothyton,idolothytum,idolothytus,ignistitium,ignitabulum,ignobilitas,illegitimus,illiberalis,illiteratus,illuminatio,illuminator,imaginarius,imitamentum,immaculatus,immansuetus,immemoratio,immemoratum,immemoratus,immissarium,immobilitas,immobiliter,immoderatio,immoderatus,immodulatus,immutabilis,immutilatus,impaciencia,impacificus,imparilitas,impatiencia,impatientia,imperceptus,impercussus,imperfectio,imperfectus,imperfossus,impermissus,impetibilis,implantatio,importuosus,improdictus,improfessus,improperium,improtectus,imprudenter,imprudentia,impudicitia,imputabilis,inabsolutus,inaniloquum,inaniloquus,inanimentum,inanloquium,inauguralis,incantatrix,incapabilis,incapacitas,incensarium,incensorium,incessanter,incestuosus,incidentium,incineratio,inciviliter,inclementer,inclementia,incogitatus,incolumitas,incomitatus,incompertus,incompetens,incomposite,inconcessus,inconcinnus,inconcussus,incongruens,inconruptio,inconruptus,inconsultus,incontanter,incontentus,incontinens,incorporeus,incorrectus,incorruptio,incorruptus,incrassatus,increbresco,incrementum,incunabulum,incunctatus,indeficiens,indelebilis,indelibatus,independens,indeprensus,indevitatus,indicativus,indifferens,indignanter,indiscretim,indiscretus,indomabilis,indubitatus,indulgenter,indulgentia,indultarius,indupeditus,industriose,ineffabilis,inexhaustus,infantarius,infelicitas,infeliciter,infidelitas,infidibulum,inflammatio,inflammatus,infortunium,ingeniarius,inhaeredito,inhonoratio,inhonoratus,inhumanatio,inhumanatus,inhumanitas,inidoneitas,injudicatus,inlecebrose,inliberalis,inlicitator,inlocabilis,inluminatio,inmaculatus,inmemoratio,inmemoratum,inmemoratus,inmutabilis,innumeralis,inobauditio,inoboediens,inordinatim,inordinatio,inordinatum,inordinatus,inpaciencia,inpatiencia,inpatientia,inperfectio,inperfectus,inprudenter,inprudentia,inregularis,inreligatus,inreligiose,inrepticius,inrequietus,inresolutus,inrevocatus,insalutatus,insanabilis,inseparatus,insipienter,insipientia,insolubilis,inspoliatus,instauratio,institorium,institorius,insuefactus,insultuosus,intaminatus,integrismus,intellectus,intellegens,intemeratus,intemperans,intemperate,intemperies,intempestus,intemptatus,intercapedo,interceptor,intercessio,intercessor,intercursus,interdictum,interductus,interequito,interfectio,interfector,interjectio,interjectus,interloquor,interludium,interlunium,intermedius,intermisceo,intermissio,intermorior,internascor,internodium,interpretor,interregnum,interruptio,intersaepio,interscindo,interstitio,intertextus,intervallum,intervenium,interventus,intractatus,intrinsecus,introductio,introspicio,introversio,intumulatus,inventarium,inveterasco,inveteratio,inveteratus,invisitatus,invitabulum,iracunditer,irascibilis,irenacentia,irregularis,irreligatus,irreligiose,irrepticius,irrequietus,irritabilis,irrotulatio,ischiadicus,israhelitis,iureiurando,iusiurandum,jocinerosus,jocundiatas,judiciarius,jureperitus,jurisdictio,jusjurandum,justinianus,juvenaliter,kalendarium,kalumniator,labdacismus,labyrinthus,lacticinium,laetabundus,lamentarius,lampadarium,lampadarius,languefacio,languidulus,lantgravius,largiloquus,laserpicium,latebricola,latericulus,laticlavius,latifundium,latreuticus,latrocinium,latrunculus,lecticarius,lectiuncula,legionarius,legisdoctor,legitimatio,legitimitas,lemniscatus,lentiscifer,leopardalis,lethargicus,leucacantha,liberalitas,liberaliter,libidinosus,libramentum,librariolus,licentiosus,liciatorium,limitrophis,limitrophus,lineamentum,linguistica,liniamentum,lintriculus,liquamentum,liquefactio,litterarius,litteratura,locumtenens,logarithmus,logicaliter,loginquitas,longaevitas,longiturnus,ludibriosus,ludificatio,ludificator,luscitiosus,luticipulum,lymphaticus,macaronicus,macedonicus,machinarius,macrocollum,macrocosmus,maestificus,magisterium,magisterius,magistratus,magnetismus,magniloquus,mahometanus,majestuosus,malachiteus,malagranata,maliloquium,malobathrum,malogranata,mandragoras,manipretium,manipularis,manipulatim,mansiuncula,mansuefacio,mantelletum,manufollium,manulearius,manupretium,manutergium,masochismus,masterbator,masturbatio,masturbator,materiarius,materiatura,mathematica,matricidium,matrimonium,maximalista,mechanismus,mediaevalis,mediastinus,medicabilis,mediocritas,mediocriter,meditamenum,meditullium,meliusculus,memorabilis,menstrualis,menstruatio,mensularius,mercenarius,mercimonium,meretricium,meretricius,meretricula,metabolicus,metaphysica,metaplasmus,metropolita,metuculosus,micrometrum,microphonum,militariter,millefolium,millimetrum,mimographus,mineralogia,minimalista,ministerium,mirabilitas,mirabiliter,mirificatio,miserabilis,missionalis,mithridates,mitificatio,moderabilis,modificatio,modificatus,moestificus,molctaticus,molecularis,momentaneus,momentarius,monachismus,monarchicus,monasterium,monetabilis,monocerotos,monochordon,monochordum,monogenesis,monogrammos,monogrammus,monographia,monosyllaba,monotheista,monstruosus,morphologia,mortariolum,mulctaticus,mulctrarium,mulierarius,mulomedicus,multangulus,multaticius,multifariam,multifarius,multiformis,multiloquax,multiloquus,multiplicus,multizonium,mundialiter,municipalis,municipatim,municipatio,municipatus,munificator,mutabilitas,mutabiliter,myriophllon,myriophllum,myrobalanum,mythistoria,narcissinus,nartatorius,nasalizatio,naturaliter,nauclericus,navicularia,navigabilis,necessarium,necessarius,necessitudo,neclegenter,neclegentia,necopinatus,necrocomium,neglegenter,neglegentia,negligenter,negligentia,nemorivagus,neolithicus,neologismus,nepiagogium,nequissimus,neutralitas,nihilominus,nineviticus,niniviticus,nocturnalis,nomenclator,nominativus,nominetenus,notificatio,novendialis,nubeculatus,nugigerulus,numerabilis,numerositas,nummularius,nuncupatura,nutrimentum,nyctegreton,nyctegretos,oblanguesco,oblaqueatio,obliurgatio,obnubilatio,obnuntiatio,oboedienter,oboedientia,oboedientio,obscaenitas,obsequenter,obsequentia,obsequiosus,obsetricium,obsetricius,obsolefacio,obtrectatio,obtrectator,occultismus,octachordos,octavodecem,odontologia,odoramentum,oecologicus,oecologista,oeconomicus,oecosystema,oecumenicus,offertorium,offerumenta,officiarius,oleaginosus,olfactorium,olfactorius,onocrotalus,operatorius,operimentum,ophiomachus,opitulatrix,oportunitas,opscaenitas,oratiuncula,orbicularis,orbiculatus,organizatio,oricalcinus,oricularius,orthopaedia,ortygometra,oscillatrum,ossiculatim,ostracismus,pacificator,paederastes,paedophilia,paedophilus,paenitentia,paenularius,palaestrice,palaestrita,pampinarium,pampinarius,panchrestus,panchristus,pantheismus,pantopolium,parabolicus,paragraphum,paragraphus,paralyticus,paraphrasis,parasiticus,parastatica,paraveredus,parcometrum,parenthesis,parietarius,parlamentum,parochialis,parochianus,paroecialis,paroecianus,parricidium,participium,parvissimus,passerculus,pastoforium,pastoforius,pastoricius,patibulatus,patriarches,patriciatus,patrimonium,patrioticus,patrocinium,paullulatim,pauperculus,paupertinus,pauxillulus,peccabundus,peccadillum,peccatorius,pectunculus,pectusculum,pecuniarius,pedetemptim,pediculosus,pedifollium,pedissequus,peiorativus,penetranter,pennipotens,perabsurdus,perangustus,perantiquus,perattentus,percallesco,percommodus,percontatio,percontator,percontumax,percrebesco,percuriosus,percursatio,perdiligens,perdormisco,peregrinans,pereloquens,pereruditus,perexcelsus,perfidiosus,perflabilis,perfrequens,perfrigesco,perfrigidus,perhorresco,perhorridus,periculosus,perinfirmus,peripetasma,periphrasis,peristereos,peristylium,peritonitis,perjucundus,permissivus,permitialis,permodestus,permolestus,perniciosus,perobscurus,perparvulus,perpauculus,perpetualis,perpetuitas,perplicatus,perpusillus,perridicule,perscienter,perscriptio,perscriptor,persentisco,perseverans,personifico,perspectiva,perspeculor,perstudiose,persubtilis,perterritus,pertricosus,perturbatio,perturbatus,pessimismus,pestilentia,petroleifus,pharetratus,pherecratus,philargyria,philargyros,philosophia,philosophor,philosophus,phonodiscus,photographo,phreneticus,phthiriasis,physiologia,physiologus,pilamalleus,pinacotheca,pinacothece,piscatorius,piscinarius,pisculentum,pisculentus,placamentum,plausibilis,plebiscitum,pleuriticus,plorabundus,pluralismus,plurifariam,pneumaticus,poenitentia,polyclinica,pontificius,populabilis,popularitas,populariter,porcellanum,porcellanus,porcinarius,portisculus,portiuncula,posculentus,postgenitus,postremitas,pradatorius,praeambulum,praeambulus,praecalidus,praecantrix,praecellens,praeconsumo,praecordium,praedelasso,praedestino,praedicatio,praefectura,praefestino,praefiscine,praefiscini,praefractus,praefurnium,praegelidus,praegredior,praelonguus,praelustris,praematurus,praemeditor,praemonitus,praemunitio,praenatalis,praenubilus,praenuntius,praeparatio,praepilatus,praepinguis,praepondero,praepositio,praepositus,praerapidus,praerigesco,praerogatio,praerogatus,praesaepium,praesaeptus,praesagitio,praesidalis,praestantia,praestigium,praestrigia,praestringo,praesulatus,praesumptio,praesumptor,praeterbito,praeterduco,praeterfluo,praeteritum,praeteritus,praeterquam,praetervolo,praetinctus,praetorqueo,praetrepido,praetumidus,praevalidus,praevaricor,praeverbium,pragmaticus,precabundus,preconcipio,presbyterus,pretiositas,primicerius,primigenius,primogenius,principalis,principatus,principissa,privilegium,problematum,procellosus,proclamator,proclivitas,procrastino,procursatio,procursator,prodeambulo,prodecessor,prodictator,prodigentia,prodigialus,prodigiosus,proficiscor,profligatus,profluentia,profunditas,prognariter,progymnasma,prohibesseo,projectilis,proletarius,promagister,promercalis,prominentia,promptarium,promptarius,promtuarium,promtuarius,promulgatio,properanter,properantia,properantim,propitiatio,propitiator,propugnatio,propugnator,propulsatio,proquaestor,proscaenium,proscriptio,proscriptus,prospeculor,prosperitas,prostibilis,prostibulum,prostitutio,prostitutus,proteruitas,protestatio,protomartyr,providentia,pseudonymum,psychiatria,psychologia,psychologus,ptisanarium,publicianus,pugiunculus,pulchritudo,pulpamentum,pultiphagus,pulvisculus,purgamentum,purgatorium,purificatio,putrefactio,pycnostylos,pyrrhichius,quadamtenus,quadantenus,quadraginta,quadrifidus,quadrigatus,quadrijugis,quadrijugus,quadrimulus,quadriremis,quadruplico,quaestorium,quaestorius,quaestuosus,qualislibet,quartadecem,quattuorvir,quemadmodum,queribundus,quinquatrus,quinquennis,quintadecem,quotidianus,radiophonia,radiophonum,rassisticus,ratificatio,ratihabitio,ratiocinium,rationalizo,rationarium,raudusculum,realisticus,rebellatrix,recalvaster,reciperatio,reciperator,rectilineus,recuperatio,recuperator,redimiculum,redituarius,redundantia,reduntantia,refectorium,refectorius,refrigerium,regeneratio,regressivus,regulariter,reimpressio,rejectaneus,relanguesco,relativitas,rememoratio,remuneratio,renascentia,renuntiatio,reparabilis,repercussio,repercussus,repraesento,reprehensio,reproductio,repromissio,repromissum,repugnantia,resonabilis,responsalis,responsoria,resurrectio,reticulatus,retinaculum,retroversus,reubarbarum,revocabilis,revolubilis,robiginosus,romanciator,rubramentum,sabbatismus,saccharatus,saccharinum,sacciperium,sacerdotium,sacramentum,sacrificium,sacrilegium,sacristanus,saepenumero,saepimentum,sagittarius,saisimentum,salariarius,salsamentum,saltatorius,samaritanus,samariticus,sanctimonia,sanctuarium,sarcinarius,sarcophagus,sardonichus,satellitium,satisfactio,saturnalium,scaciludium,scandalosus,scapularium,scariphatio,scenophegia,schoenicula,scholastica,scintillula,scirpiculus,scitamentum,scolasticus,scriptorius,scripulatim,scrupulosus,scrutabilis,scurrilitas,scurriliter,secretarium,secretarius,secularitas,seculariter,secundarius,sedentarius,segmentatus,sellariolus,sellularius,sementerium,semiapertus,semicintium,semiditonus,semimarinus,seminarista,semiophorum,semipaganus,semiputatus,semisupinus,semivocalis,sempiternus,sensualitas,sententiola,sententiose,sentimentum,separatista,septemdecim,septemfluus,septenarius,septendecim,septicaemia,septicollis,septifarius,septiformis,septiformus,septingenti,septuaginta,sepulcralis,sepulcretum,sermunculus,serpentipes,sescenarius,sesquealter,sesquialter,sesquinonus,sesquiplaga,sessiuncula,sexcenarius,sexquartano,sexquialter,sexquintano,siccatorium,significans,silentiosus,silicernium,silvifragus,simenterium,siminterium,simintorium,simplicitas,simpliciter,singillatim,sinisbrosus,sinistrosum,siphonarius,siphunculus,siticulosus,smaragdinus,smaragdites,socialismus,solempniter,soliferreum,sollemnitas,sollemniter,sollemnitus,sollicitudo,sollistimus,soloecismus,somniculose,somnolentus,sophisticus,specialista,specialitas,specialiter,spectabilis,spectaculum,spiramentum,spiritualis,spirituosus,spontaliter,spurcidicus,spurcificus,staphylinus,statisticus,statulibera,stentaculum,stercilinum,stercoralis,stercorinis,stercorosus,sterculinum,stereotypus,sternutatio,stilisticus,stimulatrix,stlattarius,stomachosus,stultividus,subabsurdus,subagitatio,subagrestis,subaqueanus,subausculto,subausterus,subconscius,subdiaconus,subditicius,subhorridus,subigitatio,subimpudens,subinsulsus,subjugalium,subministro,submolestus,subobscenus,subobscurus,subrancidus,subrusticus,subsannatio,subsannator,subsidialis,subsignanus,subsortitio,substitutio,substitutus,substrictus,substructio,subsumentum,subterfugio,subterlabor,succenturio,succingulum,succlamatio,suffimentum,suffragatio,suffragator,suicidarius,sumministro,summolestus,sumptuarius,superabilis,superabundo,superadicio,superappono,supercilium,supercilius,superductio,superemineo,superexalto,superexcedo,superficies,superimpono,superinicio,superinungo,superoccupo,superscando,supersilium,supersterno,superstitio,superurgens,supervacuus,superventus,supervolito,suppernatus,supplicatio,suppliciter,suprascando,suprascribo,surruncivus,suspiciosus,suspiriosus,sustentatus,susurratrix,sycophantia,sycophantor,syllogismos,syllogismus,symposiacus,synagraphus,synchronizo,syntheticus,tabellarius,tabernarius,tachometrum,tauriformis,tauromachia,technocrata,technologia,tectoriolum,telegraphia,telegraphum,telehorasis,telephonema,telescopium,teloniarius,temperanter,temperantia,tempestivus,temporaneus,temporarius,tentabundus,tentamentum,terebenthos,terebenthus,terebinthos,terebinthus,tergiversor,terraemotus,terriloquus,territorium,terrorismus,tessellatim,tessellatus,tesserarius,testamentum,testimonium,testudineus,tetracordos,tetrastylon,tetrastylos,thalassicus,thalassinus,thensaurius,theologicus,thymaterium,tinnimentum,tolerabilis,tolerabiter,tomographia,tonitrualis,tonstricula,torcularium,torcularius,toxicologia,toxicologus,toxicomania,tractabilis,tragelaphos,tragelaphus,traiectoria,tralaticius,tranquillum,tranquillus,transcursus,transeunter,transfiguro,transformis,transfugium,transitorie,transmissio,transmissus,transnavigo,transnomino,transpectus,transplanto,transscendo,transscribo,transspicio,transvectio,transversus,transvolito,transvorsus,traumaticus,tremebundus,trepidanter,tribunicius,tribunitius,tributarius,tributorium,tributorius,tricenarius,tridentifer,tridentiger,trierarchus,trietericum,trietericus,trifurcifer,trimenstris,trinitarius,tripartitus,tripectorus,tripedaneus,triplicitas,tripliciter,triscurrium,tristiculus,tristificus,tristimonia,triumphalis,trivenefica,tromocrates,tromocratia,tropologice,truculenter,truculentus,tumultuatio,tumultuosus,turbamentum,turbulentus,turpilucris,turpilucrus,tympanistes,typographia,typographus,tyrannicida,umbraticola,undeviginti,unguentatus,unianimiter,uniformiter,universalis,universalus,universitas,unumquodque,unusquisque,usquequaque,vaframentum,vascularius,vaticinatio,vaticinator,vegetabilis,vegetativus,velificatio,velificator,venerabilis,ventilabrum,ventilagium,ventilatrum,ventimolina,ventriculus,vergobretus,veriloquium,verisimilis,versabundus,versipellis,vespasianus,vespertilio,vespertinus,vestimentum,veteratorie,vexillarius,vexilliatio,vicedominus,vicesimanus,vicissitudo,victimarius,victoriatus,victoriosus,vigilabilis,villenagium,vindemiator,virginarius,viridiarium,virtualitas,virtualiter,virtualosus,visibiliter,vituperatio,vociferatio,volatilitas,volubilitas,voluntarius,voluptarius,voluptuosus,voraginosus,vorsipellis,xenodochium,xerampelina,zenodochium,zmaragdinus,zmaragdites,abecetuorium,abominabilis,absolutismus,absolutorium,absolutorius,acceleratrum,accensibilis,acceptabilis,acceptilatio,accessibilis,accidentalis,accommodatio,accommodatus,accubitorius,accumulatrum,accusatorius,aciscularius,acoenonoetus,acratophorum,acroamaticus,acrocolefium,actinophoros,adadunephros,adcessibilis,adcommodatio,adcommodatus,adcubitorius,additamentum,adfabricatus,adfirmativus,adhortativus,adjuramentum,adjuratorius,adjuvatorium,adlaudabilis,adlenimentum,adlevamentum,adligamentum,admensuratio,admonitorium,admurmuratio,adnotamentum,adnuntiatrix,adolescentia,adoptaticius,adparamentum,adparatorium,adparitorius,adpellativus,adpropriatio,adquietantia,adscriptivus,adsentatorie,adseveranter,adsimilanter,adsimulanter,adspersorium,adstipulatio,adstipulator,adstipulatus,adtertiarius,adtractorius,adtubernalis,adulescentia,adversabilis,adversativus,advesperasct,advocamentum,advorsabilis,aedificialis,aedificiolum,aegocephalus,aenigmaticus,aenigmatista,aenitologium,aequabilitas,aequabiliter,aequanimitas,aequanimiter,aequicrurius,aequidistans,aequilatatio,aequilaterus,aequilibrium,aequinoctium,aequiparatio,aequiperatio,aequipollens,aequipondium,aeronauticus,aeronavigans,aerumnabilis,aeschrologia,aeschynomene,aestimabilis,aestuabundus,aeternabilis,aeternaliter,affabricatus,affirmativus,agathodaemon,agnicellulus,agnitionalis,agnoscibilis,agonistarcha,agriophyllon,alabastrites,alabastritis,albicapillus,alcoholismus,alexandrinus,alimentarius,aliquantulum,aliquantulus,aliquovorsum,allaudabilis,allenimentum,allevamentum,alligamentum,alphabeticus,alteramentum,altercabilis,alterinsecus,alterutraque,alterutrique,altipendulus,alucinogenus,alumentarius,amasiunculus,ambivalentia,ambulatorius,ambustulatus,amethystinus,amethystizon,amicabiliter,ammonitorium,ammurmuratio,amovibilitas,ampelodesmos,ampeloprason,amphemerinos,amphibologia,amphibrachus,amphimallium,amplificatio,amplificator,ampliusculus,amygdalaceus,anabaptismus,anacampserox,anaglypticus,analphabetus,anapaesticum,anapaesticus,anapauomenos,anathematizo,anathymiasis,aneclogistus,anfractuosus,animadversio,animadversor,animaequitas,anniversarie,annotamentum,annuntiatrix,antamoebaeus,antemeridies,antependulus,anteportanus,anterioritas,antescolanus,antesignanus,antetestatus,anteventulus,anthologicon,anthypophora,antibioticum,antibrachium,antichristus,antichthonis,antidactylus,antimetabole,antiochensis,antiphonalis,antiphonatim,antisepticum,antisepticus,antisophista,antistoechum,aphrodisiaca,aphrodisiace,apologeticus,apoplecticus,apothecarius,apparamentum,apparatorium,apparitorius,appellativus,applicabilis,appropriatio,apsolutorium,apsolutorius,aquiminarium,archaeologia,archaeologus,archibucolus,archibuculus,archielectus,archierosyna,archimagirus,archisterium,architectura,arctophyllum,arcubalistus,arcuballista,argentoratus,argumentalis,argumentatio,argumentator,argumentosus,aristolochia,arithmeticum,arithmeticus,armilustrium,armipotentia,arrhenogonos,arteriotomia,arteriotonia,articularius,artificialis,artificiatus,artificiosus,ascendibilis,ascripticius,aspectabilis,aspernabilis,assentatorie,asseveranter,assimilanter,assimulanter,asspersorium,astrictorius,astronomicus,astrophysica,atricapillus,attemperatio,attertiarius,attractorius,attubernalis,auctionarius,auctorabilis,auctorizatio,aucupabundus,aucupatorius,auditiuncula,auditorialis,auguratorium,auricalcinus,auricularius,auriphrygius,auriscalpium,auspicabilis,auspicaliter,autochthonus,automatarium,automatarius,automatismus,baccalaureus,bacchabundus,bacchanalium,bactericidus,bactroperita,bajulatorius,balineaticum,ballaenaceus,ballematicus,ballistarium,ballistarius,balneatorius,baptisterium,barbarolexis,barycephalus,basternarius,beatificatio,belligerator,beneficentia,beneficiatus,beneplacitum,beneplacitus,benevolentia,benivolentia,bethleemites,bibliopolium,biographicus,biotechnicus,biselliarius,blandicellum,blandiloquus,blandimentum,blasphematio,bombardarius,bostrychitis,brachiolaris,breviloquens,breviloquium,buxeirostris,caecutientia,caementarius,caementicium,caementicius,caementitium,caementitius,caernophorus,caespiticius,caesticillus,calcariarius,calcariensis,calceamentum,calceolarius,calciamentum,calciolarius,callipetalon,callisphyros,callitrichon,callitrichos,calumniatrix,camelopardus,camelopodium,campestratus,cancellarius,canceraticus,canonicarius,cantabrarius,cantheriatus,cantheriolus,cantilenosus,caphisterium,capistrarius,capitalismus,capitaneatus,capitilavium,capitularium,capitularius,caprificatio,carchedonius,carcinethron,carcinothron,cardinalatus,cardinaliter,cardiogramma,carpentarius,carpophyllon,carrobalista,carthusianus,caryophyllon,caryophyllum,casetophonum,cassiterinus,castellarius,castigabilis,castorinatus,castramentor,castratorius,catabolensis,catalecticos,catalecticus,catalepticus,cataphractes,cataphractus,catarrhactes,catechisatio,catechiticus,catechumatus,catechumenus,cathartarium,cathecuminus,cathedrarius,catholicitas,cauliculatus,causidicalis,causidicatio,causidicatus,celebrabilis,celeriuscule,cellariarius,cellariensis,cellerarissa,cenacularius,cenatiuncula,centesimalis,centigrammum,centigranius,centiplicato,centuplicato,centupondium,cepolartitis,cepotafiolum,ceremonialis,ceremoniosus,cerimonialis,cerimoniosus,ceroferarium,ceroferarius,certificatio,certificatus,cervisiarius,chalcedonius,chalcophonos,chamaecissos,chamaedaphne,chamaedracon,chamaetortus,chamedyosmos,charitativus,chartiaticum,chartophylax,chartularius,chenoboscion,chiliodynama,chiliometrum,chirographon,chirographum,chirographus,choragiarius,choriambicus,chorographia,chorographus,christianizo,christigenus,chrysatticum,chrysendetum,chrysendetus,chrysocarpos,chrysocarpus,chrysococcus,chrysolampis,chrysolithos,chrysolithus,chrysomallus,chrysopastus,chrysoprasos,chrysoprasum,chrysoprasus,chrysopteros,chrysothales,cicatricosus,cinaedologos,cinnamominus,circamoerium,circumaggero,circumambulo,circumcisura,circumclaudo,circumcumulo,circumcursio,circumductio,circumductor,circumductum,circumductus,circumequito,circumfarcio,circumflagro,circumflecto,circumflexio,circumflexus,circumfossor,circumfulcio,circumfulgeo,circumiectum,circuminicio,circumjacens,circumjectio,circumjectum,circumjectus,circumlabens,circumlaqueo,circumloquor,circumlucens,circumlustro,circumluvium,circummoenio,circummulceo,circumnavigo,circumpendeo,circumplaudo,circumplecto,circumplexus,circumplumbo,circumquaque,circumrefero,circumsaepio,circumscalpo,circumscindo,circumscribo,circumseparo,circumseptus,circumsessio,circumsocius,circumspargo,circumspecte,circumspecto,circumspergo,circumspicio,circumstagno,circumstatio,circumstrepo,circumstupeo,circumtectus,circumtentus,circumtergeo,circumtextum,circumtextus,circumtinnio,circumtondeo,circumtonsus,circumvectio,circumvector,circumventio,circumventor,circumversio,circumversor,circumversus,circumvestio,circumvincio,circumvolito,circumvorsor,cissanthemos,cissanthemus,cistellatrix,citharistria,clancularius,clandestinus,clarificatio,claustrarius,claviclarius,climatologia,clitellarius,cluniacensis,coagmentatio,coassamentum,cocinatorium,cocinatorius,cocitatorius,codicillaris,coenobiarcha,coenobiticus,cogitabundus,cogitamentum,cogitatorium,cognominatio,cognominatus,cognoscenter,cohortalinus,coincidentia,coinquinatio,coinquinatus,collabefacto,collaboratio,collaceratus,collactaneus,collacticius,collateralis,collectaneum,collectaneus,collectarium,collectarius,collecticius,collectitius,collectorium,collegiarius,collocupleto,colonialista,colubrimodus,columbulatim,columellaris,comessaliter,comestabilia,comestabilis,comestibilis,comisabundus,comissaliter,comitatensis,comitialiter,commaceratio,commanifesto,commanipulus,commaturesco,commembratus,commemoratio,commemorator,commendatrix,commentarium,commentarius,commenticius,commentitius,commetaculum,comminativus,commiseratio,commiseresco,commiseresct,commissarius,commissorius,commoderatus,commodulatio,commonefacio,communicatio,communicator,communicatus,commutabilis,compacticius,compactitius,compaginatio,comparabilis,comparativus,comparticeps,compendiaria,compendiosus,comperendino,comperpetuus,compinguesco,complantatio,complementum,completorium,complusculus,complusicule,compluviatus,componderans,compositivus,compossessor,comprehensio,compresbyter,compromissio,compromissum,comptionalis,compugnantia,computabilis,concalefacio,concamaratio,concamaratus,concameratio,concameratus,concatenatio,concentricus,conchiclatus,conchortalis,conchyliatus,conciliatrix,conciliatura,concineratus,concinnitudo,concionarius,concludenter,concomitatus,concordantia,concordialis,concorporeus,concrebresco,concrementum,concrucifigo,concrustatus,conctabundus,concubinalis,concubinatus,concubitalis,concumulatus,concurrentia,condecuralis,condensatrum,condescensio,condicticius,condictitius,condiscipula,conditionate,condocefacio,conducibilis,conducticius,conductitius,confabulatio,confabulator,confabulatus,confarreatio,confectorium,confederatio,conferrumino,confessarius,confessorius,configuratio,configuratus,confirmandus,confirmative,confirmatrix,conflaccesco,conflagratio,conflagratus,conflatorium,conflictatio,conflictatus,confoedustus,confrequento,congeminatio,congesticius,congestitius,conglorifico,congluvialis,coniunctivus,conjectaneum,conjectarius,conjecturale,conjugatiter,conjunctivus,conlabefacto,conlaceratus,conlactaneus,conlacticius,conlectaneus,conlectarius,conlecticius,conlectitius,conlegiarius,conlocupleto,conmemoratio,conmemorator,conmonefacio,connumeratio,conparabilis,conparativus,conpendiaria,conpendiosus,conperendino,conplementum,conplusculus,conplusicule,conpluviatus,conprehensio,conpromissum,conputabilis,conquassatio,conrecumbens,conresuscito,conruptorius,consacraneus,consacratrix,consalutatio,consanguinea,conscribillo,consecraneus,consecratrix,consectandus,consectaneus,consectarium,consectarius,consectatrix,conseminalis,consensualis,consentaneum,consentaneus,consequenter,consequentia,conservatrix,conservitium,consideratio,considerator,consideratus,consignifico,consiliarius,consiliatrix,consimiliter,consistentia,consistorium,consolabilis,consolativus,consolatorie,consolidatio,consolidator,conspicillum,conspissatio,conspissatus,conspurcatus,constantinus,constellatio,constellatus,consternatio,consternatus,constrictive,constrictura,constuprator,consularitas,consulariter,consumptivus,consurrectio,contabefacio,contabulatio,contaminatio,contaminator,contaminatum,contaminatus,contemnendus,contemnenter,contemplatio,contemplator,contemplatus,contentiosus,conterraneus,contheroleta,contingenter,continuanter,contionarius,contitularis,contrabassum,contraceptio,contradictio,contradictor,contrafactio,contrapondus,contrarietas,contrascriba,contrascribo,contraversia,contraversim,contraversum,contraversus,contrectatio,contrectator,contristatio,controversia,controversor,controversum,controversus,contrpunctum,contubernium,contubernius,contumaciter,contumeliose,convalescens,convalidatio,convenienter,convenientia,conventicium,conventicius,conventitium,conventitius,conventualis,convergentia,conveteranus,cooperativus,coopertorium,coperimentum,coralliticus,coriaginosus,coriandratum,corinthiacus,corinthienis,corniculatus,corolliticus,coronamentum,corporalitas,corporaliter,corporativus,correcumbens,correlativus,correspondeo,corresuscito,corrumptella,corruptorius,corycomachia,cosmographia,cosmographus,cosmologicus,crassamentum,crassivenius,credentarius,credibiliter,crepitaculum,criminalitas,criminaliter,criminologia,criminologus,crispatorium,crocodillina,crocophantia,crocyfantium,crotalistria,cruciabundus,cruciamentum,cruciatorius,cruciverbium,cruricrepida,crurifragium,crurifragius,crustallinum,crustallinus,crustularius,crystallinum,crystallinus,cubicularius,cucumeraceus,cucumerarium,cucurbitatio,cucurbitinus,cucurbitivus,cujusdammodi,cujusquemodi,culpabilitas,culpabiliter,cunctabundus,cunctiparens,cunctipotens,cunicularius,cupedinarius,curatoricius,curatoritius,custodiarium,custodiarius,cyberneticus,cymbalistria,cynocardamon,cynocephalea,cynocephalia,cynocephalus,cyparissifer,damnabiliter,damnigerulus,dampnaticius,dampnatitius,dealbamentum,deambulacrum,deargumentor,decemmestris,decempedalis,decempedator,decemscalmus,decemviralis,decemviratus,decennovalis,decervicatus,declamatorie,declarativus,declinabilis,decompositus,decorticatio,decrescentia,decretalista,decurionalis,decurionatis,decurionatus,dedicatorius,defaecabilis,defectibilis,defensabilis,defensibilis,defervefacio,defraudatrix,defunctorius,dehonestatio,dehortativus,delacrimatio,delassabilis,delectabilis,delenimentum,delenitorius,deleramentum,delibamentum,delinimentum,delinitorius,delinquentia,deliramentum,dematricatus,democraticus,demonstratio,demonstrator,demoscopicus,dendrachates,dendrophorus,denominative,denticulatum,denticulatus,dentifricium,depauperatio,depauperatus,depectulatus,depilatorium,depopulatrix,depositarius,depostulator,deprecabilis,deprecativus,derogatorius,descensorius,descriptivus,desideranter,desidiabulum,desolatorius,desperabilis,despicabilis,despicientia,desplendesco,destrictivus,destructilis,destructivus,determinatio,determinator,determinista,detestabilis,detractorium,detractorius,deverticulum,devirginatio,devirginator,devoratorium,devoratorius,devorticulum,devotamentum,dextraliolum,dextroversum,dextroversus,dextrovorsum,dextrovorsus,diabatharius,diacecaumeme,diameliloton,diaspermaton,diatretarius,diazeugmenon,dictionarium,didascalicus,didasculatus,diezeugmenon,diezeugmenos,diffarreatio,difficiliter,digestibilis,dignoscentia,dijudicatrix,dilancinatus,diocletianus,diplomatibus,diplomaticus,dipondiarius,diribitorium,discalceatus,discipulatus,discographia,discordiosus,discrepantia,discriminale,disjunctivus,dismembratio,dismembratus,dispositivus,disproportio,dissentaneus,dissimulatio,dissimulator,dissonantium,dissuasorius,diurnalismus,diversimodus,documentalis,documentatio,domesticatus,domicellaris,duodeviginti,dupondiarius,dysentericus,dysintericus,ecclesiastes,effascinatio,efflagitatio,ejectamentum,electissimus,electricitas,electrisatio,electrolysis,electrolytum,electronicus,elelisphacos,elementarius,eliquatorius,emblematicus,encephalitis,enharmonicon,enharmonicos,enharmonicus,enthusiasmus,enuntiabilis,epigrammatum,epigraphicus,epigraphista,epilempticus,epistolarium,epithalamium,equivalenter,eschatologia,esperantista,ethnographia,ethnologicus,eucharistial,eucharistium,evangeliarum,exasperatrix,excardinatio,excusamentum,execramentum,exemplabilis,exemplaritas,exercitorius,exercitualis,exfornicatus,exhibitorius,exhortativus,exitabiliter,exomologesis,exorcistatus,expendiendus,expergefacio,experimentum,explicativus,expostulatio,expropriatio,expugnabilis,exsecrabilis,exsecribilis,exsistimatio,exsplendesco,exstinctivus,exsultabilis,extemporalis,exterminator,extinctorium,extramuranus,extrapolatio,exuperabilis,factispecies,facultativus,faldistorium,falsificatio,familiaritas,familiariter,feoffamentum,fermentaceus,ferriviarius,ferrogriseus,fertilisatio,fiducialiter,flexibilitas,fluctifragus,fluentisonus,foederalista,foliothecula,formidabilis,formidilosus,formidolosus,formidulosus,fortificatio,fraudulenter,fraudulentia,fraudulentus,frumentarius,frustillatim,fumigabundus,functionalis,gargarizatio,garyophyllon,gasificatrum,gazofilacium,genealogicus,geocentricus,geographicus,gladiatorius,gloriabundus,glorificatio,glycyrrhizon,grandiloquus,grandisculus,gratificatio,gratulatorie,gravedinosus,graveolentia,gubernaculum,gynaecearium,gynaecearius,gynaeciarium,gynaecologia,gynaecologus,gynaeconitis,habitualiter,habrodiaetus,hadrumetinus,haemorrhagia,haeresiarcha,hagiographia,hagiographus,hallucinator,harenifodina,harundinetum,harundinosus,haruspicinus,hecatolitrum,hecatometrum,hedonisticus,helicopterum,hemerodromus,hemorrhoissa,herbisectrum,hereditarius,hermeneutica,hetaeriarcha,heterogeneus,hiacinthinus,hibernaculum,hierarchicus,hieroglyphum,hierosolymum,hirundininus,holocaustoma,homogeneitas,homuncionita,horizontalis,horripilatio,hospitalitas,hospitaliter,humanisticus,humanitarius,hyacinthinus,hydrographia,hydrologicus,hydrosphaera,hydrostatica,hyperbolaeos,hyperbolaeus,hyperbolicus,hypertrophia,hypochondria,hypodiaconus,hypophrygius,hypostaticus,hypotheticus,hysterologia,ichnographia,idealisticus,ignominiosus,illaetabilis,illatinismus,illecebrosus,illyricianus,imaginativus,imbecillitas,immaterialis,immersabilis,immisericors,immolaticius,immolatitius,immortalitas,impassibilis,impedimentum,imperatorius,imperialista,imperiuratus,impersonalis,impetrabilis,implacabilis,implastratio,importunitas,impossibilis,impressorium,impressorius,improperatus,improvisatio,imputribilis,inaccedendus,inadfectatus,inaequabilis,inaequalitas,inaequaliter,inaestimatus,inambitiosus,inanilogista,inattenuatus,inauspicatus,inbecillitas,incapiabilis,incarceratus,incardinatio,incardinatus,incastigatus,incelebratus,incendiarius,incessabilis,inchoamentum,incidentalis,incitamentum,incogitantia,incohamentum,incommoditas,incompositus,inconruptela,inconsequens,inconstanter,inconstantia,inconsumptus,inconveniens,incorporalis,incorporatio,incorruptela,incredibilis,incredulitas,incruentatus,incunctanter,incustoditus,indeclinatus,indeploratus,indesinenter,indestrictus,indicatorius,indiligenter,indiligentia,indispositus,indissimilis,indissolutus,indistinctus,individualis,indubitanter,industrialis,industriosus,indutiomarus,inedicabilis,inedicibilis,inevitabilis,inexorabilis,inexpectatus,inexpiabilis,inexplebilis,inexploratus,inexpugnalis,inexstinctus,infabricatus,infallibilis,infantiliter,infecunditas,infervefacio,infinitarius,inflexibilis,informaticus,infortunatus,infrequentia,infructuosus,infundibulum,inhabitantis,inhospitalis,inimitabilis,inlabefactus,inlacessitus,inlecebrosus,inlitteratus,inmolaticius,inmolatitius,inmortalitas,innecessitas,inobedibilis,inobedienter,inobedientia,inobservatus,inofficiosus,inopinabilis,inpassibilis,inpedimentum,inputribilis,inquilinatus,inrationalis,inreligiosus,inremeabilis,inreverenter,inreverentia,inritamentum,insatiabilis,insensibilis,insociabilis,instructivus,instrumentum,insuadibilis,insufficiens,integumentum,intemperatus,interamentum,interfectrix,interfulgens,intergerivus,interminatus,intermuralis,internecinus,internecivus,internicivus,internuntius,interpositio,interpunctio,interpunctum,interquiesco,interrasilis,interrivatio,interrogatio,interspersus,interstinguo,interstitium,interusurium,intestabilis,intolerandus,intoleranter,intolerantia,intramuranus,introgredior,inverecundia,inverecundus,investigatio,invincibilis,inviolabilis,invitamentum,invitatorius,involuntarie,invulneratus,irrationalis,irreligiosus,irremeabilis,irreverentia,irritamentum,iurisiurandi,juridicialis,jurisperitus,justificatio,justificatus,juxtapositio,kalumniatrix,kapitularium,laboratorium,labyrintheus,lacrimabilis,lamentabilis,lanternarius,lasarpicifer,laudabilitas,lectionarium,legislativus,legisperitus,lesbianismus,leucacanthos,liberalismus,libitinarius,linguisticus,lipsanotheca,lithographia,lithostrotum,lithostrotus,loculamentum,longanimitas,longanimiter,longinquitas,ludimagister,lycopersicum,macedonienis,macroscopium,magnanemitas,magnanimitas,majestativus,malagranatum,maleficentia,malevolentia,malivolentia,malogranatum,manifestatio,mansionarius,manuscriptum,manuscriptus,marchionatus,marchionissa,materialista,materialiter,mathematicus,measurabilis,medicamentum,meditabundus,meditamentum,megalophonum,melismaticus,melisphyllum,mercennarius,meridionalis,metabolismus,metaphoricus,metaphrastes,metaphysicus,metempsychis,meteorologia,meteorologus,methodologia,microscopium,miliardarius,milionesimus,militarismus,milligrammum,minitabundus,mirificentia,misanthropia,miscellaneum,misericordia,missionarius,mollificatio,momentaliter,monosyllabon,monosyllabus,monotheismus,monstrificus,monstrigenus,mortificatio,mulctaticius,mulierositas,multiloquium,municipiolum,munificentia,munitiuncula,mythologicum,mythologicus,narcissismus,nationalitas,navicularius,noctuabundus,nomenclatura,nomenculator,nongentensim,nongentensum,notionaliter,nutriamentus,objectivitas,obligamentum,obsidionalis,obstetricium,obstetricius,obstetritius,obstupefacio,obtemperatio,obtenebresco,occasiuncula,occidentalis,octogenarius,octuplicatus,oecumenismus,offendiculum,oligarchicus,omnipotentia,omnipraesens,omniscientia,onocentaurus,operistitium,opisthotonos,opportunitas,opstetricium,opstetricius,opstetritius,optimisticus,ordinamentum,ordinatralis,orichalcinus,orthographia,orthopnoicus,ortygometras,paedagogicus,palaeopolium,palaestricus,palimpsestus,paludamentum,pancreatitis,paracharagma,parasitaster,parastaticus,paratragoedo,parliamentum,parricidalis,participatio,particularis,passibilitas,pastophorium,pastophorius,pathologicus,patriotismus,patronymicus,pavimentatus,peccabilitas,penetrabilis,penicillinum,pentachordum,pentachordus,perappositus,perbenevolus,percrebresco,perdiuturnus,peregrinatio,peregrinator,peregrinitas,perforaculum,perfunctorie,pergamenicus,pergraphicus,perhumaniter,periclitatio,periegeticus,perincommode,perindulgens,perliberalis,perlucidulus,perluctuosus,permaturesco,permediocris,perpauxillum,perridiculus,persalutatio,perspicillum,perstudiosus,pertinaciter,pertractatio,perturbatrix,pervigilatio,petasunculus,petroselinum,phantasticus,pharmacopola,philatelista,philomusicus,philosophice,photographia,photographus,photomachina,phraseologia,phrenocomium,phylacterium,physiognomon,pigmentarius,piperatorium,piscicultura,pistrinarius,pistrinensis,placabilitas,plagigerulus,plurilaterus,pneumococcus,pollicitatio,polymitarium,polymitarius,polyphonicus,polytheismus,pomeridianus,pontificalis,pontificatus,populabundus,pornographia,portentuosus,postliminium,postscaenium,praecedentia,praecognosco,praecorrumpo,praecumbrans,praedabundus,praedatorius,praedecessor,praedisponeo,praedominium,praeferratus,praefervidus,praefrigidus,praefulgidus,praegracilis,praehistoria,praejudicium,praeminister,praeministra,praeministro,praemolestia,praenestinus,praeposterus,praeproperus,praeputiatio,praeputiatus,praerogativa,praescienter,praescientia,praescriptio,praescriptum,praesentarie,praesentatio,praesidentia,praesidialis,praestabilis,praestolatio,praesultator,praesumptive,praesupponeo,praeterlabor,praeterlatus,praetermitto,praetervehor,praetextatus,praetorianus,praetrepidus,pragmatismus,pravicordius,precordialis,presbyterium,primipilaris,primogenitum,primogenitus,primopilaris,principialis,probabilitas,probabiliter,prodigilitas,profecticius,profectitius,professorius,programmatio,programmator,proiectorium,projecticius,projectitius,promatertera,promontorium,promonturium,promptuarium,promptuarius,promunturium,pronuntiatio,pronuntiator,propinquitas,propitabilis,propulsorius,prosopopoeia,proverbialis,provincialis,proxeneticum,pseudothyrum,pulmentarium,puritanismus,pusillanimis,pusillanimus,pythagoricus,quadragensim,quadragensum,quadragesima,quadrangulum,quadrangulus,quadrantalis,quadriennium,quadrifariam,quadrifarius,quadrigarius,quadrilibris,quadringenti,quadrupedans,quadruplator,quaesticulus,quandocumque,quandocunque,quandoquidem,quaternarius,quatuordecim,quindecimvir,quinquaginta,quinquennium,quinqueremis,quinquiplico,quoiquoimodi,quojuscemodi,quoquoversum,quoquoversus,radicalismus,radioactivus,radiographia,radiologicus,ratiocinatio,ratiocinator,rationabilis,rationalista,receptaculum,reciprocatio,rectificatio,refocillatio,refrigeratio,reincarnatio,relationalis,relativismus,religiositas,repastinatio,repositorium,republicanus,resipicentia,responsorium,restipulatio,restrictivus,resummonitio,reverberatio,reverberatus,rheumatismus,rhododendron,ridicularius,rogatiuncula,rogatorialis,rubicundulus,sacerdotalis,sacrificulus,sacrosanctus,saltatricula,sambucistria,sandaliarius,sanguinarius,sarisophorus,scepticismus,schedinummus,schematismos,schematismus,schismaticus,schoenobates,scholasticus,scientificus,scrofipascus,seliquastrum,semicinctium,semicrematus,semidiameter,semigermanus,semireductus,semirefectus,semirefetcus,semisepultus,sempronianus,semunciarius,semustulatus,sensibilitas,sententiosus,septuagensim,septuagensum,sequestratim,sequestratio,sermocinalis,serpentigena,serperastrum,sescentensim,sescentensum,sesquimodius,sesquisextus,sexagenarius,sexquinterno,significatio,siliquastrum,silvicultrix,similagineus,singularitas,singulariter,sinisbrorsus,sinistrorsum,sinistrorsus,sobriefactus,socializatio,sociofraudus,sociologicus,solidarietas,sollicitatio,solliferreum,solstitialis,somniculosus,sophismatius,speculatoria,spelaeologus,sphaericitas,spiritalitas,spiritaliter,spongiformis,sputatilicus,stellionatus,stercilinium,stercorarium,sterculinium,stereophonia,sterilizatio,sternumentum,sterquilinum,stillicidium,stratioticus,strophiarius,structuralis,suaviloquens,subdistinguo,subintellego,subjunctivus,subligaculum,submultiplex,subobscaenus,subpositivus,subrostranus,subsequenter,subsidiarius,substantivus,subterraneus,suburbanitas,sufficienter,suffraganeus,sugrundarium,suovetaurile,superexactio,supergredior,superimmineo,superimpendo,superincumbo,superinjicio,superinpendo,superinvaleo,superioritas,superlectile,superpendens,superumerale,supparasitor,suppeditatio,supplementum,supranistria,surrepticius,surreptitius,suscitabulum,symphoniacus,syncretismus,syphiliticus,tabernaculum,taciturnitas,tardiusculus,technocratia,telecopialis,telecustodia,telegraphema,telephonicus,telephonista,televisorium,tempestuosus,temptabundus,temptamentum,tenebricosus,terebinthina,terminologia,terracuberum,tertullianus,testudinatus,tetrachordos,theocraticus,thermometrum,thermopolium,thermostatum,thessalonica,tintinabulum,tolerabundus,torneamentum,tragicomicus,trajecticius,trajectitius,transalpinus,transcendens,transgradior,transgredior,transgressio,transgressor,transgressus,transitorium,transitorius,translativus,translucidus,transmarinus,transpadanus,transpiratio,transplantus,transverbero,traversarium,traversarius,trebuchettum,trecentensim,trecentensum,trechedipnum,triangularis,tribrachysos,triclinarium,tricliniaris,trientabulum,trilustralis,triumviralis,triumviratus,tropologicus,tubilustrium,tumultuarius,turpificatus,turpiloquium,tympanistria,tympanotriba,typographeum,tyrotarichos,ultramarinus,unctiusculus,undetriginta,undevicensim,undevicensum,undevigensim,undevigensum,undiquesecus,unguentarius,urbanicianus,utricularius,valerianella,vegetarianus,venerabundus,verberabilis,versificatio,versificator,vertibilitas,veteratorius,veterinarius,vicesimarius,violoncellum,visitatorius,vocabularium,voluptabilis,volutabundus,warantizatio,xenoparochus,abbrocamentum,abjudicativus,abominamentum,abrenunciatio,acatalecticus,accentiuncula,acroamatarius,adimplementum,adjectamentum,adminiculator,adminiculatus,administratio,administrator,admirabilitas,admirabiliter,admissionalis,adolescentior,adolescentula,adoptionismus,adpagineculus,adpellatorius,adprehensibil,adscendibilis,adscripticius,adspectabilis,adstrictorius,adulescentior,adulescentula,adverbialiter,aedificatoria,aegophthalmos,aegrotaticius,aequilibratus,aequilibritas,aequinoctiale,aequiparantia,aequiperantia,aericrepitans,aerinavigatio,aerodynamicus,aeroplanigera,aestimatorius,aggressivitas,agnosticismus,agonotheticus,alieniloquium,aliquantillum,aliquantisper,aliquantorsum,aliquodfariam,aliquotfariam,alleluiaticus,amminiculator,amminiculatus,amministratio,amministrator,ammirabilitas,ammirabiliter,ammissionalis,amphithalamos,amphitheatrum,amplificatrum,anachoreticus,anachronismus,anaclinterium,anaglyptarius,anapleroticus,anastomoticus,ancillariolus,angelificatus,anniversarium,anniversarius,annumerabilis,antegenitalis,antenuptialis,anteoccupatio,antepagmentum,antescholanus,anthropolatra,anthropologia,anthropologus,anticategoria,anticoagulans,anticonceptio,antiochenesis,antipagmentum,antiphonarium,antiphonarius,antisophistes,antizeugmenon,apalocrocodes,aperantologia,apocalypticus,apocarteresis,apocatastasis,apocrisiarius,apoproegmenon,apoproegmenum,aposphragisma,apostolicitas,appagineculus,appellatorius,apprehensibil,aquisgranenis,archibasilica,archidiaconus,archidiocesis,archigubernus,archimandrita,archisacerdos,arcuballistus,argentifodina,argentofodina,argumentatrix,armamentarium,armamentarius,aromatopolium,aspernamentum,astronauticus,atramentarium,aucthorizatio,auctoramentum,audivisificus,aurichalcinus,aurifrisiatus,auripigmentum,aurochalcinus,auscultabulum,authenticitas,authoramentum,autocineticus,autocinetista,automatizatio,autoraedarius,auxiliabundus,bacteriologus,balineatorius,barbaricarius,bardocucullus,benedictorium,beneficiarius,beneficientia,bergomagister,biaeothanatus,bibliographia,bibliographus,bibliothecula,blandiloquens,blandiloquium,boustrophedon,bracchiolaris,brachionarium,bracteamentum,brephotrophus,bronchoscopia,burgimagister,cachinnabilis,cacosyntheton,caenacularius,caenatiuncula,caeremonialis,caerimonialis,caerimoniosus,caesaraugusta,calamistratus,calculatorius,calefactorius,calliplocamos,callistruthia,callistruthis,canaliculatim,canaliculatus,candidatorius,cannibalismus,capillamentum,carbunculatio,carbunculosus,cardinalicius,cardinalitius,cardiographia,carminabundus,carnelevarium,carroballista,cartilagineus,castigatorius,castimonialis,castrensianus,cataclisticus,catadromarius,catafractatus,catapirateria,cataplectatio,catapultarius,catascopiscus,catastalticus,catechisticus,cathedraticum,catheterismus,cavillabundus,centenionalis,centralizatio,centumgeminus,centumpondium,centumviralis,centuplicatum,centuplicatus,centurionatus,centurionicus,cephalalgicus,cephalargicus,cepotaphiolum,cercopithecon,cercopithecus,chaerephyllum,chalasticamen,chalazophylax,chalcographia,chalcographus,chamaecerasus,chamaemelinus,chamaemelygos,chamaemyrsine,chancellarius,charismaticus,chartographia,chelidoniacus,chiliodynamia,chiliogrammum,chiliophylion,chiliovattium,chloroformium,chlorophyllum,choerogrillus,choerogryllus,choerogyllius,chorepiscopus,chrismatorium,christianitas,christipotens,chronographus,chronologicus,chrysanthemon,chrysanthemum,chryselectros,chryselectrum,chryselectrus,chrysoaspides,chrysocanthos,chrysomelinus,chrysophrysos,chrysoprasius,chrysoprassos,chrysoprassus,chyrogrillius,chyrogryllius,cicimalindrum,cimeliarchium,circulatorius,circumamictus,circumaspicio,circumcaesura,circumcurrens,circumforanus,circumforatus,circumfossura,circumfractus,circumgredior,circumgressus,circumhumatus,circuminjicio,circuminvolvo,circumlocutio,circummulcens,circummunitio,circummuranus,circumnascens,circumornatus,circumpadanus,circumpavitus,circumplector,circumpositio,circumpositus,circumpotatio,circumsaeptus,circumscripte,circumspectio,circumspector,circumspectus,circumstantia,circumstringo,circumtermino,circumtorqueo,circumundique,circumvolutor,cisterciensis,citharoedicus,clarissimatus,claustritumus,clavicularius,clavicymbalum,clepsydrarius,climactericus,coactiliarius,coagmentarius,codicillarius,coelementatus,coemptionalis,coemptionator,coenacularius,coenatiuncula,coepiscopatus,coexercitatus,cofraternitas,cognitionalis,cognoscitivus,collacrimatio,collaudabilis,collectaculum,collegatarius,collegialiter,collimitaneus,colliquefacio,colliquiarium,collocutorium,collocutorius,colonialismus,columbinaceus,combustibilis,comincommodus,comissabundus,commanducatio,commaniplaris,commendabilis,commendativus,commensuratio,commensuratus,comminabundus,commisariatus,commiscibilis,commissuralis,commoetaculum,commonitorium,commonitorius,commotiuncula,communicarius,communisticus,communitarius,commurmuratio,compassibilis,compaternitas,compedagogita,compedagogius,compendiarium,compendiarius,compenetratio,compensativus,comperegrinus,comperendinus,compitalicius,compitalitius,complatonicus,complicabilis,compositicius,composititius,compunctorius,concalefactio,concandefacio,concatervatus,concelebratio,conceptaculum,concertativus,conchyliarius,conciliabulum,conciliciatus,concitamentum,concohortalis,concomitantia,concordabilis,concorporalis,concorporatio,concorporatus,concrescentia,concubinarius,concussibilis,condeliquesco,condemnabilis,condicionalis,condiscipulus,conditionalis,conditionatus,conduplicatio,confervefacio,confessionale,confidejussor,confirmativum,confirmativus,conflictatrix,confoederatio,confornicatio,confractorium,congeniclatus,congenuclatus,congermanatus,congermanesco,congerminalis,conglomeratio,conglutinatio,conglutinosus,congratulatio,congregabilis,congregativus,conjecturalis,conjugicidium,conlacrimatio,conlaudabilis,conlectaculum,conlegatarius,conlimitaneus,conliquefacio,conliquiarium,connubialiter,conparaticius,conpedagogita,conpedagogius,conpendiarium,conpendiarius,conperendinus,conpitalicius,conpitalitius,conpositicius,conposititius,conquiliarius,conregionalis,conruptibilis,consanguineus,consceleratus,conservabilis,conservativus,consideranter,considerantia,consociabilis,consolatorius,conspicabilis,conspiciendus,conspicientia,conspicillium,consplendesco,constitutivus,constrictivus,consummabilis,contemnificus,contemperatio,contemplatrix,contemporalis,contemtibilis,contenebrasco,contesseratio,continuativus,contractorium,contrapositum,contrapunctum,contribulatio,contubernalis,contumeliosus,conventiculum,conversibilis,convertibilis,cooperimentum,coquinatorium,coquinatorius,coquitatorius,corallachates,cornicularius,cornucularius,corpuscularis,corregionalis,correspondens,corruptibilis,cortinipotens,coscinomantia,crassificatio,crebrinodosus,crepitacillum,crispiculcans,crucesignatus,cruciabilitas,cruciabiliter,crucisignatio,cruppellarius,crysisceptrum,crystalloides,cucurbitarius,cunnuliggeter,cuppedenarius,cuppedinarius,curagendarius,cynocephalion,dactyliotheca,dactylogramma,decacuminatio,decemplicatus,decemprimatus,declamatorius,declaratorius,decussissexis,defervefactus,defetiscentia,dehortatorius,dejectiuncula,delectamentum,deliberativus,deliquatitudo,demographicus,demonstrativa,demonstrative,denominativus,dentiscalpium,denuntiativus,deplorabundus,deportatorius,deprecabundus,deprecatorius,derectilineus,desiderabilis,desiderativus,desputamentum,destinatarius,destrictarium,determinismus,detrimentosus,deuteronomium,deversoriolum,dextrocherium,diaconissatus,diamastigosis,diametricalis,diaphoreticus,diastematicus,dictabolarium,dictatiuncula,digladiabilis,dimissorialis,dinumerabilis,directilineus,disciplinaris,disciplinatus,discriminalis,discriminatio,disgregativus,dispertitivus,dissimilitudo,dissimulanter,dissociabilis,distributivus,dithyrambicus,documentarius,duodetriginta,duodevicensim,duodevicensum,duodevigensim,duodevigensum,duumviralitas,eclecticismus,elamentabilis,electrificina,electrochemia,electrometrum,emphyteuticus,encyclopaedia,episcopaliter,epitrachelion,equipollenter,erysisceptrum,esotericismus,essentialiter,eucharisticon,eucharisticus,evangelizatio,evangelizator,excentricitas,exceptionalis,exclaustratio,exclaustratus,existentialis,exponentialis,exportaticius,exprobrabilis,exsaturabilis,exsecramentum,exsuperabilis,fallaciloquus,famigerabilis,favorabiliter,fideicommitto,fidejussorius,fluorescentia,foederalismus,fundibalarius,geocentrismus,gerontocomium,glottologicus,grandiusculus,grapheocrates,grapheocratia,gratulabundus,gryllographus,gymnasiarchus,habitudinalis,hebdomadarius,hellenisticus,hemisphaerion,hemisphaerium,hemorrhoissus,hermeneuticus,hiericuntinus,historialiter,holocaustosis,honorabiliter,honorificatus,horologiarius,hydrostaticus,hypermetricus,hypodiaconxus,hypothecarius,ichnographice,identificatio,idololatricus,illiberalitas,illiberaliter,immaculabilis,immedicabilis,immemorabilis,immiserabilis,immortalifico,imperialismus,imperterritus,imperturbatus,impetiginosus,impropugnatus,improvidentia,imputabilitas,inartificiale,incantamentum,incapabilitas,incircumcisio,incircumcisus,incogitabilis,incommendatus,inconruptivus,inconstabilis,inconsummatio,inconsummatus,incorruptivus,incunctabilis,indemutabilis,independenter,independentia,indifferenter,indignabundus,indivisibilis,indubitabilis,ineluctabilis,inemendabilis,inenarrabilis,inevulsibilis,inexcusabilis,inexercitatus,inexperrectus,inexspectatus,infanticidium,infatigabilis,infidigraphus,inflammabilis,infrascriptus,ingredientium,inhabitabilis,inhaesitanter,inhonorabilis,inliberalitas,inliberaliter,inliquefactus,innavigabilis,innumerabilis,inobaudientia,inoboedibilis,inoboedienter,inoboedientia,inobservantia,inopiniabilis,inordinaliter,inpetiginosus,inquinamentum,inrationabile,inrecogitatio,inrecusabilis,inregularitas,inremuneratus,inreparabilis,inreprehensus,inrevocabilis,inrevocabilus,inscrutabilis,insecticidium,insedabiliter,inseparabilis,insolabiliter,instaurativus,insuperabilis,intaminabilis,intellegentia,intelligentia,intemperanter,intemperantia,intempestivus,intentionalis,intercalarius,interordinium,interpellatio,interpretatio,interretialis,interspiratio,interstinctus,intertributio,intervallatus,inthronizatio,intitubabilis,intolerabilis,intractabilis,involuntarius,irrationabile,irrecogitatio,irrecusabilis,irregularitas,irremuneratus,irreparabilis,irreprehensus,irrevocabilis,irrevocabilus,isochronismus,ithyphallicus,jureconsultus,lacrimabundus,largitionalis,lectisternium,lexicographia,lexicographus,limitotrophus,litteratorius,longiturnitas,lurcinabundus,lutheranismus,machinamentum,macrobioticus,macrocosmicus,magnetophonum,magnificenter,magnificentia,magnuficenter,magnuficentia,maleloquentia,manifestarius,manifestative,manufestarius,martyrologium,materialismus,matrimonialis,mediterraneus,melancholicus,melodramatium,metamorphosis,microbiologia,microchartula,microscopicus,ministerialis,mirabiliarius,monasterialis,monochromatum,morphologicus,multiplicatio,multiplicator,multiplicitas,multipliciter,multivorancia,mundializatio,musicographus,mythistoricus,narratiuncula,nationalismus,naturalizatio,navmachiarius,neutralizatio,nudiustertius,nummischedula,nuncupatorius,objurgatorius,oblectamentum,obsecundanter,observatorium,offensiuncula,olfactoriolum,opportunismus,opsecundanter,ordinabiliter,orthopaedicus,oscillatorius,palaeographia,pancratiastes,pantheisticus,paracharactes,parallelismus,parentheticus,parlamentaris,participialis,patriarchatus,peccatissimus,penitentialis,pentecostalis,peradulescens,percalefactus,perceptibilis,perfamiliaris,perfrigefacio,perhonorifice,perimbecillus,perincommodus,peringeniosus,peripateticus,perniciabilis,peropportunus,perpendiculum,perpetualiter,perpropinquus,perreconditus,perseveranter,perseverantia,perspicibilis,perspicientia,persuasibilis,perterrefacio,pertimefactus,pertundiculum,pervestigatio,petrochemicus,pharmaceutria,pharmacopoles,philosophicus,photochartula,photographema,pigneraticius,pilamalleator,plectrologium,pluralisticus,portentificus,posterioritas,praebalteatus,praecellentia,praecidentius,praecontrecto,praedetermino,praediatorius,praefectianus,praefiguratio,praeintelligo,praejudicatum,praemedicatus,praenavigatio,praerogativus,praesentarius,praesentialis,praesidiarius,praestigiator,praestigiosus,praesumptivus,praetermissio,praeternavigo,praetervectio,praevaricatio,praevaricator,presbyteralis,presbyterissa,primiscrinius,principalitas,principaliter,privilegiatus,problematicum,problematicus,procinctualis,proconsularis,proconsulatus,procuratorius,prodigialiter,prohibitorius,proletariatus,promunctorium,propugnaculum,proscripturio,proselytismus,prospicientia,prothoplastus,psychologicus,psychomanteum,psychomantium,pugillatorius,pulverulentus,pusillianimis,putidiusculus,pyrauloplanum,quadrantarius,quadriangulus,quadrichordum,quaestiuncula,quandiucumque,quantitativus,quantumcumque,quattuordecim,quingentensim,quingentensum,quinquagensim,quinquagensum,quinquangulus,quinquennalis,quinqueprimus,quojusquemodi,quomodocumque,quomodocunque,quotiescumque,quotiescunque,quotusquisque,radiographema,radiophonicus,radiotherapia,rationalismus,recapitulatio,reciperativus,reclinatorium,recomminiscor,reconciliatio,reconciliator,recuperativus,referendarius,refocillatrix,regionalismus,residentialis,responsabilis,responsalitas,restitutorius,retrogradatio,retroscopicus,retrospective,sacerdotialis,salacaccabium,saltatiuncula,salutificator,salutigerulus,sanctificatio,sandaligerula,scrupulositas,sellisternium,semiadapertus,semicubitalis,seminiverbius,semiustulatus,sempiternalis,sempiternitas,sentimentalis,septemgeminus,septemtrional,septemviralis,septemviratus,septentrional,sequestrarius,sesquidecimus,sesquioctavus,sesquipedalis,sesquiquartus,sesquiquintus,sesquitertius,sexagesimalis,sexquetertius,sexquiquartus,sexquitertius,sextusdecimus,significanter,significantia,simplificatio,socialisticus,sorbitiuncula,specializatio,spectabilitas,speculabundus,speculatorius,spiritualitas,spiritualiter,stabilimentum,statulibertas,sterquilinium,stipendiarius,stultiloquium,stupefactivus,subadroganter,subcinericius,subdifficilis,subdistinctio,subfervefacio,subpuratorius,substantialis,subturpiculus,succinericius,suffervefacio,suouitaurilis,superaedifico,superciliosus,superincidens,superincubans,superinsterno,superliminare,superliminium,superlininare,superpartiens,supervacaneus,suppositicius,supposititius,suppuratorius,suprascriptio,suprascriptus,surrubicundus,susceptibilis,sustentaculum,synchronismus,syndicalismus,taxiraedarius,technologicus,telecopiatrum,telegraphicus,telegraphista,telespectator,televisificus,temperamentum,terebenthinus,terebinthinus,terroristicus,testudineatus,tetradrachmum,theocentricus,thermocepicus,thermolagoena,therotrophium,tintinnabulum,toxicologicus,tractogalatus,traditionalis,tranquillitas,transformatio,transfretanus,translaticius,translucentia,transmigratio,transmontanus,transparentia,transrhenanus,transversalis,trigonometria,typographicus,tyrannicidium,tyrannoctonus,ultramontanus,undenonaginta,undeoctoginta,undesexaginta,undetricensim,undetricensum,unetvicesimus,unicellularis,uniuscuiusque,universaliter,utrimquesecus,valentinianus,vaniloquentia,vegetabiliter,vercingetorix,versutiloquus,vigintisexvir,vitriterstrum,vituperabilis,accessibilitas,adcessibilitas,adnotatiuncula,adolescentulus,adolescenturio,adpropinquatio,adsimulaticius,adulescentulus,adulescenturio,aeclesiasticus,aedificatorius,aequidistanter,aequidistantia,aequilateralis,aequinoctialis,aequiparabilis,aequiperabilis,aequisonantius,afforciamentum,alexipharmacon,ambulatiuncula,amethystinatus,amothystinatus,amphibrachysos,amphiprostylos,anathematismus,angusticlavius,aniatrologetus,annotatiuncula,antecantativus,antemeridialis,antemeridianus,antescholarius,anthropophagia,anthropophagos,anthropophagus,antioxydativus,antisemitismus,appropinquatio,archaeologicus,archiepiscopus,archipresbyter,archisodalitas,archisynagogus,architectonice,architriclinus,argumentabilis,argumentaliter,argumentativus,articulamentum,artificialiter,artificiositas,assimulaticius,astrapoplectus,atmosphaericus,attachiamentum,auctoritativus,auctorizabilis,augustianismus,auriphrygiatus,autobiographia,autobirotarius,automatopoetus,baccalaureatus,benedictionale,bibliothecalis,bisulcilinguus,blasphemabilis,bracchionarium,brachysyllabus,brephotropheum,brephotrophium,breviloquentia,caeremoniarius,caldicerebrius,calefactabilis,calliblepharum,calliblepharus,camelopardalis,camelopardalus,candelabrarius,capitalisticus,carcinogenesis,cardiographium,cartilaginosus,cassivellaunus,castrensiarius,catafractarius,catechumenatus,cathedralicius,centrifugatrum,chamaeplatanus,characterismos,characterismus,chemiotherapia,christianismus,christifidelis,chrysoberullus,chrysoberyllus,chrysocephalos,chrysolachanum,cinematographo,circumaedifico,circumcidaneus,circumcisicius,circumcisitius,circumcisorium,circumcursatio,circumferentia,circumfluentia,circumforaneus,circumgarriens,circumgestator,circumglobatus,circumiectalis,circuminsessio,circumlaticius,circumlatitius,circumscaripho,circumscriptio,circumscriptor,circumscriptus,circumstridens,circumvectitor,clandestinitas,colliquefactus,collurchinatio,colobathrarius,comicotragicus,commandaticius,commanipularis,commanipulatio,commemorabilis,commendatarius,commendaticius,commendatitius,commendatorius,commentariolum,commentariolus,communicabilis,compaedagogita,compaedagogius,compartimentum,competitivitas,complexionatus,comportionalis,comprehensivus,compulsamentum,conceptionalis,concertatorius,conciliarismus,concinnaticius,concionabundus,concionatorius,conclusiuncula,concupiscentia,concursatorius,condemnatorius,condimentarius,confectorarius,confederatorus,confessionalis,confraternitas,confricamentum,confusionismus,conjectatorius,conliquefactus,conlurchinatio,conmemorabilis,conmunicabilis,connaturaliter,conpaedagogita,conpaedagogius,conresupinatus,consanguinitas,consecratorius,conservatorium,consignatorium,consistorialis,consistorianus,conspicabundus,constabilarius,constabularius,constitutorius,consultatorius,consumptibilis,contaminabilus,contemplabilis,contemplativus,contemporaneus,contemptibilis,contestificans,contionabundus,contionatorius,contractabilis,contrascriptor,contrectabilis,controversiola,contrpuncticus,convalescentia,conventionalis,conventiuncula,conversiuncula,coptoplancenta,coralloachates,corresupinatus,cosmographicus,cosmopoliticus,crispicapillus,cryptoporticus,crystallisatio,cuculliunculum,cucurbitularis,cujuscujusmodi,curculiunculus,deambulatorium,decalcificatio,decemviraliter,dedecoramentum,defectibilitas,defensibiliter,delectabiliter,deliberabundus,deliberamentum,democratizatio,demogrammateus,demonstrabilis,demonstrativus,derectiangulus,destructibilis,determinabilis,detestabiliter,differentialis,dionysonymphas,directiangulus,dispensatorius,ecclesiasticum,ecclesiasticus,effervescentia,electrificatio,electrolyticus,electroscopium,eminentissimus,enthusiasticus,eschatologicus,essentificatio,ethnographicus,eucharistialus,excalfactorius,excandescentia,excommunicatio,experimentalis,extemporalitas,extemporaliter,fideicommissum,fideicommissus,francomurarius,functionaliter,gazophylachium,haemorrhoissus,hagiographicus,hermaphroditus,hippocentaurus,homoeoteleuton,honorificentia,horizontaliter,hydrocarboneum,hydrographicus,hylomorphismus,hypodidascalus,illacrimabilis,immensurabilis,impassibilitas,impassibiliter,impenetrabilis,impermutabilis,impressionista,imputribiliter,inaccessibilis,inaequabilitas,inaequabiliter,inaestimabilis,inartificialis,incarceratious,incidentaliter,incommutabilis,incomparabilis,incompatibilis,incomtaminatus,inconfusibilis,inconruptorius,inconsequenter,inconsequentia,inconsideratus,inconvenienter,inconvenientia,incorrigibilis,incorruptorius,incumbramentum,indisciplinate,indissolubilis,individualista,individualitas,inexplicabilis,inexpugnabilis,inextricabilis,inexuperabilis,infallibilitas,infallibiliter,infundabiliter,inhospitalitas,inmensurabilis,innascibilitas,inobservabilis,inopinabiliter,inpassibilitas,inpassibiliter,inputribiliter,inrationabilis,inrecordabilis,inreductibilis,inreformabilis,inrefragabilis,inrefrenabilis,inremediabilis,inremissibilis,instrumentalis,insufficientia,intellectualis,intellegibilis,intercolumnium,interimisticus,interlaqueatus,intermenstruum,intermenstruus,interminabilis,interretiarius,intertrimentum,intransmutabil,invertibilitas,investigabilis,invincibiliter,invulnerabilis,irrationabilis,irrecordabilis,irreductibilis,irreformabilis,irrefragabilis,irrefrenabilis,irremediabilis,irremissibilis,jurisconsultus,katafractarius,lurchinabundus,magnetizabilis,magnetoscopium,magniloquentia,manifestativus,mansionariatus,melissophyllon,melodramaticus,mendaciunculum,metempsychosis,meteorologicus,metropolitanus,micropellicula,militaristicus,monogerminalis,monotheisticus,obedientiarius,obtenebricatus,octingentensim,octingentensum,ophthalmologia,ophthalmologus,ordinatraliter,ornithoboscion,orthogonaliter,pacificatorius,palaeontologia,parlamentarius,particularista,particulariter,penetentiarius,penitentiarius,pentecontarcus,perfectissimus,perflagitiosus,perhonorificus,periphrasticus,pernecessarius,personificatio,perterrefactus,perterricrepus,pestilentiosus,phantasmaticus,pharmaceuticus,pharmacopolium,phoenicopterus,photocopiatrum,photographicus,physiotherapia,polysyllabicus,pornographicus,possessiuncula,postmeridianus,praecompositus,praecurrentium,praedesignatus,praedestinatio,praedicamentum,praedispositio,praedispositus,praefabricatus,praehistoricus,praejudicialis,praemandatatum,praemonstrator,praeservativum,praestigiatrix,praesuppositio,praetergredior,praevaricatrix,primicauponius,primogenitalis,procrastinatio,professionalis,prophylacticus,propitiatorium,propitiatorius,proportionalis,prothonotarius,proverbialiter,pseudapostulus,pseudochristus,pseudopropheta,psychoanalysis,psychoanalysta,psychomotorius,psychopathicus,psychotherapia,psychotropicum,psychotropicus,pusillanimitas,quadragenarius,quadrangulatus,quadripertitus,quadriporticus,quadrupertitus,qualitercumque,qualitercunque,quartusdecimus,querquetulanus,quinqueviratus,quotienscumque,quotienscunque,quotiesquonque,radioactivitas,ratiocinativus,rationabilitas,rationabiliter,rationalabilis,reciperatorius,recuperatorius,refractariolus,relativisticus,retrospectivus,sacramentarium,sanctimonialis,sanguinolentus,sanguinulentus,scenofactorius,secundogenitus,septempliciter,septimpliciter,septuagenarius,sesquiseptimus,sextadecimanus,similaginarius,smaragdachates,spermatozoidum,sphaeristerium,stereophonicus,sternutamentum,struthocamelus,suaviloquentia,subbasilicanus,subconscientia,subintroductio,subintroductor,subjectivismus,subministratio,subsidiarietas,suffragatorius,sumministratio,superexcellens,superimpendens,superinvalesco,supernaturalis,superrealismus,superstitiosus,supervacuaneus,synchronizatio,technocraticus,testamentarius,tetragrammaton,thymiamaterium,totalitarismus,toxicomaniacus,transmaritanus,transmutabilis,transoceanicus,transtiberinus,transversarium,transversarius,universitarius,valetudinarium,verisimilitudo,vigintiviratus,vindemiatorius,zmaragdachates,administrativus,adsentatiuncula,aequalitarismus,agonistarchicus,amministrativus,amphitheatralis,amphitheatricus,analphabetismus,ancyloblepharos,antecantamentum,antepaenultimus,anthropographos,anthropologicus,anthropomorphus,anticonceptivus,anticonvulsivum,antidepressivum,antiinfectiosus,antineuralgicum,antispasmodicum,apocatastaticus,apocolocyntosis,apophegmatismos,archicapellanus,archidiaconatus,archisodalitium,architectonicus,argyrocorinthus,assentatiuncula,bibliographicus,bibliothecarius,blandiloquentia,calceamentarius,calciamentarius,carthaginiensis,cataphractarius,catatexitechnus,cestrosphendone,chalcophthongos,chalcosmaragdus,chirographarius,chromosomaticus,chrysographatus,cinematographus,circumcordialis,circumjacentium,circumtumulatus,circumventorius,coenautocinetum,cognitionaliter,cognoscibilitas,cognoscibiliter,cohortatiuncula,combustibilitas,comicotragoedia,commemoramentum,commemoratorium,commensurabilis,commentariensis,comperendinatio,comperendinatus,complementarius,compromissarius,comprovincialis,concalfactorius,conchylilegulus,conciliatricula,concupiscibilis,concupiscitivus,condicionabilis,condicionaliter,condiscipulatus,conditionabilis,conditionaliter,conjecturaliter,conmemoramentum,conmemoratorium,conperendinatio,conperendinatus,conpromissarius,conrationalitas,conroboramentum,conruptibilitas,consubstantivus,consuetudinarie,consupplicatrix,contemplabundus,contemtibilitas,contractiuncula,contradicibilis,contradictorium,contradictorius,controversialis,controversiosus,conversibiliter,convertibiliter,corrationalitas,correspondentia,corroboramentum,corruptibilitas,cosmopolitismus,crepusculascens,cujusmodicumque,cyclophoreticus,declamatiuncula,dehonestamentum,delacrimatorius,delectatiuncula,demonstratorius,depraesentiarum,deprecatiuncula,descriptiuncula,disciplinabilis,electrochemicus,electrostaticus,electrotechnica,electrotherapia,encephalopathia,evangelistarium,extradiocesanus,extrajudicialis,extraordinarius,gerontotrophium,grapheocraticus,hendecasyllabus,historiographus,hypercatalectus,hypochondriacus,immarcescibilis,imperceptibilis,imperialisticus,imperturbabilis,impraesentiarum,impressionismus,imprisonamentum,incircumscripte,inconfusibliter,inconruptibilis,inconsiderantia,inconstabilitas,inconstabilitio,inconstabilitus,inconsummabilis,incorruptibilis,incumberamentum,incunctabiliter,incurvicervicus,indisciplinatio,indisciplinatus,indisciplinosus,indiscriminatim,individualismus,indivisibilitas,indivisibiliter,inestimabiliter,inexsuperabilis,inextinguibilis,infatigabiliter,infinitesimalis,inrecuperabilis,inrecusabiliter,inregressibilis,inremunerabilis,inseparabiliter,institutionalis,intellectibilis,intentionaliter,internationalis,intransmeabilis,invituperabilis,irrecuperabilis,irrecusabiliter,irregressibilis,irremunerabilis,lectisterniator,lexicographicus,materialisticus,matrimonialiter,montepessulanus,multicellularis,multiplicabilis,nucifrangibulum,ornithotrophion,orphanotrophium,palaeographicus,participialiter,particularismus,pentacontarchus,peraccommodatus,peregrinabundus,perfrictiuncula,peripneumonicus,persuasibiliter,photoelectricus,praeproperanter,praeselectorius,praesentialiter,praesidentialis,praeterequitans,proceleumaticus,prosthapheresis,protestantismus,pseudodictamnum,pseudoprophetia,psychosomaticus,quadrilateralus,quadringenarius,quartadecimamus,quartadecimanus,quartadecumamus,quartadecumanus,quattuorviratus,quindecimprimus,quinquagenarius,quinquepartitus,quinquepertitus,quintadecimanus,quintadecumanus,quotiensquonque,radioelectricus,rationalisticus,refrangibilitas,reprehensibilis,responsabilitas,sempiternaliter,septemtrionalis,septentrionalis,septingentensim,septingentensum,sesquivicesimus,sincipitamentum,stipulatiuncula,structuralismus,struthiocamelus,stultiloquentia,subdisjunctivus,substantialiter,succontumeliose,superabundanter,superabundantia,superaedificium,superbipartiens,superexcrescens,superficietenus,symptomatologia,tertiadecimanus,traditionalista,tragicocomoedia,transmodulatrum,undequadraginta,undevicesimanus,unetvicesimanus,vicepraepositus,vituperabiliter,adminiculabundus,administratorius,aedificatiuncula,amminiculabundus,amministratorius,anthropomorphita,antihistaminicum,appreciatamentum,appretiatamentum,archiepiscopalis,archiepiscopatus,brachycatalectum,calliblepharatus,catacecaumenites,centralizatorius,chamaecyparissos,chorocitharistes,cinematographeum,circumflexibilis,circumscariphico,circumscriptorie,circumspectatrix,circumspicientia,collectivisticus,communicabilitas,communicabiliter,comprehendibilis,comprehensibilis,concupiscentivus,confidentiloquus,conmunicabilitas,conmunicabiliter,conprehendibilis,conprehensibilis,conservativismus,constantinopolis,consubstantialis,consuetudinarius,contemplabiliter,contemptibilitas,contestatiuncula,contortiplicatus,contractabiliter,contrectabiliter,delachrimatorius,dentifrangibulum,dentifrangibulus,duoetvicesimanus,electroconvulsio,electrotechnicus,existentialismus,hierosolimitanus,immisericorditer,imperscrutabilis,improportionatus,incircumscriptus,incommutabilitas,incommutabiliter,incomparabiliter,incompatibilitas,incontaminabilis,incorrigibilitas,indefectibiliter,indissolubilitas,indissolubiliter,indistinguibilis,industrializatio,inexpugnabiliter,inexstinguibilis,inobedientiarius,inproportionatus,inrationabiliter,inrefragabiliter,intellectualiter,interminabiliter,interpretamentum,intransmutabilis,irrationabiliter,irrefragabiliter,magnificabiliter,misericordaliter,perfectissimatus,proportionalitas,proslambanomenos,prosthaphaeresis,psychoanalyticus,psychopathologia,psychotherapeuta,quadringentensim,quadringentensum,quindecimviralis,quinquegentianus,rationalabiliter,repraesentativus,senatusconsultum,senatusconsultus,septemtrionarius,septentrionarius,sesquimellesimus,struthocamelinus,subscribendarius,subsuperpartiens,superbiloquentia,supertripartiens,totalitaristicus,traditionalismus,transcendentalis,transmutabilitas,undequinquaginta,amphitheatriticus,antepreparatorius,anthropocentricus,anthropomorphitus,antihypertensivum,archidendrophorus,archiparaphonista,blandiloquentulus,charitonblepharon,cinematographicus,circumvolitabilis,commensurabilitas,concorporificatus,concupiscentialis,constitutionarius,electromagneticus,extrajudicialiter,fideicommissarius,illitteratissimus,incommensurabilis,inconruptibilitas,inconruptibiliter,incorruptibilitas,incorruptibiliter,incrementabiliter,individualisticus,inoboedientiarius,inreprehensibilis,interrogatiuncula,irreprehensibilis,particularisticus,peristerotrophion,perpendiculariter,sesquaquartnarius,sesquiquartnarius,sexquaquartnarius,sexquiquartnarius,struthiocamelinus,superbiparticular,superparticularis,transubstantiatio,turpilucricupidus,anthropocentrismus,anthropomorphismus,archipresbyteratus,brachycatalecticum,comprehensibilitas,consubstantialitas,electromagnetismus,extrasacramentalis,improportionabilis,improportionabilit,improportionaliter,incomprehensibilis,inproportionabilis,inproportionabilit,inproportionaliter,semicircumferentia,supersubstantialis,supertriparticular";
@@ -0,0 +1,75 @@
+package chinwag
+
+import (
+  "io"
+  "os"
+  "bufio"
+  "regexp"
+  "strings"
+)
+
+// LoadOptions configures how a dictionary is read, per call, rather than
+// through the package-wide Delimiters. Entries are split by the first of
+// Split (e.g. bufio.ScanWords), Pattern (matching separators, e.g.
+// `\s*\|\s*`) or Delimiters (any of which separates entries) to be set;
+// with none set, CW_DELIMITERS is used. As with OpenWithTokens, entries
+// are placed by length and duplicates pruned.
+type LoadOptions struct {
+  Name string
+  Delimiters string
+  Pattern *regexp.Regexp
+  Split bufio.SplitFunc
+}
+
+// separates the entries gathered by Pattern and Split; it is among
+// CW_DELIMITERS, so can't have been meant as part of an entry
+const loadSeparator = "\034"
+
+// Load reads a dictionary from r
+func Load(r io.Reader, opts LoadOptions) (CWDict, error) {
+  if opts.Split == nil {
+    contents, err := io.ReadAll(r)
+    if err != nil { return CWDict{}, err }
+
+    return splitString(string(contents), opts), nil
+  }
+
+  var tokens []string
+  scanner := bufio.NewScanner(r)
+  scanner.Split(opts.Split)
+
+  for scanner.Scan() { tokens = append(tokens, scanner.Text()) }
+  if err := scanner.Err(); err != nil { return CWDict{}, err }
+
+  return openTokens(opts.Name, strings.Join(tokens, loadSeparator),
+  loadSeparator), nil
+}
+
+// LoadFile reads a dictionary from filename
+func LoadFile(filename string, opts LoadOptions) (CWDict, error) {
+  file, err := os.Open(filename)
+  if err != nil { return CWDict{}, err }
+  defer file.Close()
+
+  return Load(file, opts)
+}
+
+// LoadString reads a dictionary from tokens; the error is Split's, if any
+func LoadString(tokens string, opts LoadOptions) (CWDict, error) {
+  if opts.Split != nil { return Load(strings.NewReader(tokens), opts) }
+
+  return splitString(tokens, opts), nil
+}
+
+// split string (by Pattern or Delimiters, which can't fail)
+func splitString(tokens string, opts LoadOptions) CWDict {
+  switch {
+  case opts.Pattern != nil:
+    return openTokens(opts.Name, strings.Join(opts.Pattern.Split(tokens, -1),
+    loadSeparator), loadSeparator)
+  case opts.Delimiters != "":
+    return openTokens(opts.Name, tokens, opts.Delimiters)
+  }
+
+  return openTokens(opts.Name, tokens, defaultDelimiters)
+}
@@ -0,0 +1,122 @@
+package chinwag
+
+import (
+  "os"
+  "sync"
+  "bufio"
+  "errors"
+  "regexp"
+  "slices"
+  "strings"
+  "testing"
+  "path/filepath"
+)
+
+func sortedWords(dict CWDict) []string {
+  words := dict.Words()
+  slices.Sort(words)
+
+  return words
+}
+
+func TestLoadString(t *testing.T) {
+  expected := []string{"grinch", "ice cream", "sneetch", "who"}
+
+  for _, loader := range []struct {
+    name, tokens string
+    opts LoadOptions
+  }{
+    {"tsv", "grinch\tice cream\nsneetch\twho\ngrinch\n",
+    LoadOptions{Delimiters: "\t\n"}},
+    {"pipes", "grinch | ice cream|sneetch |who|grinch",
+    LoadOptions{Pattern: regexp.MustCompile(`\s*\|\s*`)}},
+    {"default", "grinch,ice cream;sneetch:who\ngrinch", LoadOptions{}},
+  } {
+    loader.opts.Name = loader.name
+    dict, err := LoadString(loader.tokens, loader.opts)
+    if err != nil { t.Fatal(err) }
+
+    if dict.Name() != loader.name ||
+    !slices.Equal(sortedWords(dict), expected) {
+      t.Errorf("%s: expected %v, got %v", loader.name, expected,
+      sortedWords(dict))
+    }
+  }
+
+  // words, split on any white space
+  dict, err := LoadString("grinch  ice\tcream\nwho", LoadOptions{Split:
+  bufio.ScanWords})
+  if err != nil { t.Fatal(err) }
+
+  expected = []string{"cream", "grinch", "ice", "who"}
+  if !slices.Equal(sortedWords(dict), expected) {
+    t.Errorf("expected %v, got %v", expected, sortedWords(dict))
+  }
+
+  // a failing split is reported, not passed off as a short dictionary
+  broken := errors.New("broken split")
+  _, err = LoadString("grinch who", LoadOptions{Split: func(data []byte,
+  atEOF bool) (int, []byte, error) {
+    return 0, nil, broken
+  }})
+
+  if err != broken { t.Errorf("expected the split's error, got %v", err) }
+}
+
+func TestLoadSideBySide(t *testing.T) {
+  seuss := OpenEmbedded("Seussian").Words()
+
+  var group sync.WaitGroup
+  loaders := []struct {
+    joiner string
+    opts LoadOptions
+  }{
+    {"\t", LoadOptions{Delimiters: "\t"}},
+    {" ", LoadOptions{Split: bufio.ScanWords}},
+    {"|", LoadOptions{Pattern: regexp.MustCompile(`\|`)}},
+  }
+
+  for _, loader := range loaders {
+    group.Add(1)
+
+    go func() {
+      defer group.Done()
+
+      var plain []string
+      for _, word := range seuss {
+        if !strings.ContainsAny(word, " \t|") { plain = append(plain, word) }
+      }
+
+      dict, err := Load(strings.NewReader(strings.Join(plain, loader.joiner)),
+      loader.opts)
+      if err != nil { t.Error(err); return }
+
+      if err := dict.Validate(); err != nil {
+        t.Errorf("%q: expected a valid dictionary, got %s", loader.joiner,
+        ErrString(dict, err))
+      }
+    }()
+  }
+
+  group.Wait()
+
+  if Delimiters != defaultDelimiters {
+    t.Error("expected loading to leave Delimiters alone")
+  }
+}
+
+func TestLoadFile(t *testing.T) {
+  filename := filepath.Join(t.TempDir(), "words.tsv")
+  if err := os.WriteFile(filename, []byte("who\twhat\nwhen"), 0644); err != nil {
+    t.Fatal(err)
+  }
+
+  dict, err := LoadFile(filename, LoadOptions{Delimiters: "\t\n"})
+  if err != nil || dict.Length() != 3 {
+    t.Errorf("expected three entries, got %v (%v)", dict.Words(), err)
+  }
+
+  if _, err := LoadFile(filename + ".missing", LoadOptions{}); err == nil {
+    t.Error("expected a missing file to fail")
+  }
+}
@@ -0,0 +1,76 @@
+package chinwag
+
+import (
+  "sync"
+  "time"
+)
+
+// source mirrors mother() from generator.c, keeping its state in a value
+// rather than in statics, so that each caller can hold (and seed) its own
+type source struct {
+  number uint32
+  matka [5]uint32
+
+  // closed to cancel the generation drawing from this source
+  done <-chan struct{}
+}
+
+// unseeded generation draws its seeds from here
+var (
+  seedLock sync.Mutex
+  seeds = newSource(uint32(time.Now().UnixNano() / 1000))
+)
+
+func nextSeed() uint32 {
+  seedLock.Lock()
+  defer seedLock.Unlock()
+
+  return seeds.mother()
+}
+
+func newSource(seed uint32) *source {
+  src := &source{matka: [5]uint32{199112345, 177667890, 444454321,
+  196409876, 987654321}}
+
+  for i := 0; i != 4; i++ { src.matka[i] ^= seed >> (uint(i) * 2) }
+
+  return src
+}
+
+func (src *source) mother() uint32 {
+  matka := &src.matka
+
+  // perform linear bitshift combinations
+  src.number += (matka[1] << 12) + (matka[2] >> 6) +
+  (matka[3] << 10) + (matka[4] >> 8) + (matka[0] ^ (matka[0] >> 7))
+
+  // shift values in the array toward the front (i.e. "[0]")
+  matka[0], matka[1], matka[2], matka[3] = matka[1], matka[2], matka[3], matka[4]
+
+  // push new working number onto the back of the array (i.e. "[4]")
+  matka[4] = (matka[4] ^ (matka[4] << 6)) ^ (src.number ^ (src.number << 13))
+
+  // combine two array indices for further randomization
+  return matka[4] + (matka[2] + matka[2] + 4)
+}
+
+// cancelled reports whether done has been closed
+func (src *source) cancelled() bool {
+  select {
+  case <-src.done:
+    return true
+  default:
+    return false
+  }
+}
+
+// motherr returns a number within [min, max]; unlike its C counterpart, an
+// empty or overflowing span yields min rather than dividing by zero
+func (src *source) motherr(min, max uint32) uint32 {
+  if min == max { return min }
+
+  span := max - min + 1
+  if span == 0 || max < min { return min }
+
+  return min + (src.mother() % span)
+}
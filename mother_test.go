@@ -0,0 +1,33 @@
+package chinwag
+
+import (
+  "testing"
+)
+
+func TestMotherSeeded(t *testing.T) {
+  first, second := newSource(42), newSource(42)
+
+  for i := 0; i != 1000; i++ {
+    if first.mother() != second.mother() {
+      t.Fatal("expected equally seeded sources to agree")
+    }
+  }
+
+  if newSource(42).mother() == newSource(43).mother() {
+    t.Error("expected differently seeded sources to diverge")
+  }
+}
+
+func TestMotherRange(t *testing.T) {
+  src := newSource(7)
+
+  for i := 0; i != 10000; i++ {
+    if n := src.motherr(3, 8); n < 3 || n > 8 {
+      t.Fatalf("expected a number within [3, 8], got %d", n)
+    }
+  }
+
+  if src.motherr(5, 5) != 5 || src.motherr(1, 0) != 1 {
+    t.Error("expected degenerate ranges to yield min")
+  }
+}
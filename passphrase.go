@@ -0,0 +1,103 @@
+package chinwag
+
+import (
+  "math"
+  "strings"
+  "math/big"
+  "crypto/rand"
+)
+
+// PassphraseOptions configures Passphrase. Words is the number of words
+// drawn (defaulting to 6), joined by Separator (defaulting to "-"). Digits
+// and Symbols append that many random digits or symbols to randomly chosen
+// words (negative counts add none), and MinEntropy, in bits, fails any
+// passphrase that would be weaker (zero disables the check).
+type PassphraseOptions struct {
+  Words int
+  Separator string
+  Capitalize bool
+  Digits int
+  Symbols int
+  MinEntropy float64
+}
+
+// symbols injected by PassphraseOptions.Symbols
+const passphraseSymbols = "!#$%&*+=?@^~"
+
+// Passphrase draws words from the dictionary with a cryptographically
+// secure source (crypto/rand, never mother), returning the passphrase and
+// its entropy in bits. Entropy counts only the word, digit and symbol
+// draws, from the dictionary's eligible entries: those left by pruning
+// (distinct, here case-insensitively), less any holding whitespace or the
+// separator.
+func Passphrase(dict CWDict, opts PassphraseOptions) (string, float64,
+*ErrorType) {
+  if opts.Words <= 0 { opts.Words = 6 }
+  if opts.Separator == "" { opts.Separator = "-" }
+  if opts.Digits < 0 { opts.Digits = 0 }
+  if opts.Symbols < 0 { opts.Symbols = 0 }
+
+  words := passphraseWords(dict, opts.Separator)
+
+  var entropy float64
+  if len(words) > 1 {
+    entropy = float64(opts.Words) * math.Log2(float64(len(words)))
+  }
+
+  entropy += float64(opts.Digits) * math.Log2(10)
+  entropy += float64(opts.Symbols) *
+  math.Log2(float64(len(passphraseSymbols)))
+
+  if len(words) < 2 || entropy < opts.MinEntropy {
+    var go_error ErrorType = EntropyTooLow
+    return "", entropy, &go_error
+  }
+
+  phrase := make([]string, opts.Words)
+  for i := range phrase {
+    phrase[i] = words[secureIntn(len(words))]
+    if opts.Capitalize { phrase[i] = upperFirst(phrase[i]) }
+  }
+
+  for i := 0; i != opts.Digits; i++ {
+    n := secureIntn(len(phrase))
+    phrase[n] += string(rune('0' + secureIntn(10)))
+  }
+
+  for i := 0; i != opts.Symbols; i++ {
+    n := secureIntn(len(phrase))
+    phrase[n] += string(passphraseSymbols[secureIntn(len(passphraseSymbols))])
+  }
+
+  return strings.Join(phrase, opts.Separator), entropy, nil
+}
+
+// passphraseWords lists the eligible entries of dict, pruning as it goes
+// (Prune itself can drop distinct entries from short rows, and copying the
+// dictionary to prune it is slow)
+func passphraseWords(dict CWDict, separator string) []string {
+  var result []string
+  seen := map[string]bool{}
+
+  for word := range dict.All() {
+    lower := strings.ToLower(word)
+
+    if word == "" || seen[lower] || strings.ContainsAny(word, " \t\r\n") ||
+    strings.Contains(word, separator) {
+      continue
+    }
+
+    seen[lower] = true
+    result = append(result, word)
+  }
+
+  return result
+}
+
+// secureIntn returns a uniform number within [0, n) from crypto/rand
+func secureIntn(n int) int {
+  result, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+  if err != nil { panic(err) }
+
+  return int(result.Int64())
+}
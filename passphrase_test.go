@@ -0,0 +1,103 @@
+package chinwag
+
+import (
+  "math"
+  "strings"
+  "testing"
+  "unicode"
+)
+
+func TestPassphrase(t *testing.T) {
+  phrase, entropy, err := Passphrase(latin, PassphraseOptions{Words: 5})
+  if err != nil { t.Fatal(ErrString(latin, err)) }
+
+  words := strings.Split(phrase, "-")
+  if len(words) != 5 {
+    t.Fatalf("expected five words, got %q", phrase)
+  }
+
+  for _, word := range words {
+    if !latin.Include(word) {
+      t.Errorf("expected %q to come from the dictionary", word)
+    }
+  }
+
+  eligible := len(passphraseWords(latin, "-"))
+  if math.Abs(entropy - 5 * math.Log2(float64(eligible))) > 1e-9 {
+    t.Errorf("expected 5 * log2(%d) bits, got %f", eligible, entropy)
+  }
+}
+
+func TestPassphraseOptions(t *testing.T) {
+  phrase, entropy, err := Passphrase(latin, PassphraseOptions{Words: 4,
+  Separator: " ", Capitalize: true, Digits: 2, Symbols: 1})
+  if err != nil { t.Fatal(ErrString(latin, err)) }
+
+  words := strings.Split(phrase, " ")
+  if len(words) != 4 {
+    t.Fatalf("expected four words, got %q", phrase)
+  }
+
+  var digits, symbols int
+  for _, word := range words {
+    if !unicode.IsUpper([]rune(word)[0]) {
+      t.Errorf("expected %q to be capitalised", word)
+    }
+
+    for _, r := range word {
+      if unicode.IsDigit(r) { digits++ }
+      if strings.ContainsRune(passphraseSymbols, r) { symbols++ }
+    }
+  }
+
+  if digits != 2 || symbols != 1 {
+    t.Errorf("expected two digits and a symbol, got %q", phrase)
+  }
+
+  eligible := float64(len(passphraseWords(latin, " ")))
+  expected := 4 * math.Log2(eligible) + 2 * math.Log2(10) +
+  math.Log2(float64(len(passphraseSymbols)))
+  if math.Abs(entropy - expected) > 1e-9 {
+    t.Errorf("expected %f bits, got %f", expected, entropy)
+  }
+}
+
+func TestPassphraseNegative(t *testing.T) {
+  phrase, entropy, err := Passphrase(latin, PassphraseOptions{Words: 3,
+  Digits: -2, Symbols: -1})
+  if err != nil { t.Fatal(ErrString(latin, err)) }
+
+  if strings.ContainsAny(phrase, "0123456789" + passphraseSymbols) {
+    t.Errorf("expected negative counts to add nothing, got %q", phrase)
+  }
+
+  eligible := float64(len(passphraseWords(latin, "-")))
+  if math.Abs(entropy - 3 * math.Log2(eligible)) > 1e-9 {
+    t.Errorf("expected negative counts not to lower entropy, got %f", entropy)
+  }
+}
+
+func TestPassphraseEntropyPolicy(t *testing.T) {
+  _, _, err := Passphrase(latin, PassphraseOptions{Words: 2, MinEntropy: 80})
+  if err == nil || *err != EntropyTooLow {
+    t.Error("expected two words to fall short of 80 bits")
+  }
+
+  small := Open()
+  small.PlaceWords("one", "one", "two", "two two", "three")
+
+  _, entropy, err := Passphrase(small, PassphraseOptions{Words: 4})
+  if err != nil { t.Fatal(ErrString(small, err)) }
+
+  if math.Abs(entropy - 4 * math.Log2(3)) > 1e-9 {
+    t.Errorf("expected duplicates and spaced entries not to count, got %f",
+    entropy)
+  }
+
+  tiny := Open()
+  tiny.PlaceWords("only")
+
+  if _, _, err := Passphrase(tiny, PassphraseOptions{}); err == nil {
+    t.Error("expected a single word dictionary to be refused")
+  }
+}
@@ -0,0 +1,193 @@
+package chinwag
+
+import (
+  "fmt"
+  "sync"
+  "strings"
+)
+
+// Person is a fake identity; Email always falls under a reserved domain
+type Person struct {
+  First string
+  Last string
+  Display string
+  Username string
+  Email string
+}
+
+// PersonOptions configures person generation. First and Last default to
+// the embedded FirstNames and LastNames dictionaries, and Words (the source
+// of usernames, as in grumpy_owl42) to the Seussian one. Domains defaults
+// to the reserved example.com, example.org and example.net; any given must
+// be reserved too (one of those, or under the .test, .example, .invalid or
+// .localhost top-level domains), or generation fails with
+// DomainUnreserved. Unique makes
+// every username and email differ across calls, and a non-zero Seed makes
+// the sequence reproducible.
+type PersonOptions struct {
+  First, Last, Words CWDict
+  Domains []string
+  Unique bool
+  Seed uint64
+}
+
+var reservedDomains = []string{"example.com", "example.org", "example.net"}
+
+// names reserved for testing and documentation (RFC 2606), along with
+// their subdomains
+var reservedNames = append([]string{"test", "example", "invalid",
+"localhost"}, reservedDomains...)
+
+func reservedDomain(domain string) bool {
+  domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+  for _, name := range reservedNames {
+    if domain == name || strings.HasSuffix(domain, "." + name) { return true }
+  }
+
+  return false
+}
+
+// embedded name dictionaries, shared by every generator
+var (
+  peopleOnce sync.Once
+  firstNames, lastNames []string
+)
+
+// People generates fake identities; it is safe for concurrent use
+type People struct {
+  lock sync.Mutex
+  first, last, words, domains []string
+  unique bool
+  unreserved bool // a domain given isn't reserved
+  src *source
+  seen map[string]bool
+}
+
+func NewPeople(opts PersonOptions) *People {
+  peopleOnce.Do(func() {
+    first, last := OpenEmbedded("FirstNames"), OpenEmbedded("LastNames")
+    firstNames, lastNames = first.Words(), last.Words()
+    first.Close(); last.Close()
+  })
+
+  people := &People{first: firstNames, last: lastNames,
+  domains: opts.Domains, unique: opts.Unique, seen: map[string]bool{}}
+
+  if opts.First.Length() != 0 { people.first = opts.First.Words() }
+  if opts.Last.Length() != 0 { people.last = opts.Last.Words() }
+  if len(people.domains) == 0 { people.domains = reservedDomains }
+
+  for _, domain := range people.domains {
+    if !reservedDomain(domain) { people.unreserved = true }
+  }
+
+  words := opts.Words
+  if words.Length() == 0 { words = defaultDict }
+
+  for _, word := range words.Words() {
+    if word = slugify(word); word != "" {
+      people.words = append(people.words, word)
+    }
+  }
+
+  seed := nextSeed()
+  if opts.Seed != 0 { seed = uint32(opts.Seed ^ (opts.Seed >> 32)) }
+  people.src = newSource(seed)
+
+  return people
+}
+
+// GeneratePerson creates a single fake identity
+func GeneratePerson(opts PersonOptions) (Person, *ErrorType) {
+  return NewPeople(opts).Next()
+}
+
+// GeneratePeople creates n fake identities (none, for n below one)
+func GeneratePeople(opts PersonOptions, n int) ([]Person, *ErrorType) {
+  return NewPeople(opts).Batch(n)
+}
+
+// reserve (marks existing usernames and emails as taken)
+func (p *People) Reserve(taken ...string) {
+  p.lock.Lock()
+  defer p.lock.Unlock()
+
+  for _, value := range taken { p.seen[strings.ToLower(value)] = true }
+}
+
+func (p *People) Next() (Person, *ErrorType) {
+  p.lock.Lock()
+  defer p.lock.Unlock()
+
+  return p.next()
+}
+
+// Batch returns n identities (none, for n below one)
+func (p *People) Batch(n int) ([]Person, *ErrorType) {
+  p.lock.Lock()
+  defer p.lock.Unlock()
+
+  if n < 0 { n = 0 }
+  result := make([]Person, 0, n)
+
+  for i := 0; i != n; i++ {
+    person, err := p.next()
+    if err != nil { return result, err }
+
+    result = append(result, person)
+  }
+
+  return result, nil
+}
+
+func (p *People) next() (Person, *ErrorType) {
+  var go_error ErrorType = DictTooSmall
+  if len(p.first) == 0 || len(p.last) == 0 || len(p.words) == 0 {
+    return Person{}, &go_error
+  }
+
+  if p.unreserved {
+    go_error = DomainUnreserved
+    return Person{}, &go_error
+  }
+
+  person := Person{First: p.pick(p.first), Last: p.pick(p.last)}
+  person.Display = person.First + " " + person.Last
+
+  person.Username = p.claim(fmt.Sprintf("%s_%s%d", p.pick(p.words),
+  p.pick(p.words), p.src.motherr(1, 99)), "")
+
+  local := slugify(person.First) + "." + slugify(person.Last)
+  domain := "@" + p.pick(p.domains)
+  person.Email = p.claim(local, domain)
+
+  if person.Username == "" || person.Email == "" {
+    go_error = UniqueExhausted
+    return Person{}, &go_error
+  }
+
+  return person, nil
+}
+
+// claim returns value (plus suffix), numbering it should it be taken and
+// Unique be set
+func (p *People) claim(value, suffix string) string {
+  if !p.unique { return value + suffix }
+
+  candidate := value
+  for n := 2; n < 1 << 20; n++ {
+    if !p.seen[candidate + suffix] {
+      p.seen[candidate + suffix] = true
+      return candidate + suffix
+    }
+
+    candidate = fmt.Sprint(value, n)
+  }
+
+  return ""
+}
+
+func (p *People) pick(from []string) string {
+  return from[p.src.motherr(0, uint32(len(from) - 1))]
+}
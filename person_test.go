@@ -0,0 +1,122 @@
+package chinwag
+
+import (
+  "regexp"
+  "strings"
+  "testing"
+)
+
+func TestOpenEmbeddedNames(t *testing.T) {
+  first, last := OpenEmbedded("FirstNames"), OpenEmbedded("last")
+
+  if first.Name() != "FirstNames" || last.Name() != "LastNames" {
+    t.Errorf("expected embedded names, got %q and %q", first.Name(),
+    last.Name())
+  }
+
+  if first.Validate() != nil || last.Validate() != nil {
+    t.Error("expected name dictionaries to be large enough to generate from")
+  }
+
+  if !first.Include("Ada") || !last.Include("Hill") {
+    t.Error("expected the name dictionaries to hold names")
+  }
+
+  // as dict_first_names_len and dict_last_names_len declare
+  if first.Length() != 397 || last.Length() != 330 {
+    t.Errorf("expected 397 and 330 names, got %d and %d", first.Length(),
+    last.Length())
+  }
+}
+
+func TestGeneratePerson(t *testing.T) {
+  username := regexp.MustCompile(`^[a-z0-9]+_[a-z0-9]+[0-9]{1,2}$`)
+  email := regexp.MustCompile(`^[a-z0-9]+\.[a-z0-9]+@example\.(com|org|net)$`)
+  first := OpenEmbedded("FirstNames")
+
+  people, err := GeneratePeople(PersonOptions{}, 50)
+  if err != nil { t.Fatal(ErrString(first, err)) }
+
+  for _, person := range people {
+    if !first.Include(person.First) ||
+    person.Display != person.First + " " + person.Last {
+      t.Errorf("expected a first and display name, got %+v", person)
+    }
+
+    if !username.MatchString(person.Username) {
+      t.Errorf("expected a username like grumpy_owl42, got %q",
+      person.Username)
+    }
+
+    if !email.MatchString(person.Email) {
+      t.Errorf("expected an email at a reserved domain, got %q", person.Email)
+    }
+  }
+}
+
+func TestGeneratePeopleUnique(t *testing.T) {
+  small := Open()
+  small.PlaceWords("Ann", "Bo")
+
+  people := NewPeople(PersonOptions{First: small, Last: small, Words: small,
+  Domains: []string{"example.org"}, Unique: true})
+  people.Reserve("ann.ann@example.org")
+
+  batch, err := people.Batch(200)
+  if err != nil { t.Fatal(ErrString(small, err)) }
+
+  seen := map[string]bool{"ann.ann@example.org": true}
+  for _, person := range batch {
+    if seen[person.Email] || seen[person.Username] {
+      t.Fatalf("expected unique usernames and emails, got %+v", person)
+    }
+
+    seen[person.Email], seen[person.Username] = true, true
+  }
+}
+
+func TestGeneratePersonDomains(t *testing.T) {
+  for _, domain := range []string{"mail.example.com", "qa.test",
+  "EXAMPLE.NET", "localhost"} {
+    person, err := GeneratePerson(PersonOptions{Domains: []string{domain}})
+    if err != nil || !strings.HasSuffix(person.Email, "@" + domain) {
+      t.Errorf("expected %q to be accepted, got %+v", domain, person)
+    }
+  }
+
+  for _, domain := range []string{"gmail.com", "notexample.com",
+  "example.co"} {
+    _, err := GeneratePerson(PersonOptions{Domains: []string{"example.com",
+    domain}})
+    if err == nil || *err != DomainUnreserved {
+      t.Errorf("expected %q to be rejected", domain)
+    }
+  }
+}
+
+func TestGeneratePeopleNegative(t *testing.T) {
+  if people, err := GeneratePeople(PersonOptions{}, -1); err != nil ||
+  len(people) != 0 {
+    t.Errorf("expected a negative batch to be empty, got %v", people)
+  }
+
+  people := NewPeople(PersonOptions{Seed: 3})
+  if batch, err := people.Batch(-5); err != nil || len(batch) != 0 {
+    t.Errorf("expected a negative batch to be empty, got %v", batch)
+  }
+}
+
+func TestGeneratePersonSeeded(t *testing.T) {
+  first, _ := GeneratePerson(PersonOptions{Seed: 12})
+  second, _ := GeneratePerson(PersonOptions{Seed: 12})
+
+  if first != second {
+    t.Errorf("expected the same seed to repeat, got %+v and %+v", first,
+    second)
+  }
+
+  custom, _ := GeneratePerson(PersonOptions{Domains: []string{"test.invalid"}})
+  if !strings.HasSuffix(custom.Email, "@test.invalid") {
+    t.Errorf("expected a custom domain, got %q", custom.Email)
+  }
+}
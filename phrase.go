@@ -0,0 +1,42 @@
+package chinwag
+
+import "strings"
+
+// IsPhrase reports whether entry is a multi-word phrase, like "ice cream"
+// or "well-known"
+func IsPhrase(entry string) bool {
+  return isPhrase(strings.TrimSpace(entry))
+}
+
+// Phrases returns the dictionary's multi-word entries, in order
+func (dict CWDict) Phrases() []string {
+  var result []string
+
+  for word := range dict.All() {
+    if IsPhrase(word) { result = append(result, word) }
+  }
+
+  return result
+}
+
+// the measures below mirror those in utility.c, which the C engine uses
+
+// is phrase (holds a space or hyphen, as the engine sees it)
+func isPhrase(entry string) bool {
+  return strings.ContainsAny(entry, " -")
+}
+
+// letter count (bytes, bar spaces and hyphens)
+func letterCount(entry string) int {
+  return len(entry) - strings.Count(entry, " ") - strings.Count(entry, "-")
+}
+
+// word count (words separated by spaces; a hyphenated word is one)
+func wordCount(entry string) int {
+  return strings.Count(entry, " ") + 1
+}
+
+// last word length (in bytes)
+func lastWordLength(entry string) int {
+  return len(entry) - strings.LastIndexByte(entry, ' ') - 1
+}
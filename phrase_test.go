@@ -0,0 +1,148 @@
+package chinwag
+
+import (
+  "slices"
+  "strings"
+  "testing"
+)
+
+var sweets = []string{"ice cream", "apple pie", "well-known", "jelly bean",
+"hot cocoa", "sugar-coated", "candy floss", "lemon drop"}
+
+// the Seussian dictionary, plus a few phrases
+func phrased() CWDict {
+  dict := OpenEmbedded("Seussian").Clone()
+  dict.PlaceSlice(sweets)
+  dict.Sort()
+
+  return dict
+}
+
+func TestIsPhrase(t *testing.T) {
+  for _, entry := range sweets {
+    if !IsPhrase(entry) { t.Errorf("expected %q to be a phrase", entry) }
+  }
+
+  for _, entry := range []string{"grinch", " who ", ""} {
+    if IsPhrase(entry) { t.Errorf("expected %q not to be a phrase", entry) }
+  }
+
+  phrases := phrased().Phrases()
+  for _, entry := range sweets {
+    if !slices.Contains(phrases, entry) {
+      t.Errorf("expected %q among the phrases", entry)
+    }
+  }
+}
+
+func TestPhrasesAsWords(t *testing.T) {
+  dict := phrased()
+
+  found := func(generate func(seed uint64) (string, *ErrorType)) bool {
+    for seed := uint64(1); seed != 300; seed++ {
+      result, err := generate(seed)
+      if err != nil { t.Fatal(ErrString(dict, err)) }
+
+      for _, phrase := range []string{"Ice Cream", "Well-known", "Hot Cocoa"} {
+        if strings.Contains(result, phrase) { return true }
+      }
+    }
+
+    return false
+  }
+
+  words := func(seed uint64) (string, *ErrorType) {
+    return GenerateSeeded(dict, Words, 5, 5, seed)
+  }
+
+  if found(words) { t.Error("expected words to skip phrases by default") }
+
+  if dict.SetPhrasesAsWords(true); !found(words) {
+    t.Error("expected words to draw phrases whole")
+  }
+
+  if !dict.Clone().PhrasesAsWords() {
+    t.Error("expected a clone to keep drawing phrases")
+  }
+
+  // letters count a phrase's letters, not its spaces or hyphens
+  if !found(func(seed uint64) (string, *ErrorType) {
+    return GenerateSeeded(dict, Letters, 20, 30, seed)
+  }) {
+    t.Error("expected letters to draw phrases whole")
+  }
+
+  // or per call, through a policy
+  dict.SetPhrasesAsWords(false)
+  policy := DefaultPolicy
+  policy.PhrasesAsWords = true
+
+  if !found(func(seed uint64) (string, *ErrorType) {
+    return GenerateWithPolicySeeded(dict, policy, Words, 5, 5, seed)
+  }) {
+    t.Error("expected the policy to draw phrases whole")
+  }
+
+  if dict.PhrasesAsWords() { t.Error("expected the policy to leave dict be") }
+}
+
+func TestValidatePhrases(t *testing.T) {
+  dict := Open()
+  dict.PlaceSlice(OpenEmbedded("Latin").Words()[:292])
+  dict.PlaceSlice(sweets)
+  dict.Sort()
+
+  // 292 words and 8 phrases make the 300 entries Validate asks for
+  if err := dict.Validate(); err != nil {
+    t.Errorf("expected phrases to count, got %s", ErrString(dict, err))
+  }
+}
+
+func TestPhrasesInSentences(t *testing.T) {
+  firsts := []string{"big", "red", "blue", "old", "new", "fine", "tall",
+  "short", "quick", "slow", "bright", "dark", "shiny", "lumpy"}
+  seconds := []string{"cat", "hat", "fish", "wocket", "pocket", "zans",
+  "lorax", "grinch", "sneetch", "thneed"}
+
+  // mostly phrases, with just enough words to end any sentence on
+  dict := Open()
+  for _, first := range firsts {
+    for _, second := range seconds { dict.PlaceWord(first + " " + second) }
+  }
+
+  dict.PlaceWords("a", "i", "an", "is", "it", "at", "on", "the", "and", "but",
+  "not", "into", "onto", "with", "from", "about", "above", "after", "below",
+  "under", "around", "behind", "beside", "toward", "without", "between",
+  "through", "because", "although", "whatever")
+  dict.Sort()
+
+  policy := ValidationPolicy{MinSize: 100, AllowSpaces: true}
+
+  for seed := uint64(1); seed != 50; seed++ {
+    result, err := GenerateWithPolicySeeded(dict, policy, Sentences, 3, 3,
+    seed)
+    if err != nil { t.Fatal(ErrString(dict, err)) }
+
+    // every phrase's first word is followed by its second
+    words := strings.Fields(strings.ToLower(result))
+
+    for i, word := range words {
+      if !slices.Contains(firsts, word) { continue }
+
+      if i + 1 == len(words) ||
+      !slices.Contains(seconds, strings.Trim(words[i + 1], ".,?!")) {
+        t.Errorf("expected %q to begin a whole phrase in %q", word, result)
+      }
+    }
+
+    // phrases count a word each toward a sentence's length
+    for _, sentence := range strings.FieldsFunc(result, func(r rune) bool {
+      return strings.ContainsRune(".?!", r)
+    }) {
+      if count := len(strings.Fields(sentence)); count > sentenceWords {
+        t.Errorf("expected at most %d words, got %d in %q", sentenceWords,
+        count, sentence)
+      }
+    }
+  }
+}
@@ -0,0 +1,235 @@
+package chinwag
+
+import (
+  "strings"
+  "unicode/utf8"
+)
+
+// ValidationPolicy decides which dictionaries generation will accept, and
+// which of their entries it draws from. Entries breaking the policy (with a
+// space when AllowSpaces is unset, say, or longer than MaxWordLength) are
+// left out, and what remains must number MinSize or more; AllowedChars,
+// when set, lists every character an entry may hold, besides any space or
+// hyphen the policy allows. AllowRepeats lets a dictionary too small for
+// the engine (one without the ten length rows sentences need, say) still
+// generate, drawing each word independently, so words repeat and sentences
+// lose their rhythm. PhrasesAsWords lets words (and titles) and letters draw
+// the phrases the policy accepts, as SetPhrasesAsWords does.
+type ValidationPolicy struct {
+  MinSize uint64
+  AllowedChars string
+  MaxWordLength int
+  AllowHyphens bool
+  AllowSpaces bool
+  RequireSorted bool
+  AllowRepeats bool
+  PhrasesAsWords bool
+}
+
+// DefaultPolicy matches Validate (and CW_MIN_DICT_SIZE), and so Generate
+var DefaultPolicy = ValidationPolicy{MinSize: 300, AllowHyphens: true,
+AllowSpaces: true, RequireSorted: true}
+
+// ValidateWith is Validate under policy; it fails with DictTooSmall when
+// fewer than MinSize entries (and at least one) are acceptable
+func (dict CWDict) ValidateWith(policy ValidationPolicy) *ErrorType {
+  var go_error ErrorType
+  count := uint64(0)
+
+  for word := range dict.All() {
+    if policy.accepts(word) { count++ }
+  }
+
+  if count == 0 || count < policy.MinSize {
+    go_error = DictTooSmall
+    return &go_error
+  } else if policy.RequireSorted && !dict.IsSorted() {
+    go_error = DictUnsortable
+    return &go_error
+  }
+
+  return nil
+}
+
+// GenerateWithPolicy is Generate, validating dict under policy rather
+// than DefaultPolicy
+func GenerateWithPolicy(dict CWDict, policy ValidationPolicy, kind CWType,
+min, max uint64) (string, *ErrorType) {
+  return generateWithPolicy(dict, policy, kind, min, max, nextSeed())
+}
+
+// GenerateWithPolicySeeded is GenerateWithPolicy with a fixed seed
+func GenerateWithPolicySeeded(dict CWDict, policy ValidationPolicy,
+kind CWType, min, max, seed uint64) (string, *ErrorType) {
+  return generateWithPolicy(dict, policy, kind, min, max,
+  uint32(seed ^ (seed >> 32)))
+}
+
+func generateWithPolicy(dict CWDict, policy ValidationPolicy, kind CWType,
+min, max uint64, seed uint32) (string, *ErrorType) {
+  var go_error ErrorType
+
+  cwerror := dict.ValidateWith(policy)
+  if cwerror != nil { return "", cwerror }
+
+  if max > 10000 {
+    go_error = MaxTooHigh
+    return "", &go_error
+  }
+
+  source := dict.filtered(policy)
+  var result string
+
+  if Preflight(source, kind, min, max) == nil {
+    engineKind := kind
+    if kind == Titles { engineKind = Words }
+
+    result, cwerror = source.generate(engineKind, min, max, seed)
+  } else if policy.AllowRepeats && kind != Letters {
+    result, cwerror = source.generateRepeating(kind, min, max, seed)
+  } else {
+    go_error = Unsatisfiable
+    return "", &go_error
+  }
+
+  if cwerror != nil { return "", cwerror }
+
+  if kind == Titles { result = titleCase(result) }
+
+  return result, nil
+}
+
+// accepts reports whether word may be drawn from under the policy
+func (policy ValidationPolicy) accepts(word string) bool {
+  if word == "" { return false }
+  if !policy.AllowSpaces && strings.Contains(word, " ") { return false }
+  if !policy.AllowHyphens && strings.Contains(word, "-") { return false }
+
+  if policy.MaxWordLength > 0 &&
+  utf8.RuneCountInString(word) > policy.MaxWordLength {
+    return false
+  }
+
+  if policy.AllowedChars == "" { return true }
+
+  for _, r := range word {
+    if r == ' ' || r == '-' { continue }
+    if !strings.ContainsRune(policy.AllowedChars, r) { return false }
+  }
+
+  return true
+}
+
+// filtered returns a sorted dictionary of the entries policy accepts (drawing
+// phrases as words, under PhrasesAsWords), cached until dict changes, or
+// dict itself, when that already is one
+func (dict CWDict) filtered(policy ValidationPolicy) CWDict {
+  derived := dict.derived()
+  derived.lock.Lock()
+  defer derived.lock.Unlock()
+
+  if cached, ok := derived.filtered[policy]; ok { return cached }
+
+  var kept []string
+  phrases := policy.PhrasesAsWords || dict.PhrasesAsWords()
+  changed := phrases != dict.PhrasesAsWords()
+
+  for word := range dict.All() {
+    if policy.accepts(word) {
+      kept = append(kept, word)
+    } else {
+      changed = true
+    }
+  }
+
+  result := dict
+  if changed || !dict.IsSorted() {
+    result = Open()
+    result.SetName(dict.Name())
+    result.PlaceSlice(kept)
+    result.Sort()
+    result.SetPhrasesAsWords(phrases)
+  }
+
+  if derived.filtered == nil {
+    derived.filtered = map[ValidationPolicy]CWDict{}
+  }
+
+  derived.filtered[policy] = result
+  return result
+}
+
+// generateRepeating draws every word independently, for dictionaries the
+// engine can't generate from; sentence and paragraph lengths follow the
+// engine's, but not its rhythm
+func (dict CWDict) generateRepeating(kind CWType, min, max uint64,
+seed uint32) (string, *ErrorType) {
+  var words []string
+  phrases := dict.PhrasesAsWords()
+
+  for word := range dict.All() {
+    if phrases || !isPhrase(word) { words = append(words, word) }
+  }
+
+  if len(words) == 0 { words = dict.Words() }
+
+  if len(words) == 0 {
+    var go_error ErrorType = DictTooSmall
+    return "", &go_error
+  }
+
+  src := newSource(seed)
+  pick := func() string {
+    return words[src.motherr(0, uint32(len(words) - 1))]
+  }
+
+  sentence := func() string {
+    amount := src.motherr(2, sentenceWords)
+    temp := make([]string, amount)
+    for i := range temp { temp[i] = pick() }
+
+    if src.motherr(0, 1) == 1 {
+      comma := src.motherr(1, amount - 1)
+      temp[comma - 1] += ","
+    }
+
+    // the engine's 64-21-15 ratio of periods, questions and exclamations
+    s := upperFirst(strings.Join(temp, " "))
+    punct := src.motherr(0, 99)
+
+    if punct <= 63 {
+      return s + "."
+    } else if punct <= 84 {
+      return s + "?"
+    }
+
+    return s + "!"
+  }
+
+  sentences := func(amount uint32) string {
+    temp := make([]string, amount)
+    for i := range temp { temp[i] = sentence() }
+
+    return strings.Join(temp, " ")
+  }
+
+  amount := src.motherr(uint32(min), uint32(max))
+
+  switch kind {
+  case Words, Titles:
+    temp := make([]string, amount)
+    for i := range temp { temp[i] = upperFirst(pick()) }
+
+    return strings.Join(temp, " "), nil
+  case Sentences:
+    return sentences(amount), nil
+  case Paragraphs:
+    temp := make([]string, amount)
+    for i := range temp { temp[i] = sentences(src.motherr(4, 6)) }
+
+    return strings.Join(temp, "\n\n"), nil
+  }
+
+  var go_error ErrorType = InvalidOutputType
+  return "", &go_error
+}
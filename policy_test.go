@@ -0,0 +1,132 @@
+package chinwag
+
+import (
+  "strings"
+  "testing"
+)
+
+// a forty-word product glossary, far short of the default minimum
+func glossary() CWDict {
+  dict := Open()
+  dict.AppendWords("widget", "gadget", "sprocket", "flange", "gasket",
+  "bracket", "hinge", "spindle", "bearing", "coupling", "valve", "nozzle",
+  "piston", "rotor", "stator", "gear", "cog", "lever", "pulley", "crank",
+  "shaft", "bushing", "washer", "bolt", "nut", "rivet", "clamp", "spring",
+  "socket", "plug", "fuse", "relay", "switch", "sensor", "gauge", "dial",
+  "knob", "panel", "casing", "self-tapping screw")
+
+  return dict
+}
+
+func TestValidateWith(t *testing.T) {
+  dict := glossary()
+
+  if err := dict.ValidateWith(DefaultPolicy); err == nil ||
+  *err != DictTooSmall {
+    t.Errorf("expected the default policy to refuse a glossary, got %v", err)
+  }
+
+  policy := ValidationPolicy{MinSize: 39}
+  if err := dict.ValidateWith(policy); err != nil {
+    t.Errorf("expected 39 acceptable entries, got %v", err)
+  }
+
+  // the spaced, hyphenated entry is the fortieth
+  policy.MinSize = 40
+  if err := dict.ValidateWith(policy); err == nil {
+    t.Error("expected the spaced entry to go uncounted")
+  }
+
+  policy.AllowSpaces, policy.AllowHyphens = true, true
+  if err := dict.ValidateWith(policy); err != nil {
+    t.Errorf("expected 40 acceptable entries, got %v", err)
+  }
+
+  policy.RequireSorted = true
+  if err := dict.ValidateWith(policy); err == nil || *err != DictUnsortable {
+    t.Errorf("expected an unsorted glossary to be refused, got %v", err)
+  }
+
+  seuss := OpenEmbedded("Seussian")
+  if err := seuss.ValidateWith(DefaultPolicy); err != nil {
+    t.Errorf("expected the default policy to match Validate, got %v", err)
+  }
+}
+
+func TestGenerateWithPolicy(t *testing.T) {
+  dict := glossary()
+
+  if _, err := Generate(dict, Words, 3, 5); err == nil {
+    t.Error("expected Generate to refuse a glossary")
+  }
+
+  policy := ValidationPolicy{MinSize: 20, AllowedChars:
+  "abcdefghijklmnopqrstuvwxyz", MaxWordLength: 7}
+
+  for i := 0; i != 50; i++ {
+    result, err := GenerateWithPolicy(dict, policy, Words, 3, 5)
+    if err != nil { t.Fatalf("expected words, got %s", ErrString(dict, err)) }
+
+    words := strings.Fields(result)
+    if len(words) < 3 || len(words) > 5 {
+      t.Errorf("expected three to five words, got %q", result)
+    }
+
+    for _, word := range words {
+      if len(word) > 7 { t.Errorf("%q is longer than the policy allows", word) }
+    }
+  }
+
+  // a glossary has no rows for sentence rhythm, short of repeats
+  if _, err := GenerateWithPolicy(dict, policy, Sentences, 1, 2);
+  err == nil || *err != Unsatisfiable {
+    t.Errorf("expected sentences to be unsatisfiable, got %v", err)
+  }
+
+  policy.AllowRepeats = true
+
+  result, err := GenerateWithPolicy(dict, policy, Paragraphs, 2, 2)
+  if err != nil {
+    t.Fatalf("expected repeats to allow paragraphs, got %s",
+    ErrString(dict, err))
+  }
+
+  if strings.Count(result, "\n\n") != 1 {
+    t.Errorf("expected two paragraphs, got %q", result)
+  }
+
+  // more words than the policy leaves, so some must repeat
+  result, err = GenerateWithPolicy(dict, policy, Titles, 30, 30)
+  if err != nil || len(strings.Fields(result)) != 30 {
+    t.Errorf("expected thirty title words, got %q (%v)", result, err)
+  }
+
+  if _, err := GenerateWithPolicy(dict, policy, Letters, 1, 1); err == nil {
+    t.Error("expected repeats not to help letters")
+  }
+}
+
+func TestGenerateWithPolicySeeded(t *testing.T) {
+  dict := glossary()
+  policy := ValidationPolicy{MinSize: 10, AllowRepeats: true}
+
+  for _, kind := range []CWType{Words, Sentences} {
+    first, err := GenerateWithPolicySeeded(dict, policy, kind, 2, 4, 42)
+    if err != nil { t.Fatal(ErrString(dict, err)) }
+
+    second, _ := GenerateWithPolicySeeded(dict, policy, kind, 2, 4, 42)
+    if first != second {
+      t.Errorf("expected the same seed to repeat, got %q and %q", first, second)
+    }
+  }
+
+  // the filtered copy follows changes to the dictionary
+  dict.AppendWords("zzyzx")
+  policy.AllowedChars = "xyz"
+  policy.MinSize = 1
+
+  result, err := GenerateWithPolicySeeded(dict, policy, Words, 2, 2, 7)
+  if err != nil || result != "Zzyzx Zzyzx" {
+    t.Errorf("expected the appended entry alone, got %q (%v)", result, err)
+  }
+}
@@ -0,0 +1,170 @@
+package chinwag
+
+import (
+  "fmt"
+  "sync"
+)
+
+// PreflightError explains why a request can't be satisfied by a dictionary;
+// it matches Unsatisfiable under errors.Is
+type PreflightError struct {
+  Kind CWType
+  Min, Max uint64
+  Reason string
+}
+
+func (err *PreflightError) Error() string {
+  return fmt.Sprintf("%s : %s", Unsatisfiable, err.Reason)
+}
+
+func (err *PreflightError) Unwrap() error {
+  return Unsatisfiable
+}
+
+// what the engine's sampling loops need from a dictionary, gathered once
+// per change to it
+type dictAnalysis struct {
+  rows int
+  length int
+  distinct int
+  eligible int // distinct, drawable as words (phrases only when enabled)
+  shortest int // shortest eligible entry, in letters (zero when none)
+  singles int // distinct, without a space, so filling one sentence slot
+}
+
+const (
+  // rows the sentence rhythm indexes into; it picks rows 1 through 10
+  sentenceRows = 11
+
+  // the most words in a sentence (CW_SENTENCE_MAX_WORD)
+  sentenceWords = 25
+)
+
+// values derived from a dictionary, dropped whenever it changes
+type dictDerived struct {
+  once sync.Once
+  analysis *dictAnalysis
+
+  lock sync.Mutex
+  filtered map[ValidationPolicy]CWDict
+
+  soundOnce sync.Once
+  sounds *soundIndex
+}
+
+// derived returns the dictionary's cache, creating it if necessary
+func (dict CWDict) derived() *dictDerived {
+  if dict.ref == nil { return &dictDerived{} }
+
+  for {
+    if cached := dict.ref.derived.Load(); cached != nil { return cached }
+
+    created := &dictDerived{}
+    if dict.ref.derived.CompareAndSwap(nil, created) { return created }
+  }
+}
+
+// analysis returns the dictionary's (cached) analysis
+func (dict CWDict) analysis() *dictAnalysis {
+  derived := dict.derived()
+  derived.once.Do(func() { derived.analysis = dict.analyse() })
+
+  return derived.analysis
+}
+
+func (dict CWDict) analyse() *dictAnalysis {
+  result := &dictAnalysis{}
+  seen := map[string]bool{}
+  eligible := map[string]bool{}
+  singles := map[string]bool{}
+  phrases := dict.PhrasesAsWords()
+
+  dict.eachRow(func(_ int, words []string) bool {
+    result.rows++
+
+    for _, word := range words {
+      if word == "" { continue }
+
+      result.length++
+      seen[word] = true
+      if wordCount(word) == 1 { singles[word] = true }
+      if !phrases && isPhrase(word) { continue }
+
+      eligible[word] = true
+      if result.shortest == 0 || letterCount(word) < result.shortest {
+        result.shortest = letterCount(word)
+      }
+    }
+
+    return true
+  })
+
+  result.distinct, result.eligible = len(seen), len(eligible)
+  result.singles = len(singles)
+
+  return result
+}
+
+// Preflight reports whether generating kind, between min and max, could
+// keep the engine sampling forever; it is run ahead of every generation,
+// which fails with Unsatisfiable, and is exported for the explanation
+func Preflight(dict CWDict, kind CWType, min, max uint64) error {
+  if min == 0 || max == 0 || max < min { return nil }
+
+  reason := dict.analysis().unsatisfiable(kind, min, max)
+  if reason == "" { return nil }
+
+  return &PreflightError{Kind: kind, Min: min, Max: max, Reason: reason}
+}
+
+func (a *dictAnalysis) unsatisfiable(kind CWType, min, max uint64) string {
+  switch kind {
+  case Letters:
+    // samples must fit the letters left; one letter needs a one-letter
+    // entry, and the remainder can always reach three
+    if min == 1 && a.shortest != 1 {
+      return "a single letter needs a one-letter entry that can be drawn"
+    }
+
+    if max >= 3 && (a.shortest == 0 || a.shortest > 3) {
+      return fmt.Sprintf("letters need an entry of at most three letters " +
+      "that can be drawn (shortest is %d)", a.shortest)
+    }
+  case Words, Titles:
+    if a.eligible == 0 {
+      return "words need entries without a space or hyphen (or phrases " +
+      "drawn as words), and there are none"
+    }
+
+    // words repeat only once more are asked for than the dictionary holds
+    // (eligible or not); short of that, each must be a fresh eligible one
+    low := max64(min, uint64(a.eligible) + 1)
+    high := max
+    if high > uint64(a.length) { high = uint64(a.length) }
+
+    if low <= high {
+      return fmt.Sprintf("%d words may be needed without repeats, but only " +
+      "%d distinct entries can be drawn as words", high, a.eligible)
+    }
+  case Sentences, Paragraphs:
+    if a.rows < sentenceRows {
+      return fmt.Sprintf("sentence rhythm draws from rows 1 to %d, but the " +
+      "dictionary has %d", sentenceRows - 1, a.rows)
+    }
+
+    // phrases can't fill a sentence's last slot, so single words must
+    // be able to fill every one
+    if a.singles <= sentenceWords {
+      return fmt.Sprintf("sentences of up to %d words need more than %d " +
+      "distinct entries without a space (there are %d)", sentenceWords,
+      sentenceWords, a.singles)
+    }
+  }
+
+  return ""
+}
+
+func max64(a, b uint64) uint64 {
+  if a > b { return a }
+  return b
+}
@@ -0,0 +1,114 @@
+package chinwag
+
+import (
+  "fmt"
+  "errors"
+  "strings"
+  "testing"
+)
+
+// every entry holds a hyphen, so words can never be satisfied
+func hyphenated() CWDict {
+  dict := Open()
+  for i := 0; i != 400; i++ { dict.PlaceWord(fmt.Sprintf("who-%d", i)) }
+
+  dict.Sort()
+  return dict
+}
+
+func TestPreflightWords(t *testing.T) {
+  dict := hyphenated()
+
+  err := Preflight(dict, Words, 1, 2)
+  if !errors.Is(err, Unsatisfiable) ||
+  !strings.Contains(err.Error(), "space or hyphen") {
+    t.Errorf("expected hyphenated entries to be unsatisfiable, got %v", err)
+  }
+
+  if _, cwerror := Generate(dict, Words, 1, 2); cwerror == nil ||
+  *cwerror != Unsatisfiable {
+    t.Error("expected Generate to refuse rather than hang")
+  }
+
+  // five plain words, so six or more (short of the 405 entries) can't all
+  // be fresh
+  dict.PlaceWords("one", "two", "three", "four", "five")
+  dict.Sort()
+
+  if err := Preflight(dict, Words, 2, 5); err != nil {
+    t.Errorf("expected five fresh words to be possible, got %v", err)
+  }
+
+  if err := Preflight(dict, Titles, 4, 6); err == nil {
+    t.Error("expected six fresh words to be impossible")
+  }
+
+  // past the dictionary's length, repeats are allowed
+  if err := Preflight(dict, Words, 500, 600); err != nil {
+    t.Errorf("expected repeats to satisfy a long request, got %v", err)
+  }
+}
+
+func TestPreflightLetters(t *testing.T) {
+  dict := Open()
+  for i := 0; i != 400; i++ { dict.PlaceWord(fmt.Sprintf("word%d", i)) }
+  dict.Sort()
+
+  if err := Preflight(dict, Letters, 1, 1); err == nil {
+    t.Error("expected a single letter to need a one-letter entry")
+  }
+
+  if err := Preflight(dict, Letters, 5, 10); err == nil {
+    t.Error("expected long entries alone to be unsatisfiable")
+  }
+
+  if err := Preflight(dict, Letters, 2, 2); err != nil {
+    t.Errorf("expected two letters to come from vowels, got %v", err)
+  }
+
+  seuss := OpenEmbedded("Seussian")
+  if err := Preflight(seuss, Letters, 1, 20); err != nil {
+    t.Errorf("expected the Seussian dictionary to satisfy letters, got %v",
+    err)
+  }
+}
+
+func TestPreflightSentences(t *testing.T) {
+  dict := Open()
+  for i := 0; i != 400; i++ { dict.PlaceWord(fmt.Sprintf("w%03d", i)) }
+  dict.Sort()
+
+  err := Preflight(dict, Sentences, 1, 1)
+  if err == nil || !strings.Contains(err.Error(), "rows") {
+    t.Errorf("expected missing rhythm rows to be reported, got %v", err)
+  }
+
+  if _, cwerror := Generate(dict, Paragraphs, 1, 1); cwerror == nil ||
+  *cwerror != Unsatisfiable {
+    t.Error("expected paragraphs to be refused too")
+  }
+
+  for kind := Letters; kind <= Titles; kind++ {
+    if err := Preflight(latin, kind, 1, 10); err != nil {
+      t.Errorf("expected the Latin dictionary to satisfy kind %d, got %v",
+      kind, err)
+    }
+  }
+}
+
+func TestPreflightCache(t *testing.T) {
+  dict := hyphenated()
+  if Preflight(dict, Words, 1, 1) == nil { t.Fatal("expected a failure") }
+
+  for i := 0; i != 10; i++ { dict.PlaceWord(fmt.Sprintf("plain%d", i)) }
+  dict.Sort()
+
+  if err := Preflight(dict, Words, 1, 1); err != nil {
+    t.Errorf("expected changes to refresh the analysis, got %v", err)
+  }
+
+  dict.Tweak(func(word string) string { return word + "-x" })
+  if Preflight(dict, Words, 1, 1) == nil {
+    t.Error("expected tweaks to refresh the analysis")
+  }
+}
@@ -0,0 +1,71 @@
+package chinwag
+
+import (
+  "regexp"
+  "strings"
+)
+
+// The queries below each return a new dictionary of the matching entries,
+// named as the original, and sorted if it was; the original is untouched.
+
+// where (entries for which keep returns true)
+func (dict CWDict) Where(keep func(word string) bool) CWDict {
+  var kept []string
+
+  for word := range dict.All() {
+    if keep(word) { kept = append(kept, word) }
+  }
+
+  return dict.subset(kept)
+}
+
+func (dict CWDict) WithPrefix(prefix string) CWDict {
+  return dict.Where(func(word string) bool {
+    return strings.HasPrefix(word, prefix)
+  })
+}
+
+func (dict CWDict) WithSuffix(suffix string) CWDict {
+  return dict.Where(func(word string) bool {
+    return strings.HasSuffix(word, suffix)
+  })
+}
+
+func (dict CWDict) Containing(substr string) CWDict {
+  return dict.Where(func(word string) bool {
+    return strings.Contains(word, substr)
+  })
+}
+
+func (dict CWDict) Matching(pattern *regexp.Regexp) CWDict {
+  return dict.Where(pattern.MatchString)
+}
+
+// of length (entries of min to max bytes, as rows are bucketed; max at
+// zero is unbounded), skipping rows too short to hold any
+func (dict CWDict) OfLength(min, max int) CWDict {
+  var kept []string
+
+  dict.eachRow(func(largest int, words []string) bool {
+    if largest < min { return true }
+
+    for _, word := range words {
+      if len(word) >= min && (max == 0 || len(word) <= max) {
+        kept = append(kept, word)
+      }
+    }
+
+    return true
+  })
+
+  return dict.subset(kept)
+}
+
+func (dict CWDict) subset(words []string) CWDict {
+  result := OpenWithName(dict.Name())
+  result.PlaceSlice(words)
+
+  if dict.IsSorted() { result.Sort() }
+
+  return result
+}
@@ -0,0 +1,91 @@
+package chinwag
+
+import (
+  "regexp"
+  "slices"
+  "strings"
+  "testing"
+)
+
+func TestQueries(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+
+  for name, query := range map[string]struct {
+    result CWDict
+    check func(string) bool
+  }{
+    "prefix": {seuss.WithPrefix("bl"), func(word string) bool {
+      return strings.HasPrefix(word, "bl")
+    }},
+    "suffix": {seuss.WithSuffix("ing"), func(word string) bool {
+      return strings.HasSuffix(word, "ing")
+    }},
+    "containing": {seuss.Containing("oo"), func(word string) bool {
+      return strings.Contains(word, "oo")
+    }},
+    "matching": {seuss.Matching(regexp.MustCompile(`^[A-Z][a-z]+$`)),
+    func(word string) bool {
+      for i, r := range word {
+        if i == 0 && (r < 'A' || r > 'Z') { return false }
+        if i != 0 && (r < 'a' || r > 'z') { return false }
+      }
+
+      return len(word) > 1
+    }},
+    "length": {seuss.OfLength(3, 4), func(word string) bool {
+      return len(word) >= 3 && len(word) <= 4
+    }},
+  } {
+    if query.result.Length() == 0 { t.Errorf("%s: expected matches", name) }
+
+    if query.result.Name() != "Seussian" || !query.result.IsSorted() {
+      t.Errorf("%s: expected a sorted dictionary named Seussian", name)
+    }
+
+    // every match, and only matches
+    expected := 0
+    for word := range seuss.All() {
+      if query.check(word) { expected++ }
+    }
+
+    if query.result.Length() != uint64(expected) {
+      t.Errorf("%s: expected %d matches, got %d", name, expected,
+      query.result.Length())
+    }
+
+    for word := range query.result.All() {
+      if !query.check(word) { t.Errorf("%s: unexpected %q", name, word) }
+    }
+  }
+}
+
+func TestQueryRows(t *testing.T) {
+  dict := Open()
+  dict.PlaceWords("grin", "grinch", "go", "grown", "sneetch")
+  dict.Sort()
+
+  short := dict.OfLength(0, 4)
+  if lengths := short.Lengths(); !slices.Equal(lengths, []int{2, 4}) {
+    t.Errorf("expected rows of two and four, got %v", lengths)
+  }
+
+  if long := dict.OfLength(6, 0); long.Length() != 2 {
+    t.Errorf("expected an unbounded maximum, got %v", long.Words())
+  }
+
+  // queries leave the original alone, and chain
+  themed := dict.WithPrefix("gr").Where(func(word string) bool {
+    return len(word) > 4
+  })
+
+  if !slices.Equal(themed.Words(), []string{"grown", "grinch"}) ||
+  dict.Length() != 5 {
+    t.Errorf("expected grown and grinch alone, got %v", themed.Words())
+  }
+
+  unsorted := Open()
+  unsorted.AppendWords("who", "what")
+  if unsorted.WithPrefix("w").IsSorted() {
+    t.Error("expected an unsorted dictionary's results to stay unsorted")
+  }
+}
@@ -0,0 +1,87 @@
+//go:build cgo && !chinwag_purego
+
+#include "set.h"
+
+// FNV-1a
+static U32 cwset_hash
+(const char* str)
+{
+  U32 hash = 2166136261u;
+
+  for(; *str; ++str)
+  {
+    hash ^= (unsigned char)*str;
+    hash *= 16777619u;
+  }
+
+  return hash;
+}
+
+// slot holding str, or the empty slot it belongs in
+static unsigned long cwset_find
+(cwset_t set, const char* str)
+{
+  unsigned long i = cwset_hash(str) & (set.size - 1);
+
+  while(set.slots[i] && strcmp(set.slots[i], str) != 0)
+  {
+    i = (i + 1) & (set.size - 1);
+  }
+
+  return i;
+}
+
+cwset_t cwset_open
+(unsigned long expected)
+{
+  cwset_t set;
+
+  // a power of two, kept at most half full
+  set.size = 16;
+  while(set.size < expected * 2) set.size *= 2;
+
+  set.count = 0;
+  set.slots = (const char**)calloc(set.size, sizeof(char*));
+
+  return set;
+}
+
+cwset_t cwset_add
+(cwset_t set, const char* str)
+{
+  unsigned long i = cwset_find(set, str);
+  if(set.slots[i]) return set;
+
+  set.slots[i] = str;
+  ++set.count;
+
+  if(set.count * 2 > set.size)
+  {
+    cwset_t grown = cwset_open(set.count);
+
+    for(unsigned long j = 0; j != set.size; ++j)
+    {
+      if(set.slots[j]) grown.slots[cwset_find(grown, set.slots[j])] =
+      set.slots[j];
+    }
+
+    grown.count = set.count;
+    cwset_close(set);
+
+    return grown;
+  }
+
+  return set;
+}
+
+bool cwset_include
+(cwset_t set, const char* str)
+{
+  return set.slots[cwset_find(set, str)] != NULL;
+}
+
+void cwset_close
+(cwset_t set)
+{
+  free(set.slots);
+}
@@ -0,0 +1,26 @@
+#ifndef __SET_8KQ2ZD4F_H
+#define __SET_8KQ2ZD4F_H
+
+#include "chinwag.h"
+
+// string set (open addressing); entries are borrowed, not copied, so must
+// outlive the set
+typedef struct string_set_type {
+  unsigned long size;
+  unsigned long count;
+  const char** slots;
+} cwset_t;
+
+cwset_t cwset_open
+(unsigned long expected);
+
+cwset_t cwset_add
+(cwset_t set, const char* str);
+
+bool cwset_include
+(cwset_t set, const char* str);
+
+void cwset_close
+(cwset_t set);
+
+#endif
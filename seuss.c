@@ -1,3 +1,5 @@
+//go:build cgo && !chinwag_purego
+
 #include "seuss.h"
 
 const char* const dict_seuss = "I,a,TV,am,an,as,at,be,by,do,go,he,if,in,is,it,me,my,no,of,oh,on,or,so,to,up,us,we,'em,Eek,Gox,Hoo,How,Ish,Joe,May,Moo,Ned,Pip,Pop,Who,Yop,act,aid,air,all,and,any,are,ask,bad,bed,bee,bet,big,bit,bow,box,bus,but,can,car,cat,cow,cup,cut,dad,day,did,die,dog,ear,eat,egg,end,fan,far,fat,fly,for,fox,fun,get,got,guy,had,ham,has,hat,her,hey,hid,him,his,hit,hop,hot,how,hte,ink,kid,lad,lag,let,lit,lot,low,mad,man,mat,may,met,mix,mot,net,new,not,now,off,oil,old,one,our,out,owl,own,pan,pat,pet,pin,pot,put,ran,red,rid,rot,run,sad,sat,saw,say,see,she,sit,six,sky,sun,ten,the,tie,tin,tip,too,top,toy,try,two,vim,was,way,wet,who,why,wig,win,won,yes,yet,yon,you,why,Boom,Bray,Buzz,Choo,Dopp,Fish,Gack,Grum,Gump,Kiss,Mike,Nook,Tick,Tock,Whos,Wump,Ying,Yink,Yopp,Zans,Zeds,Zeep,able,arms,away,back,ball,beak,beat,been,bell,bent,best,bike,bird,bite,blew,blow,blue,boat,boil,book,both,boys,bump,bust,cage,cake,call,came,cans,care,case,cats,chin,cold,comb,come,cook,cool,cork,dare,dark,days,dead,dear,deep,deft,dish,does,done,door,dope,dots,down,drop,dumb,dunk,dust,each,ears,east,eggs,even,ever,eyes,face,fail,fall,fame,fast,fear,feet,fell,felt,find,fine,fish,five,flew,flip,fool,foot,foul,four,from,full,game,gang,gave,give,glad,goat,gold,gone,good,gown,grab,grow,hair,hall,hand,hard,harm,haul,have,he's,head,hear,heat,help,here,hide,high,hike,hold,home,hook,hops,horn,hour,howl,hump,hung,into,it's,join,joys,jump,just,keen,kept,kind,kiss,kite,know,last,lead,leak,left,like,live,long,look,lose,lots,loud,made,mail,make,many,mean,meet,mess,milk,mind,mine,moon,more,most,move,much,must,name,near,neck,need,news,next,nine,noon,once,only,open,over,pace,pack,pail,pair,pans,park,pass,past,pets,pick,pink,play,plop,pool,poor,pull,quit,race,rain,rake,rang,read,rest,ride,ring,road,roar,rope,safe,said,sank,save,seem,seen,ship,shoe,show,shut,sing,sits,size,slow,snow,soar,soft,some,soon,sore,sort,sour,star,stay,step,stew,stop,such,sure,tact,tail,take,tall,tame,tell,than,that,them,then,they,thin,this,thus,till,time,tiny,told,took,tops,town,tree,true,turn,twas,upon,very,walk,wall,want,wave,well,went,were,west,what,when,wide,wild,will,wind,wing,wink,wire,wish,with,wood,work,yell,yelp,yipp,your,fear,Bixby,Blurp,Brown,Clark,Jo-Jo,Klopp,Knock,Lurch,Slump,Slurp,Yo-Yo,about,after,again,alarm,alive,alone,along,asked,birds,black,blame,blood,bones,books,brass,break,brush,bumps,burst,caged,can't,chirp,clean,clear,clock,comes,could,creek,cried,curls,doing,don't,drink,eagle,eight,elbow,every,extra,faint,favor,folks,found,funny,games,girls,going,great,green,grind,grows,gusts,hands,he'll,heads,heard,hello,hills,house,how's,humpf,juice,jumps,kicks,kinds,kites,knots,lasso,latte,light,likes,loose,makes,maybe,mayor,miles,mixed,mouse,mouth,named,never,night,noise,ought,pants,patch,perch,phone,piled,piped,place,plane,pouch,prove,prowl,quick,quiet,quite,raced,ready,right,roads,roped,sadly,Sally,saved,scare,seven,shake,shall,shame,share,sheep,shine,shoes,shook,shout,silly,sings,sleep,smack,small,smart,sneak,socks,soget,sorry,sough,sound,space,speak,speck,speed,spoke,stand,star,start,state,steer,stick,story,sunny,swift,swish,talks,teeth,thank,their,there,these,thick,thing,think,those,three,thump,tight,times,tired,today,toots,train,trees,trick,trunk,twerp,under,using,vigor,voice,we're,we've,where,while,white,who's,whole,whose,wings,won't,world,worry,would,young,mayor,'cause,Dibble,Friday,Horton,Sizzle,Splatt,across,afraid,almost,always,anyone,aren't,around,banner,barley,before,begged,behind,better,beyond,bigger,birdie,brains,brainy,breeze,bright,broken,bungle,called,cannot,chance,chased,choose,clocks,clover,coming,counts,course,darked,decide,didn't,dozens,easily,either,eleven,enough,escape,except,family,famous,finger,fliers,flight,floors,flower,flutes,footsy,forget,fought,friend,frying,gasped,gently,groans,happen,headed,helped,hidden,hither,holler,houses,indeed,inside,jungle,kettle,kindly,landed,life's,lifted,likely,little,lonely,looked,loudly,making,marked,matter,mauled,mighty,mostly,mother,noises,onward,opener,pearls,people,person,picked,placed,places,please,points,proved,racket,really,rooter,ruckus,rushed,scared,scored,scream,seeing,seemed,should,sights,simple,smiled,sprain,sticks,stones,stores,street,string,strong,summer,surely,that's,things,though,throat,thumps,toward,town's,tricks,uncles,voices,walked,wasn't,what's,who'll,who've,winner,wonder,yellow,you'll,you're,you've,smiled,spread,Buxbaum,Hoo Hoo,Moo Moo,Whisper,against,already,amounts,another,because,beeping,believe,between,bicycle,biggest,bipping,blasted,blowing,calling,carried,chances,cleared,clearly,climbed,clovers,country,cousins,crashed,darkest,despair,distant,disturb,dropped,drowned,enemies,engaged,existed,finally,fingers,flapped,friends,frowned,garbage,getting,goodbye,grabbed,greater,grocery,haircut,heights,howling,humpfed,hundred,hustled,in-laws,kettles,laughed,lighted,magical,managed,mayor's,meeting,monkeys,morning,mothers,neither,nothing,nowhere,oompahs,percent,perhaps,persons,playing,pleaded,plucked,plunged,protect,quickly,rattled,repairs,shaking,shirker,shouted,smashed,snapped,sneered,somehow,someone,squeaky,started,staying,stomach,stopped,strange,streets,strings,succeed,talking,there's,they'll,they're,they've,thought,through,thunder,tom-tom,trouble,useless,waiting,walking,weather,whether,whisper,whooped,wiggled,windows,working,yapping,yipping,Horton's,Mr. Gump,Sam-I-am,answered,anything,anywhere,battered,bazookas,bouncing,building,ceilings,chatting,children,churches,confused,creature,drinking,elephant,enjoying,everyone,fall-ish,flapping,friendly,goldfish,grateful,greabbed,greatest,hang-ups,humpfted,insisted,jungle's,kangaroo,mountain,murmured,nonsense,person's,planning,problems,remember,searched,shirking,shoulder,shouting,smallest,snatched,sneakers,speckhah,standing,starting,steaming,straight,suddenly,tattered,tea-pots,terrible,terribly,thankful,thousand,toenails,tomorrow,up-up-up,watching,weirdish,whatever,wherever,worrying,yourself,Buzz Buzz,Dopp Dopp,Fish Kiss,Grum Grum,Mr. Brown,Mr. Mayor,Tick Tock,Who-ville,afternoon,balancing,bellowing,boom-pahs,buildings,butterfly,certainly,clarinets,cranberry,dexterous,direction,happening,kangaroos,littlecar,millionth,mountains,peaceable,sincerely,small-ish,something,sometimes,somewhere,splashing,stretched,wonderful,Boom Bands,Uncle Jake,beezle-nut,discovered,elephant's,everywhere,frequently,playthings,prickle-ly,unpleasant,yourselves,guaranteed,hullabaloo,Blurp Blurp,Hoo Hoo Hoo,Knock Knock,Pop Pop Pop,Slurp Slurp,frightening,nonsensical,not-so-good,speck-voice,un-slumping,winning-est,wonderfully,Great Places,Hakken-Kraks,carryings-on,fun-in-a-box,kangaroos',Dibble Dibble,Sizzle Sizzle,Waiting Place,blackbottomed,break-necking,flip-flapping,Boom Boom Boom,Dopp Dopp Dopp,Grum Grum Grum,Jungle of Nool,black-bottomed,floor-to-floor,rocking-chairs,Hoo Hoo Hoo Hoo,Whisper Whisper,congratulations,Eiffelberg Tower,Vlad Vlad-i-koff,mind-maker-upper,Cock-a-Doodle-Doo,Klopp Klopp Klopp,Knock Knock Knock,Choo Choo Choo Chu,Wickersham Brothers,Splatt Splatt Splatt,Who-ville Town Square,hippopotamus chewing gum,right-and-three-quarters,Dibble Dibble Dibble Dopp,Dibble Dibble Dibble Dropp,Dibble Dibble Dibble Dibble,Dibble Dibble Dibble Dribble,Mordecai Ali Van Allen O'Shea,";
@@ -0,0 +1,198 @@
+package chinwag
+
+import (
+  "fmt"
+  "sync"
+  "strings"
+)
+
+// SlugOptions configures slug and identifier generation. Min and Max bound
+// the words drawn (defaulting to 3 apiece), Separator joins them (defaulting
+// to "-"), MaxLength caps the result in bytes (zero for no cap), and Digits
+// appends a random number of that many digits (0 to 9). An identifier
+// Casing (CamelCase, PascalCase, SnakeCase, KebabCase or ConstantCase)
+// replaces the separator; any other casing is ignored. A non-zero Seed makes
+// the sequence reproducible.
+type SlugOptions struct {
+  Min, Max uint64
+  Separator string
+  MaxLength int
+  Digits int
+  Casing CWCase
+  Seed uint64
+}
+
+// Slugger generates slugs that are unique across every call, remembering
+// what it has produced (and anything reserved); it is safe for concurrent
+// use
+type Slugger struct {
+  lock sync.Mutex
+  dict CWDict
+  opts SlugOptions
+  src *source
+  seen map[string]bool
+}
+
+// attempts at a fresh random slug before numbering a repeated one instead
+const slugAttempts = 32
+
+func NewSlugger(dict CWDict, opts SlugOptions) *Slugger {
+  if opts.Min == 0 && opts.Max == 0 { opts.Min, opts.Max = 3, 3 }
+  if opts.Separator == "" { opts.Separator = "-" }
+  if opts.Digits > 9 { opts.Digits = 9 }
+  if opts.Digits < 0 { opts.Digits = 0 }
+
+  seed := nextSeed()
+  if opts.Seed != 0 { seed = uint32(opts.Seed ^ (opts.Seed >> 32)) }
+
+  return &Slugger{dict: dict, opts: opts, src: newSource(seed),
+  seen: map[string]bool{}}
+}
+
+// GenerateSlug creates a single slug; uniqueness needs a Slugger
+func GenerateSlug(dict CWDict, opts SlugOptions) (string, *ErrorType) {
+  return NewSlugger(dict, opts).Next()
+}
+
+// GenerateSlugs creates n distinct slugs
+func GenerateSlugs(dict CWDict, opts SlugOptions, n int) ([]string,
+*ErrorType) {
+  return NewSlugger(dict, opts).Batch(n)
+}
+
+// reserve (marks existing slugs as taken, e.g. those already stored)
+func (s *Slugger) Reserve(slugs ...string) {
+  s.lock.Lock()
+  defer s.lock.Unlock()
+
+  for _, slug := range slugs { s.seen[slug] = true }
+}
+
+// seen
+func (s *Slugger) Seen(slug string) bool {
+  s.lock.Lock()
+  defer s.lock.Unlock()
+
+  return s.seen[slug]
+}
+
+// len (slugs produced or reserved so far)
+func (s *Slugger) Len() int {
+  s.lock.Lock()
+  defer s.lock.Unlock()
+
+  return len(s.seen)
+}
+
+// Next returns a slug not seen before. Random candidates are tried first;
+// should those keep colliding, the last is numbered (happy-fox-2, and so
+// on), so a fresh slug is found unless MaxLength leaves no room.
+func (s *Slugger) Next() (string, *ErrorType) {
+  s.lock.Lock()
+  defer s.lock.Unlock()
+
+  return s.next()
+}
+
+// Batch returns n slugs, distinct from each other and from any seen before
+// (none, for n below one)
+func (s *Slugger) Batch(n int) ([]string, *ErrorType) {
+  s.lock.Lock()
+  defer s.lock.Unlock()
+
+  if n < 0 { n = 0 }
+  result := make([]string, 0, n)
+
+  for i := 0; i != n; i++ {
+    slug, err := s.next()
+    if err != nil { return result, err }
+
+    result = append(result, slug)
+  }
+
+  return result, nil
+}
+
+func (s *Slugger) next() (string, *ErrorType) {
+  var words []string
+
+  for i := 0; i != slugAttempts; i++ {
+    var err *ErrorType
+    words, err = s.words()
+    if err != nil { return "", err }
+
+    slug := s.join(words, s.suffix())
+    if slug != "" && !s.seen[slug] {
+      s.seen[slug] = true
+      return slug, nil
+    }
+  }
+
+  for n := 2; n < 1 << 20; n++ {
+    slug := s.join(words, fmt.Sprint(n))
+    if !strings.HasSuffix(strings.ToLower(slug), fmt.Sprint(n)) { break }
+
+    if !s.seen[slug] {
+      s.seen[slug] = true
+      return slug, nil
+    }
+  }
+
+  var go_error ErrorType = UniqueExhausted
+  return "", &go_error
+}
+
+// words draws dictionary words, reduced to lower-case ASCII letters and
+// digits
+func (s *Slugger) words() ([]string, *ErrorType) {
+  text, err := generateSeeded(s.dict, Words, s.opts.Min, s.opts.Max,
+  s.src.mother())
+  if err != nil { return nil, err }
+
+  var words []string
+  for _, word := range identifierWords(text) {
+    if word = slugify(word); word != "" { words = append(words, word) }
+  }
+
+  return words, nil
+}
+
+func (s *Slugger) suffix() string {
+  if s.opts.Digits == 0 { return "" }
+
+  limit := uint32(1)
+  for i := 0; i != s.opts.Digits; i++ { limit *= 10 }
+
+  return fmt.Sprintf("%0*d", s.opts.Digits, s.src.motherr(0, limit - 1))
+}
+
+// join cases and joins the words and suffix, dropping trailing words (or,
+// for a lone word, letters) to honour MaxLength; the suffix is always kept
+func (s *Slugger) join(words []string, suffix string) string {
+  words = append([]string(nil), words...)
+
+  for {
+    parts := words
+    if suffix != "" { parts = append(parts[:len(parts):len(parts)], suffix) }
+
+    slug := s.cased(parts)
+    if s.opts.MaxLength <= 0 || len(slug) <= s.opts.MaxLength { return slug }
+
+    if len(words) > 1 {
+      words = words[:len(words) - 1]
+    } else if len(words) == 1 && len(words[0]) > 1 {
+      words[0] = words[0][:len(words[0]) - 1]
+    } else {
+      return ""
+    }
+  }
+}
+
+func (s *Slugger) cased(parts []string) string {
+  switch s.opts.Casing {
+  case CamelCase, PascalCase, SnakeCase, KebabCase, ConstantCase:
+    return Recase(strings.Join(parts, " "), s.opts.Casing)
+  }
+
+  return strings.Join(parts, s.opts.Separator)
+}
@@ -0,0 +1,97 @@
+package chinwag
+
+import (
+  "regexp"
+  "strings"
+  "testing"
+)
+
+func TestGenerateSlug(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+  pattern := regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*_[0-9]{4}$`)
+
+  for i := 0; i != 20; i++ {
+    slug, err := GenerateSlug(seuss, SlugOptions{Min: 2, Max: 2,
+    Separator: "_", Digits: 4})
+    if err != nil { t.Fatal(ErrString(seuss, err)) }
+
+    if !pattern.MatchString(slug) {
+      t.Errorf("expected a url-safe slug with a suffix, got %q", slug)
+    }
+  }
+
+  slug, _ := GenerateSlug(seuss, SlugOptions{Min: 5, Max: 5, MaxLength: 12})
+  if len(slug) > 12 || slug == "" || strings.HasSuffix(slug, "-") {
+    t.Errorf("expected a slug of at most 12 bytes, got %q", slug)
+  }
+
+  slug, _ = GenerateSlug(seuss, SlugOptions{Casing: PascalCase})
+  if !regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`).MatchString(slug) {
+    t.Errorf("expected a Pascal case identifier, got %q", slug)
+  }
+}
+
+func TestGenerateSlugsUnique(t *testing.T) {
+  slugs, err := GenerateSlugs(latin, SlugOptions{Min: 1, Max: 1}, 2000)
+  if err != nil { t.Fatal(ErrString(latin, err)) }
+
+  seen := map[string]bool{}
+  for _, slug := range slugs {
+    if seen[slug] { t.Fatalf("expected distinct slugs, got %q twice", slug) }
+    seen[slug] = true
+  }
+
+  if len(slugs) != 2000 {
+    t.Errorf("expected 2000 slugs, got %d", len(slugs))
+  }
+}
+
+func TestSluggerPersistent(t *testing.T) {
+  slugger := NewSlugger(latin, SlugOptions{Min: 1, Max: 1, Seed: 4})
+  first, _ := slugger.Next()
+
+  again := NewSlugger(latin, SlugOptions{Min: 1, Max: 1, Seed: 4})
+  if repeat, _ := again.Next(); repeat != first {
+    t.Errorf("expected the same seed to repeat, got %q and %q", first, repeat)
+  }
+
+  again = NewSlugger(latin, SlugOptions{Min: 1, Max: 1, Seed: 4})
+  again.Reserve(first)
+
+  if next, _ := again.Next(); next == first {
+    t.Errorf("expected reserved slug %q to be skipped", first)
+  }
+
+  if !again.Seen(first) || again.Len() != 2 {
+    t.Error("expected the slugger to remember reserved and produced slugs")
+  }
+}
+
+func TestSluggerNegative(t *testing.T) {
+  slugger := NewSlugger(latin, SlugOptions{Min: 1, Max: 1, Digits: -3})
+
+  slug, err := slugger.Next()
+  if err != nil || strings.ContainsAny(slug, "0123456789") {
+    t.Errorf("expected negative digits to add none, got %q", slug)
+  }
+
+  if slugs, err := slugger.Batch(-1); err != nil || len(slugs) != 0 {
+    t.Errorf("expected a negative batch to be empty, got %v", slugs)
+  }
+}
+
+func TestSluggerExhausted(t *testing.T) {
+  slugger := NewSlugger(latin, SlugOptions{Min: 1, Max: 1, MaxLength: 1})
+
+  var err *ErrorType
+  for i := 0; i != 100 && err == nil; i++ { _, err = slugger.Next() }
+
+  if err == nil || *err != UniqueExhausted {
+    t.Fatal("expected a one byte slugger to run out")
+  }
+
+  if !strings.Contains(ErrString(latin, err), "unique") {
+    t.Errorf("expected a message for UniqueExhausted, got %q",
+    ErrString(latin, err))
+  }
+}
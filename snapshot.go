@@ -0,0 +1,149 @@
+package chinwag
+
+import (
+  "iter"
+)
+
+// Snapshot is a frozen copy of a dictionary. Nothing can modify it once it
+// has been taken, so any number of goroutines may read from it, or generate
+// with it, at the same time.
+type Snapshot struct {
+  dict CWDict
+}
+
+// Builder collects changes to a dictionary, producing snapshots on request.
+// A Builder is not safe for concurrent use; its snapshots are.
+type Builder struct {
+  dict CWDict
+}
+
+// snapshot (deep copy, unaffected by later changes to dict)
+func (dict CWDict) Snapshot() Snapshot {
+  return Snapshot{dict: dict.Clone()}
+}
+
+func (snap Snapshot) Generate(kind CWType, min, max uint64) (string, *ErrorType) {
+  return Generate(snap.dict, kind, min, max)
+}
+
+// builder (starts from a copy of the snapshot's entries)
+func (snap Snapshot) Builder() *Builder {
+  return &Builder{dict: snap.dict.Clone()}
+}
+
+// dict (mutable copy, for use with the rest of the CWDict API)
+func (snap Snapshot) Dict() CWDict {
+  return snap.dict.Clone()
+}
+
+func (snap Snapshot) Name() string {
+  return snap.dict.Name()
+}
+
+func (snap Snapshot) Length() uint64 {
+  return snap.dict.Length()
+}
+
+func (snap Snapshot) IsSorted() bool {
+  return snap.dict.IsSorted()
+}
+
+func (snap Snapshot) Largest() uint32 {
+  return snap.dict.Largest()
+}
+
+func (snap Snapshot) Sample() string {
+  return snap.dict.Sample()
+}
+
+func (snap Snapshot) Validate() *ErrorType {
+  return snap.dict.Validate()
+}
+
+func (snap Snapshot) Include(word string) bool {
+  return snap.dict.Include(word)
+}
+
+func (snap Snapshot) Exclude(word string) bool {
+  return snap.dict.Exclude(word)
+}
+
+func (snap Snapshot) Words() []string {
+  return snap.dict.Words()
+}
+
+func (snap Snapshot) Row(length int) []string {
+  return snap.dict.Row(length)
+}
+
+func (snap Snapshot) All() iter.Seq[string] {
+  return snap.dict.All()
+}
+
+func (snap Snapshot) Lengths() []int {
+  return snap.dict.Lengths()
+}
+
+func (snap Snapshot) Stats() CWStats {
+  return snap.dict.Stats()
+}
+
+func (snap Snapshot) Join(joiner string) string {
+  return snap.dict.Join(joiner)
+}
+
+func (snap Snapshot) String() string {
+  return snap.dict.String()
+}
+
+func NewBuilder() *Builder {
+  return &Builder{dict: Open()}
+}
+
+func NewBuilderWithName(name string) *Builder {
+  return &Builder{dict: OpenWithName(name)}
+}
+
+func (builder *Builder) SetName(name string) *Builder {
+  builder.dict.SetName(name)
+  return builder
+}
+
+func (builder *Builder) AppendWords(words ...string) *Builder {
+  builder.dict.AppendSlice(words)
+  return builder
+}
+
+func (builder *Builder) PlaceWords(words ...string) *Builder {
+  builder.dict.PlaceSlice(words)
+  return builder
+}
+
+func (builder *Builder) Tweak(fn func(string)string) *Builder {
+  builder.dict.Tweak(fn)
+  return builder
+}
+
+func (builder *Builder) Sort() *Builder {
+  builder.dict.Sort()
+  return builder
+}
+
+func (builder *Builder) Prune() *Builder {
+  builder.dict.Prune()
+  return builder
+}
+
+func (builder *Builder) Clean() *Builder {
+  builder.dict.Clean()
+  return builder
+}
+
+func (builder *Builder) Length() uint64 {
+  return builder.dict.Length()
+}
+
+// snapshot (the builder remains usable afterwards)
+func (builder *Builder) Snapshot() Snapshot {
+  return builder.dict.Snapshot()
+}
@@ -0,0 +1,98 @@
+package chinwag
+
+import (
+  "sync"
+  "strings"
+  "testing"
+)
+
+func TestSnapshotIsolation(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+  snap := seuss.Snapshot()
+
+  seuss.PlaceWords("zzzzzz", "yyyyyy")
+  seuss.Sort()
+  seuss.Close()
+
+  if snap.Length() != 1096 {
+    t.Errorf("expected snapshot to keep 1096 entries, got %d", snap.Length())
+  }
+
+  if snap.Include("zzzzzz") {
+    t.Error("expected snapshot to ignore later changes")
+  }
+
+  if snap.Name() != "Seussian" || !snap.IsSorted() {
+    t.Error("expected snapshot to keep its name and sorting")
+  }
+
+  dict := snap.Dict()
+  dict.Close()
+
+  if snap.Length() != 1096 {
+    t.Error("expected closing a copy to leave the snapshot intact")
+  }
+}
+
+func TestSnapshotConcurrentGenerate(t *testing.T) {
+  snap := OpenEmbedded("Latin").Snapshot()
+
+  var group sync.WaitGroup
+  failures := make(chan string, 64)
+
+  for i := 0; i != 16; i++ {
+    group.Add(1)
+
+    go func() {
+      defer group.Done()
+
+      for j := 0; j != 20; j++ {
+        for _, kind := range []CWType{Letters, Words, Sentences, Paragraphs} {
+          result, err := snap.Generate(kind, 5, 10)
+
+          if err != nil || result == "" {
+            failures <- "empty result"
+            return
+          }
+        }
+      }
+    }()
+  }
+
+  group.Wait()
+  close(failures)
+
+  for failure := range failures { t.Error(failure) }
+}
+
+func TestBuilder(t *testing.T) {
+  builder := NewBuilderWithName("Glossary")
+  builder.PlaceWords("widget", "gadget", "sprocket", "widget").Clean()
+
+  first := builder.Snapshot()
+  builder.PlaceWords("gizmo").Sort()
+  second := builder.Snapshot()
+
+  if first.Length() != 3 || second.Length() != 4 {
+    t.Errorf("expected 3 and 4 entries, got %d and %d", first.Length(),
+    second.Length())
+  }
+
+  if first.Include("gizmo") || !second.Include("gizmo") {
+    t.Error("expected only the second snapshot to include \"gizmo\"")
+  }
+
+  extended := second.Builder().AppendWords("doohickey").Snapshot()
+
+  if extended.Length() != 5 || second.Length() != 4 {
+    t.Error("expected extending a snapshot to leave it unchanged")
+  }
+
+  if !strings.Contains(extended.Join(" "), "doohickey") {
+    t.Error("expected \"extended\" to include \"doohickey\"")
+  }
+
+  if NewBuilder().Snapshot().Length() != 0 {
+    t.Error("expected a blank builder to produce a blank snapshot")
+  }
+}
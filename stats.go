@@ -0,0 +1,103 @@
+package chinwag
+
+import (
+  "fmt"
+  "sort"
+  "bytes"
+  "strings"
+  "unicode/utf8"
+)
+
+// CWStats summarizes a dictionary's entries; lengths are measured in bytes,
+// matching the way entries are bucketed into rows
+type CWStats struct {
+  Words uint64
+  Unique uint64
+  Duplicates uint64
+  Eligible uint64
+  Spaces uint64
+  Hyphens uint64
+  NonASCII uint64
+  AverageLength float64
+  MedianLength float64
+  Rows map[int]uint64
+}
+
+// stats
+func (dict CWDict) Stats() CWStats {
+  var total int
+  stats := CWStats{Rows: make(map[int]uint64)}
+  seen := make(map[string]bool)
+  lengths := make([]int, 0, dict.Length())
+
+  dict.eachRow(func(largest int, words []string) bool {
+    if len(words) > 0 { stats.Rows[largest] += uint64(len(words)) }
+    return true
+  })
+
+  for word := range dict.All() {
+    stats.Words++
+
+    if seen[word] { stats.Duplicates++ } else { seen[word] = true }
+
+    space := strings.Contains(word, " ")
+    hyphen := strings.Contains(word, "-")
+
+    if space { stats.Spaces++ }
+    if hyphen { stats.Hyphens++ }
+    if !space && !hyphen { stats.Eligible++ }
+    if !isASCII(word) { stats.NonASCII++ }
+
+    lengths = append(lengths, len(word))
+    total += len(word)
+  }
+
+  stats.Unique = uint64(len(seen))
+
+  if len(lengths) > 0 {
+    sort.Ints(lengths)
+    middle := len(lengths) / 2
+
+    stats.AverageLength = float64(total) / float64(len(lengths))
+    stats.MedianLength = float64(lengths[middle])
+    if len(lengths) % 2 == 0 {
+      stats.MedianLength = float64(lengths[middle - 1] + lengths[middle]) / 2
+    }
+  }
+
+  return stats
+}
+
+// report
+func (stats CWStats) String() string {
+  var result bytes.Buffer
+
+  fmt.Fprintf(&result, "words       : %d\n", stats.Words)
+  fmt.Fprintf(&result, "unique      : %d\n", stats.Unique)
+  fmt.Fprintf(&result, "duplicates  : %d\n", stats.Duplicates)
+  fmt.Fprintf(&result, "eligible    : %d\n", stats.Eligible)
+  fmt.Fprintf(&result, "spaces      : %d\n", stats.Spaces)
+  fmt.Fprintf(&result, "hyphens     : %d\n", stats.Hyphens)
+  fmt.Fprintf(&result, "non-ascii   : %d\n", stats.NonASCII)
+  fmt.Fprintf(&result, "average len : %.2f\n", stats.AverageLength)
+  fmt.Fprintf(&result, "median len  : %.1f\n", stats.MedianLength)
+  result.WriteString("rows        :\n")
+
+  lengths := make([]int, 0, len(stats.Rows))
+  for length := range stats.Rows { lengths = append(lengths, length) }
+  sort.Ints(lengths)
+
+  for _, length := range lengths {
+    fmt.Fprintf(&result, "  %4d : %d\n", length, stats.Rows[length])
+  }
+
+  return result.String()
+}
+
+func isASCII(word string) bool {
+  for i := 0; i < len(word); i++ {
+    if word[i] >= utf8.RuneSelf { return false }
+  }
+
+  return true
+}
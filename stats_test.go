@@ -0,0 +1,61 @@
+package chinwag
+
+import (
+  "strings"
+  "testing"
+)
+
+func TestChinwagStats(t *testing.T) {
+  small_mess := Open()
+  small_mess.PlaceWords("this", "is", "a", "test", "ice cream", "well-known",
+  "café", "this")
+
+  stats := small_mess.Stats()
+
+  if stats.Words != 8 || stats.Unique != 7 || stats.Duplicates != 1 {
+    t.Errorf("expected 8/7/1 words/unique/duplicates, got %d/%d/%d",
+    stats.Words, stats.Unique, stats.Duplicates)
+  }
+
+  if stats.Spaces != 1 || stats.Hyphens != 1 || stats.Eligible != 6 {
+    t.Errorf("expected 1/1/6 spaces/hyphens/eligible, got %d/%d/%d",
+    stats.Spaces, stats.Hyphens, stats.Eligible)
+  }
+
+  if stats.NonASCII != 1 {
+    t.Errorf("expected 1 non-ascii entry, got %d", stats.NonASCII)
+  }
+
+  if stats.Rows[4] != 3 || stats.Rows[5] != 1 || stats.Rows[9] != 1 {
+    t.Errorf("unexpected row histogram %v", stats.Rows)
+  }
+
+  if stats.MedianLength != 4 {
+    t.Errorf("expected median length of 4, got %.1f", stats.MedianLength)
+  }
+
+  if stats.AverageLength != 39.0 / 8.0 {
+    t.Errorf("expected average length of 4.88, got %.2f", stats.AverageLength)
+  }
+}
+
+func TestChinwagStatsEmbedded(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+  stats := seuss.Stats()
+
+  if stats.Words != seuss.Length() {
+    t.Errorf("expected %d words, got %d", seuss.Length(), stats.Words)
+  }
+
+  if stats.Eligible < 300 {
+    t.Errorf("expected at least 300 eligible words, got %d", stats.Eligible)
+  }
+
+  if !strings.Contains(stats.String(), "eligible") {
+    t.Error("expected report to list eligible words")
+  }
+
+  if Open().Stats().Words != 0 {
+    t.Error("expected blank dict to have no words")
+  }
+}
@@ -0,0 +1,174 @@
+package chinwag
+
+import (
+  "io"
+  "fmt"
+  "sync"
+  "reflect"
+  "text/template"
+  html "html/template"
+)
+
+// embedded dictionaries named from templates, opened once and shared
+var (
+  templateLock sync.Mutex
+  templateDicts = map[string]Snapshot{}
+)
+
+// output kinds by name (as used in templates and struct tags), with the
+// amounts generated when none are given
+type namedKind struct {
+  kind CWType
+  min, max uint64
+}
+
+var namedKinds = map[string]namedKind{
+  "letters": {Letters, 5, 10},
+  "words": {Words, defaultMinOutput, defaultMaxOutput},
+  "sentences": {Sentences, 1, 3},
+  "paragraphs": {Paragraphs, 1, 3},
+  "title": {Titles, 2, 5},
+}
+
+// FuncMap returns generation functions for text/template and html/template
+// (html/template.FuncMap shares its underlying type):
+//
+//   {{ letters 5 10 }}  {{ words 3 5 }}  {{ sentences 1 2 "latin" }}
+//   {{ paragraphs 2 }}  {{ title }}
+//
+// Numeric arguments are the minimum and (optionally) the maximum amount; a
+// string names an embedded dictionary, and a CWDict or Snapshot is used as
+// is. Without a dictionary, the default (Seussian) one is used.
+func FuncMap() template.FuncMap {
+  return funcMap(nextSeed)
+}
+
+// SeededFuncMap is FuncMap with reproducible output; the map draws from one
+// running sequence, so a template executed twice with the same map renders
+// different text each time (see ExecuteSeeded for per-execution renders)
+func SeededFuncMap(seed uint64) template.FuncMap {
+  var lock sync.Mutex
+  src := newSource(uint32(seed ^ (seed >> 32)))
+
+  return funcMap(func() uint32 {
+    lock.Lock()
+    defer lock.Unlock()
+
+    return src.mother()
+  })
+}
+
+// ExecuteSeeded executes a clone of tmpl (parsed with FuncMap or
+// SeededFuncMap) with a fresh SeededFuncMap(seed), so every execution with
+// the same seed renders the same text
+func ExecuteSeeded(tmpl *template.Template, w io.Writer, data any,
+seed uint64) error {
+  clone, err := tmpl.Clone()
+  if err != nil { return err }
+
+  return clone.Funcs(SeededFuncMap(seed)).Execute(w, data)
+}
+
+// ExecuteSeededHTML is ExecuteSeeded for html/template; as html/template
+// can't clone a template once executed, tmpl itself should only ever be
+// executed this way
+func ExecuteSeededHTML(tmpl *html.Template, w io.Writer, data any,
+seed uint64) error {
+  clone, err := tmpl.Clone()
+  if err != nil { return err }
+
+  funcs := html.FuncMap(SeededFuncMap(seed))
+  return clone.Funcs(funcs).Execute(w, data)
+}
+
+func funcMap(seed func() uint32) template.FuncMap {
+  funcs := template.FuncMap{}
+
+  for name, named := range namedKinds {
+    funcs[name] = func(args ...any) (string, error) {
+      dict, min, max, err := templateArgs(name, named.min, named.max, args)
+      if err != nil { return "", err }
+
+      result, cwerror := generateSeeded(dict, named.kind, min, max, seed())
+      if cwerror != nil { return "", fmt.Errorf("%s: %s", name,
+      ErrString(dict, cwerror)) }
+
+      return result, nil
+    }
+  }
+
+  // recasing, for pipelines such as {{ words 3 | cased "snake" }}
+  funcs["cased"] = func(name, text string) (string, error) {
+    casing, ok := namedCases[name]
+    if !ok { return "", fmt.Errorf("cased: unknown casing %q", name) }
+
+    return Recase(text, casing), nil
+  }
+
+  return funcs
+}
+
+// templateArgs resolves a template function's arguments to a dictionary
+// and an amount, falling back to the defaults given
+func templateArgs(name string, min, max uint64, args []any) (CWDict, uint64,
+uint64, error) {
+  var amounts []uint64
+  dict := defaultDict
+
+  for _, arg := range args {
+    switch value := arg.(type) {
+    case int, int8, int16, int32, int64:
+      amount := reflect.ValueOf(value).Int()
+      if amount < 0 {
+        return dict, 0, 0, fmt.Errorf("%s: negative amount %d", name, amount)
+      }
+
+      amounts = append(amounts, uint64(amount))
+    case uint, uint8, uint16, uint32, uint64:
+      amounts = append(amounts, reflect.ValueOf(value).Uint())
+    case string:
+      embedded, err := templateDict(value)
+      if err != nil { return dict, 0, 0, fmt.Errorf("%s: %s", name, err) }
+
+      dict = embedded
+    case CWDict:
+      dict = value
+    case Snapshot:
+      dict = value.dict
+    default:
+      return dict, 0, 0, fmt.Errorf("%s: unexpected argument %v (%T)", name,
+      arg, arg)
+    }
+  }
+
+  switch len(amounts) {
+  case 0:
+  case 1:
+    min, max = amounts[0], amounts[0]
+  case 2:
+    min, max = amounts[0], amounts[1]
+  default:
+    return dict, 0, 0, fmt.Errorf("%s: too many amounts (%d)", name,
+    len(amounts))
+  }
+
+  return dict, min, max, nil
+}
+
+func templateDict(name string) (CWDict, error) {
+  templateLock.Lock()
+  defer templateLock.Unlock()
+
+  if snapshot, ok := templateDicts[name]; ok { return snapshot.dict, nil }
+
+  dict := OpenEmbedded(name)
+  if dict.Length() == 0 {
+    dict.Close()
+    return dict, fmt.Errorf("unknown dictionary %q", name)
+  }
+
+  templateDicts[name] = dict.Snapshot()
+  dict.Close()
+
+  return templateDicts[name].dict, nil
+}
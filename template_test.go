@@ -0,0 +1,134 @@
+package chinwag
+
+import (
+  "strings"
+  "testing"
+  "text/template"
+  html "html/template"
+)
+
+func render(t *testing.T, funcs template.FuncMap, text string) string {
+  var out strings.Builder
+
+  tmpl, err := template.New("test").Funcs(funcs).Parse(text)
+  if err != nil { t.Fatal(err) }
+
+  err = tmpl.Execute(&out, nil)
+  if err != nil { t.Fatal(err) }
+
+  return out.String()
+}
+
+func TestTemplateFuncs(t *testing.T) {
+  got := render(t, FuncMap(), `{{ words 3 }}|{{ title }}|{{ letters 4 4 }}`)
+  parts := strings.Split(got, "|")
+
+  if len(strings.Fields(parts[0])) != 3 {
+    t.Errorf("expected three words, got %q", parts[0])
+  }
+
+  if count := len(strings.Fields(parts[1])); count < 2 || count > 5 {
+    t.Errorf("expected a title of 2-5 words, got %q", parts[1])
+  }
+
+  if len(parts[2]) < 4 {
+    t.Errorf("expected at least four letters, got %q", parts[2])
+  }
+}
+
+func TestTemplateDictionaries(t *testing.T) {
+  got := render(t, FuncMap(), `{{ words 5 "latin" }}`)
+  for _, word := range strings.Fields(got) {
+    if !latin.Include(strings.ToLower(word)) && !latin.Include(word) {
+      t.Errorf("expected %q to come from the Latin dictionary", word)
+    }
+  }
+
+  var out strings.Builder
+  tmpl := template.Must(template.New("test").Funcs(FuncMap()).
+  Parse(`{{ words 2 "klingon" }}`))
+
+  if tmpl.Execute(&out, nil) == nil {
+    t.Error("expected an unknown dictionary to fail the template")
+  }
+
+  tmpl = template.Must(template.New("test").Funcs(FuncMap()).
+  Parse(`{{ words 5 2 }}`))
+
+  if tmpl.Execute(&out, nil) == nil {
+    t.Error("expected max less than min to fail the template")
+  }
+}
+
+func TestTemplateSeeded(t *testing.T) {
+  text := `{{ title }} {{ sentences 1 2 "latin" }} {{ paragraphs 2 }}`
+
+  first := render(t, SeededFuncMap(7), text)
+  second := render(t, SeededFuncMap(7), text)
+  other := render(t, SeededFuncMap(8), text)
+
+  if first != second {
+    t.Errorf("expected the same seed to render the same text")
+  }
+
+  if first == other {
+    t.Errorf("expected another seed to render other text")
+  }
+}
+
+func TestTemplateHTML(t *testing.T) {
+  var first, second strings.Builder
+  text := `<h1>{{ title }}</h1><p>{{ sentences 2 }}</p>`
+
+  tmpl := html.Must(html.New("test").Funcs(html.FuncMap(SeededFuncMap(3))).
+  Parse(text))
+  if err := tmpl.Execute(&first, nil); err != nil { t.Fatal(err) }
+
+  tmpl = html.Must(html.New("test").Funcs(html.FuncMap(SeededFuncMap(3))).
+  Parse(text))
+  if err := tmpl.Execute(&second, nil); err != nil { t.Fatal(err) }
+
+  if first.String() != second.String() {
+    t.Error("expected seeded html renders to be stable")
+  }
+
+  if !strings.HasPrefix(first.String(), "<h1>") {
+    t.Errorf("expected html markup to survive, got %q", first.String())
+  }
+}
+
+func TestTemplateExecuteSeeded(t *testing.T) {
+  text := `{{ title }} {{ sentences 1 2 "latin" }}`
+  tmpl := template.Must(template.New("test").Funcs(FuncMap()).Parse(text))
+
+  // parsed once, and executed over and over
+  var renders [3]strings.Builder
+  for i, seed := range []uint64{42, 42, 43} {
+    err := ExecuteSeeded(tmpl, &renders[i], nil, seed)
+    if err != nil { t.Fatal(err) }
+  }
+
+  if renders[0].String() != renders[1].String() {
+    t.Errorf("expected the same seed to render the same text, got %q and " +
+    "%q", renders[0].String(), renders[1].String())
+  }
+
+  if renders[0].String() == renders[2].String() {
+    t.Error("expected another seed to render other text")
+  }
+
+  page := html.Must(html.New("test").Funcs(html.FuncMap(FuncMap())).
+  Parse(`<h1>{{ title }}</h1>`))
+
+  var first, second strings.Builder
+  err := ExecuteSeededHTML(page, &first, nil, 42)
+  if err != nil { t.Fatal(err) }
+
+  err = ExecuteSeededHTML(page, &second, nil, 42)
+  if err != nil { t.Fatal(err) }
+
+  if first.String() != second.String() {
+    t.Errorf("expected seeded html renders to be stable, got %q and %q",
+    first.String(), second.String())
+  }
+}
@@ -0,0 +1,80 @@
+package chinwag
+
+import (
+  "strings"
+  "unicode"
+  "unicode/utf8"
+)
+
+// TitleOptions configures GenerateTitle. Min and Max bound the words in the
+// title (defaulting to 2-6); with Subtitle set, a second run of words,
+// bounded by SubtitleMin and SubtitleMax (defaulting to 2-5), follows a
+// colon.
+type TitleOptions struct {
+  Min, Max uint64
+  Subtitle bool
+  SubtitleMin, SubtitleMax uint64
+}
+
+// words kept lower-case within a title, unless first or last: articles,
+// coordinating conjunctions and short prepositions
+var minorWords = map[string]bool{
+  "a": true, "an": true, "the": true,
+  "and": true, "but": true, "or": true, "nor": true, "for": true,
+  "so": true, "yet": true,
+  "as": true, "at": true, "by": true, "in": true, "of": true, "off": true,
+  "on": true, "per": true, "to": true, "up": true, "via": true,
+  "en": true, "vs": true, "from": true, "into": true, "onto": true,
+  "with": true, "upon": true, "over": true,
+}
+
+// GenerateTitle creates a headline from the dictionary, optionally with a
+// subtitle; Generate with Titles is the same, less the subtitle
+func GenerateTitle(dict CWDict, opts TitleOptions) (string, *ErrorType) {
+  return generateTitle(dict, opts, nextSeed())
+}
+
+func generateTitle(dict CWDict, opts TitleOptions,
+seed uint32) (string, *ErrorType) {
+  if opts.Min == 0 && opts.Max == 0 { opts.Min, opts.Max = 2, 6 }
+  if opts.SubtitleMin == 0 && opts.SubtitleMax == 0 {
+    opts.SubtitleMin, opts.SubtitleMax = 2, 5
+  }
+
+  src := newSource(seed)
+
+  title, err := generateSeeded(dict, Titles, opts.Min, opts.Max, src.mother())
+  if err != nil || !opts.Subtitle { return title, err }
+
+  subtitle, err := generateSeeded(dict, Titles, opts.SubtitleMin,
+  opts.SubtitleMax, src.mother())
+  if err != nil { return "", err }
+
+  return title + ": " + subtitle, nil
+}
+
+// titleCase applies headline capitalisation: every word capitalised (the
+// first part, when hyphenated), bar minor words other than the first and
+// last
+func titleCase(text string) string {
+  words := strings.Fields(text)
+
+  for i, word := range words {
+    lower := strings.ToLower(word)
+
+    if minorWords[lower] && i != 0 && i != len(words) - 1 {
+      words[i] = lower
+    } else {
+      words[i] = upperFirst(word)
+    }
+  }
+
+  return strings.Join(words, " ")
+}
+
+func upperFirst(word string) string {
+  r, size := utf8.DecodeRuneInString(word)
+  if r == utf8.RuneError { return word }
+
+  return string(unicode.ToUpper(r)) + word[size:]
+}
@@ -0,0 +1,81 @@
+package chinwag
+
+import (
+  "strings"
+  "testing"
+  "unicode"
+)
+
+func TestTitleCase(t *testing.T) {
+  cases := map[string]string{
+    "the cat in the hat": "The Cat in the Hat",
+    "Of Mice And Men": "Of Mice and Men",
+    "green eggs and ham": "Green Eggs and Ham",
+    "what it is for": "What It Is For",
+    "prickle-ly Who-ville": "Prickle-ly Who-ville",
+    "": "",
+  }
+
+  for in, expected := range cases {
+    if got := titleCase(in); got != expected {
+      t.Errorf("expected %q to become %q, got %q", in, expected, got)
+    }
+  }
+}
+
+func TestGenerateTitles(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+
+  for i := 0; i != 50; i++ {
+    result, err := Generate(seuss, Titles, 3, 6)
+    if err != nil { t.Fatal(ErrString(seuss, err)) }
+
+    words := strings.Fields(result)
+    if len(words) < 3 {
+      t.Errorf("expected at least three words, got %q", result)
+    }
+
+    for j, word := range words {
+      first := []rune(word)[0]
+      if minorWords[strings.ToLower(word)] && j != 0 && j != len(words) - 1 {
+        if word != strings.ToLower(word) {
+          t.Errorf("expected minor word %q to be lower-case in %q", word,
+          result)
+        }
+      } else if unicode.IsLower(first) {
+        t.Errorf("expected %q to be capitalised in %q", word, result)
+      }
+    }
+  }
+
+  if _, err := Generate(seuss, Titles, 0, 2); err == nil ||
+  *err != MinLessThanOne {
+    t.Error("expected titles to share the usual amount errors")
+  }
+}
+
+func TestGenerateTitleSubtitle(t *testing.T) {
+  result, err := GenerateTitle(latin, TitleOptions{Min: 2, Max: 2,
+  Subtitle: true, SubtitleMin: 3, SubtitleMax: 3})
+  if err != nil { t.Fatal(ErrString(latin, err)) }
+
+  parts := strings.Split(result, ": ")
+  if len(parts) != 2 {
+    t.Fatalf("expected a title and subtitle, got %q", result)
+  }
+
+  if len(strings.Fields(parts[0])) < 2 || len(strings.Fields(parts[1])) < 3 {
+    t.Errorf("expected 2 and 3 words, got %q", result)
+  }
+
+  result, _ = GenerateTitle(latin, TitleOptions{})
+  if strings.Contains(result, ":") {
+    t.Errorf("expected no subtitle by default, got %q", result)
+  }
+
+  first, _ := generateTitle(latin, TitleOptions{Subtitle: true}, 9)
+  second, _ := generateTitle(latin, TitleOptions{Subtitle: true}, 9)
+  if first != second {
+    t.Errorf("expected seeded titles to repeat, got %q and %q", first, second)
+  }
+}
@@ -1,3 +1,5 @@
+//go:build cgo && !chinwag_purego
+
 #include "tokenize.h"
 
 U32 stringify_file
@@ -26,7 +28,7 @@ U32 stringify_file
 cwdict_t tokenize
 (const char* const buffer, const char* delimiters)
 {
-  char* tok;
+  char* tok; char* cursor;
   char* mutable_buffer = (char*)malloc(strlen(buffer) + 1 * sizeof(char));
   cwdict_t dict = cwdict_open();
 
@@ -35,14 +37,15 @@ cwdict_t tokenize
   mutable_buffer[strlen(buffer)] = '\0';
 
   // natively tokenize input string
-  tok = strtok(mutable_buffer, delimiters);
+  cursor = mutable_buffer;
+  tok = next_token(&cursor, delimiters);
   while(tok != NULL)
   {
     // add tok to dict
     dict = cwdict_place_word_strict(dict, tok);
 
     // get new tok (if any)
-    tok = strtok(NULL, delimiters);
+    tok = next_token(&cursor, delimiters);
   }
 
   // close mutable buffer
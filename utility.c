@@ -1,3 +1,5 @@
+//go:build cgo && !chinwag_purego
+
 #include "utility.h"
 
 bool exclude(char const* str, char const* substr)
@@ -39,16 +41,37 @@ U32 count(char* string, char const* delimiters)
   char* tok = NULL;
   U32 count = 0;
 
-  tok = strtok(string, delimiters);
+  tok = next_token(&string, delimiters);
   while(tok != NULL)
   {
     ++count;
-    tok = strtok(NULL, delimiters);
+    tok = next_token(&string, delimiters);
   }
 
   return count;
 }
 
+// strtok, keeping its place in cursor rather than in a static, so that
+// dictionaries can be tokenized on several threads at once
+char* next_token(char** cursor, char const* delimiters)
+{
+  char* tok = *cursor;
+  char* end = NULL;
+
+  if(tok == NULL) return NULL;
+
+  // skip leading delimiters; runs of delimiters produce no tokens
+  tok += strspn(tok, delimiters);
+  if(*tok == '\0') { *cursor = NULL; return NULL; }
+
+  end = tok + strcspn(tok, delimiters);
+
+  if(*end == '\0') *cursor = NULL;
+  else { *end = '\0'; *cursor = end + 1; }
+
+  return tok;
+}
+
 char* substring_with_size(const char* string, U32 start, U32 end)
 {
   char* result = NULL;
@@ -141,3 +164,49 @@ char* capitalize(char* word)
 
   return word;
 }
+
+char* capitalize_words(char* word)
+{
+  for(U32 i = 0; word[i] != '\0'; ++i)
+  {
+    if(i == 0 || word[i - 1] == ' ') word[i] = toupper(word[i]);
+  }
+
+  return word;
+}
+
+bool phrase(char const* str)
+{
+  return include(str, " ") || include(str, "-");
+}
+
+U32 letter_count(char const* str)
+{
+  U32 letters = 0;
+
+  for(U32 i = 0; str[i] != '\0'; ++i)
+  {
+    if(str[i] != ' ' && str[i] != '-') ++letters;
+  }
+
+  return letters;
+}
+
+U32 word_count(char const* str)
+{
+  U32 words = 1;
+
+  for(U32 i = 0; str[i] != '\0'; ++i)
+  {
+    if(str[i] == ' ') ++words;
+  }
+
+  return words;
+}
+
+U32 last_word_length(char const* str)
+{
+  char const* last = strrchr(str, ' ');
+
+  return (U32)strlen(last ? last + 1 : str);
+}
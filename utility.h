@@ -9,6 +9,7 @@ bool include(char const* str, char const* substr);
 bool include_mtx(char const* str,const char* const* substr,const int sz);
 
 U32 count(char* string, char const* delimiters);
+char* next_token(char** cursor, char const* delimiters);
 
 char* substring_with_size(const char* string, U32 start, U32 end);
 char* sample_substring_with_size(const char* string, U32 size);
@@ -16,5 +17,12 @@ char* add_suffix(char* string, char* suffix);
 char* upcase(char* word);
 char* downcase(char* word);
 char* capitalize(char* word);
+char* capitalize_words(char* word);
+
+// phrases (entries holding a space or hyphen) and their measures
+bool phrase(char const* str);
+U32 letter_count(char const* str);
+U32 word_count(char const* str);
+U32 last_word_length(char const* str);
 
 #endif
@@ -0,0 +1,313 @@
+package chinwag
+
+import (
+  "sort"
+  "strings"
+)
+
+// VerseOptions configures GenerateVerse. Scheme gives each line of a stanza
+// a letter, lines sharing a letter rhyming on their last word, and lines
+// of different letters never (defaulting to "AABB"; "ABAB", "ABCB" and
+// "AAAA" work as well). Stanzas defaults to one, and each line holds
+// MinWords to MaxWords words (4 to 7, for whichever bound is left unset).
+// Alliterate starts every word of a line, bar the rhyme, with the same
+// sound. A non-zero Seed makes the verse reproducible.
+type VerseOptions struct {
+  Scheme string
+  Stanzas int
+  MinWords, MaxWords uint64
+  Alliterate bool
+  Seed uint64
+}
+
+// words grouped by their first sound and by their rhyme, in dictionary
+// order, each word once
+type soundIndex struct {
+  onsets, rhymes map[string][]string
+  onsetKeys, rhymeKeys []string
+}
+
+// GenerateAlliterative creates between min and max words, every one
+// starting with the same sound, as in "Bouncy Blue Bubbles"
+func GenerateAlliterative(dict CWDict, min, max uint64) (string, *ErrorType) {
+  return generateAlliterative(dict, min, max, nextSeed())
+}
+
+// GenerateAlliterativeSeeded is GenerateAlliterative with a fixed seed
+func GenerateAlliterativeSeeded(dict CWDict, min, max,
+seed uint64) (string, *ErrorType) {
+  return generateAlliterative(dict, min, max, uint32(seed ^ (seed >> 32)))
+}
+
+func generateAlliterative(dict CWDict, min, max uint64,
+seed uint32) (string, *ErrorType) {
+  if cwerror := checkRange(min, max); cwerror != nil { return "", cwerror }
+
+  src := newSource(seed)
+  amount := int(src.motherr(uint32(min), uint32(max)))
+
+  index := dict.sounds()
+  words := index.pickGroup(src, index.onsets, index.onsetKeys, amount, nil)
+  if words == nil { return "", unsatisfiable() }
+
+  picked := pickDistinct(src, words, amount)
+  for i := range picked { picked[i] = upperFirst(picked[i]) }
+
+  return strings.Join(picked, " "), nil
+}
+
+// GenerateVerse creates rhyming verse, its lines separated by newlines and
+// its stanzas by blank lines
+func GenerateVerse(dict CWDict, opts VerseOptions) (string, *ErrorType) {
+  seed := nextSeed()
+  if opts.Seed != 0 { seed = uint32(opts.Seed ^ (opts.Seed >> 32)) }
+
+  if opts.Scheme == "" { opts.Scheme = "AABB" }
+  if opts.Stanzas <= 0 { opts.Stanzas = 1 }
+  if opts.MinWords == 0 {
+    opts.MinWords = 4
+    if opts.MaxWords != 0 && opts.MaxWords < 4 { opts.MinWords = opts.MaxWords }
+  }
+
+  if opts.MaxWords == 0 { opts.MaxWords = max64(7, opts.MinWords) }
+
+  cwerror := checkRange(opts.MinWords, opts.MaxWords)
+  if cwerror != nil { return "", cwerror }
+
+  // lines needed per rhyme
+  needed := map[rune]int{}
+  for _, letter := range opts.Scheme { needed[letter]++ }
+
+  src := newSource(seed)
+  index := dict.sounds()
+
+  if len(index.onsetKeys) == 0 {
+    var go_error ErrorType = DictTooSmall
+    return "", &go_error
+  }
+
+  stanzas := make([]string, opts.Stanzas)
+
+  for s := range stanzas {
+    // each letter's rhyming words, dealt out in scheme order; a rhyme
+    // serves one letter only
+    endings := map[rune][]string{}
+    rhymed := map[string]bool{}
+
+    for _, letter := range opts.Scheme {
+      if endings[letter] != nil { continue }
+
+      group := index.pickGroup(src, index.rhymes, index.rhymeKeys,
+      needed[letter], rhymed)
+      if group == nil { return "", unsatisfiable() }
+
+      rhymed[Rhyme(group[0])] = true
+
+      endings[letter] = pickDistinct(src, group, needed[letter])
+    }
+
+    lines := make([]string, 0, len(opts.Scheme))
+
+    for _, letter := range opts.Scheme {
+      ending := endings[letter][0]
+      endings[letter] = endings[letter][1:]
+
+      lines = append(lines, index.line(src, opts, ending))
+    }
+
+    stanzas[s] = strings.Join(lines, ",\n") + "."
+  }
+
+  return strings.Join(stanzas, "\n\n"), nil
+}
+
+// line (words leading up to ending, the first capitalised)
+func (index *soundIndex) line(src *source, opts VerseOptions,
+ending string) string {
+  amount := int(src.motherr(uint32(opts.MinWords), uint32(opts.MaxWords)))
+
+  words := index.onsets[index.onsetKeys[src.motherr(0,
+  uint32(len(index.onsetKeys) - 1))]]
+
+  temp := make([]string, 0, amount)
+
+  for len(temp) != amount - 1 {
+    if !opts.Alliterate {
+      key := index.onsetKeys[src.motherr(0, uint32(len(index.onsetKeys) - 1))]
+      words = index.onsets[key]
+    }
+
+    word := words[src.motherr(0, uint32(len(words) - 1))]
+
+    // no stuttering, given a choice
+    if len(temp) > 0 && word == temp[len(temp) - 1] && len(words) > 1 {
+      continue
+    }
+
+    temp = append(temp, word)
+  }
+
+  temp = append(temp, ending)
+  temp[0] = upperFirst(temp[0])
+
+  return strings.Join(temp, " ")
+}
+
+// pickGroup returns a random group holding at least size words, bar those
+// under an excluded key, or nil
+func (index *soundIndex) pickGroup(src *source, groups map[string][]string,
+keys []string, size int, excluded map[string]bool) []string {
+  var candidates []string
+
+  for _, key := range keys {
+    if len(groups[key]) >= size && !excluded[key] {
+      candidates = append(candidates, key)
+    }
+  }
+
+  if len(candidates) == 0 { return nil }
+
+  return groups[candidates[src.motherr(0, uint32(len(candidates) - 1))]]
+}
+
+// pickDistinct returns n different words from words, at random
+func pickDistinct(src *source, words []string, n int) []string {
+  pool := append([]string(nil), words...)
+  result := make([]string, n)
+
+  for i := range result {
+    j := i + int(src.motherr(0, uint32(len(pool) - 1 - i)))
+    pool[i], pool[j] = pool[j], pool[i]
+    result[i] = pool[i]
+  }
+
+  return result
+}
+
+// sounds returns the dictionary's (cached) sound index, over its entries
+// without a space or hyphen
+func (dict CWDict) sounds() *soundIndex {
+  derived := dict.derived()
+
+  derived.soundOnce.Do(func() {
+    index := &soundIndex{onsets: map[string][]string{},
+    rhymes: map[string][]string{}}
+    seen := map[string]bool{}
+
+    for word := range dict.All() {
+      lower := strings.ToLower(word)
+      if seen[lower] || strings.ContainsAny(word, " -") { continue }
+
+      seen[lower] = true
+
+      if onset := Onset(word); onset != "" {
+        index.onsets[onset] = append(index.onsets[onset], word)
+      }
+
+      if rhyme := Rhyme(word); rhyme != "" {
+        index.rhymes[rhyme] = append(index.rhymes[rhyme], word)
+      }
+    }
+
+    for key := range index.onsets {
+      index.onsetKeys = append(index.onsetKeys, key)
+    }
+
+    for key := range index.rhymes {
+      index.rhymeKeys = append(index.rhymeKeys, key)
+    }
+
+    sort.Strings(index.onsetKeys)
+    sort.Strings(index.rhymeKeys)
+
+    derived.sounds = index
+  })
+
+  return derived.sounds
+}
+
+// spellings sounding alike at the start of a word
+var onsetSounds = []struct{ spelling, sound string }{
+  {"ph", "f"}, {"kn", "n"}, {"gn", "n"}, {"wr", "r"}, {"ps", "s"},
+  {"ch", "ch"}, {"sh", "sh"}, {"th", "th"}, {"wh", "w"}, {"qu", "k"},
+  {"ce", "s"}, {"ci", "s"}, {"cy", "s"}, {"c", "k"}, {"q", "k"},
+  {"x", "z"},
+}
+
+// Onset approximates the sound word starts with, from its spelling; words
+// sharing one alliterate (Phone and Fish, Cat and Kite)
+func Onset(word string) string {
+  word = lettersOf(word)
+  if word == "" { return "" }
+
+  for _, onset := range onsetSounds {
+    if strings.HasPrefix(word, onset.spelling) { return onset.sound }
+  }
+
+  return word[:1]
+}
+
+// Rhyme approximates the sound word ends with, from its spelling: its last
+// vowel and all that follows (past a silent e); words sharing one rhyme
+// (Cat and Hat, Cake and Lake)
+func Rhyme(word string) string {
+  word = lettersOf(word)
+  end := len(word)
+
+  // silent e, as in cake
+  if end > 2 && word[end - 1] == 'e' && !isVowel(word, end - 2) {
+    end--
+  }
+
+  i := end - 1
+  for i >= 0 && !isVowel(word, i) { i-- }
+
+  if i < 0 { return word }
+  for i > 0 && isVowel(word, i - 1) { i-- }
+
+  return word[i:]
+}
+
+// y counts as a vowel, bar at the start of a word
+func isVowel(word string, i int) bool {
+  switch word[i] {
+  case 'a', 'e', 'i', 'o', 'u':
+    return true
+  case 'y':
+    return i != 0
+  }
+
+  return false
+}
+
+// lettersOf returns word's ASCII letters, lower-cased
+func lettersOf(word string) string {
+  return strings.Map(func(r rune) rune {
+    if r >= 'A' && r <= 'Z' { return r - 'A' + 'a' }
+    if r >= 'a' && r <= 'z' { return r }
+
+    return -1
+  }, word)
+}
+
+func checkRange(min, max uint64) *ErrorType {
+  var go_error ErrorType
+
+  if min == 0 || max == 0 {
+    go_error = MinLessThanOne
+    return &go_error
+  } else if max < min {
+    go_error = MaxLessThanMin
+    return &go_error
+  } else if max > 10000 {
+    go_error = MaxTooHigh
+    return &go_error
+  }
+
+  return nil
+}
+
+func unsatisfiable() *ErrorType {
+  var go_error ErrorType = Unsatisfiable
+  return &go_error
+}
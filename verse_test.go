@@ -0,0 +1,150 @@
+package chinwag
+
+import (
+  "strings"
+  "testing"
+)
+
+func TestSounds(t *testing.T) {
+  for _, pair := range [][2]string{{"Phone", "fish"}, {"cat", "Kite"},
+  {"knot", "nose"}, {"city", "sun"}, {"queen", "kite"}, {"who", "wall"}} {
+    if Onset(pair[0]) != Onset(pair[1]) {
+      t.Errorf("expected %s and %s to alliterate", pair[0], pair[1])
+    }
+  }
+
+  if Onset("shoe") == Onset("sun") || Onset("cat") == Onset("city") {
+    t.Error("expected sh and soft c to stand apart")
+  }
+
+  for _, pair := range [][2]string{{"cat", "hat"}, {"cake", "Lake"},
+  {"moon", "spoon"}, {"Grinch", "pinch"}, {"funny", "bunny"}} {
+    if Rhyme(pair[0]) != Rhyme(pair[1]) {
+      t.Errorf("expected %s and %s to rhyme", pair[0], pair[1])
+    }
+  }
+
+  if Rhyme("cat") == Rhyme("cake") || Onset("") != "" {
+    t.Error("unexpected sound keys")
+  }
+}
+
+func TestGenerateAlliterative(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+
+  result, err := GenerateAlliterativeSeeded(seuss, 4, 6, 7)
+  if err != nil { t.Fatal(ErrString(seuss, err)) }
+
+  words := strings.Fields(result)
+  if len(words) < 4 || len(words) > 6 {
+    t.Fatalf("expected four to six words, got %q", result)
+  }
+
+  seen := map[string]bool{}
+  for _, word := range words {
+    if Onset(word) != Onset(words[0]) || seen[word] {
+      t.Errorf("expected distinct alliterating words, got %q", result)
+    }
+
+    seen[word] = true
+  }
+
+  again, _ := GenerateAlliterativeSeeded(seuss, 4, 6, 7)
+  if again != result { t.Error("expected a seed to repeat its output") }
+
+  // far more words than any one sound has
+  _, err = GenerateAlliterative(seuss, 5000, 5000)
+  if err == nil || *err != Unsatisfiable {
+    t.Error("expected an unsatisfiable request")
+  }
+
+  _, err = GenerateAlliterative(seuss, 3, 2)
+  if err == nil || *err != MaxLessThanMin { t.Error("expected MaxLessThanMin") }
+}
+
+func TestGenerateVerse(t *testing.T) {
+  seuss := OpenEmbedded("Seussian")
+
+  for _, scheme := range []string{"AABB", "ABAB", "ABCB"} {
+    opts := VerseOptions{Scheme: scheme, Stanzas: 2, MinWords: 2,
+    MaxWords: 4, Alliterate: true, Seed: 11}
+
+    verse, err := GenerateVerse(seuss, opts)
+    if err != nil { t.Fatal(ErrString(seuss, err)) }
+
+    stanzas := strings.Split(verse, "\n\n")
+    if len(stanzas) != 2 { t.Fatalf("expected two stanzas, got %q", verse) }
+
+    for _, stanza := range stanzas {
+      lines := strings.Split(stanza, "\n")
+      if len(lines) != len(scheme) {
+        t.Fatalf("expected %d lines, got %q", len(scheme), stanza)
+      }
+
+      if !strings.HasSuffix(stanza, ".") {
+        t.Errorf("expected a full stop, got %q", stanza)
+      }
+
+      endings := map[byte]string{}
+      for i, line := range lines {
+        words := strings.Fields(strings.TrimRight(line, ",."))
+        if len(words) < 2 || len(words) > 4 {
+          t.Errorf("expected two to four words, got %q", line)
+        }
+
+        // the words leading up to the rhyme alliterate
+        for _, word := range words[:len(words) - 1] {
+          if Onset(word) != Onset(words[0]) {
+            t.Errorf("expected %q to alliterate", line)
+          }
+        }
+
+        rhyme := Rhyme(words[len(words) - 1])
+        if known, ok := endings[scheme[i]]; ok && known != rhyme {
+          t.Errorf("%s: expected %q to rhyme with %q", scheme, line, known)
+        }
+
+        endings[scheme[i]] = rhyme
+      }
+    }
+
+    again, _ := GenerateVerse(seuss, opts)
+    if again != verse { t.Error("expected a seed to repeat its verse") }
+  }
+
+  // either bound alone is filled in from the default
+  for _, opts := range []VerseOptions{{MinWords: 3}, {MinWords: 9},
+  {MaxWords: 2}} {
+    if _, err := GenerateVerse(seuss, opts); err != nil {
+      t.Errorf("%+v: expected a verse, got %s", opts, ErrString(seuss, err))
+    }
+  }
+
+  // lines of different letters never rhyme with each other
+  for seed := uint64(1); seed != 500; seed++ {
+    verse, err := GenerateVerse(seuss, VerseOptions{Scheme: "ABAB",
+    Seed: seed})
+    if err != nil { t.Fatal(ErrString(seuss, err)) }
+
+    lines := strings.Split(verse, "\n")
+    first := strings.Fields(strings.TrimRight(lines[0], ",."))
+    second := strings.Fields(strings.TrimRight(lines[1], ",."))
+
+    if Rhyme(first[len(first) - 1]) == Rhyme(second[len(second) - 1]) {
+      t.Fatalf("seed %d: expected A and B lines not to rhyme, got %q", seed,
+      verse)
+    }
+  }
+
+  // nothing rhymes with orange
+  dict := Open()
+  dict.PlaceWords("orange", "silver", "purple", "month")
+
+  _, err := GenerateVerse(dict, VerseOptions{Scheme: "AA"})
+  if err == nil || *err != Unsatisfiable {
+    t.Error("expected an unsatisfiable scheme")
+  }
+
+  _, err = GenerateVerse(Open(), VerseOptions{})
+  if err == nil || *err != DictTooSmall { t.Error("expected DictTooSmall") }
+}